@@ -27,16 +27,22 @@ const (
 type CipherType int32
 
 const (
-	CipherType_AES256_GCM CipherType = 0
+	CipherType_AES256_GCM         CipherType = 0
+	CipherType_XCHACHA20_POLY1305 CipherType = 1
+	CipherType_AES256_GCM_SIV     CipherType = 2
 )
 
 // Enum value maps for CipherType.
 var (
 	CipherType_name = map[int32]string{
 		0: "AES256_GCM",
+		1: "XCHACHA20_POLY1305",
+		2: "AES256_GCM_SIV",
 	}
 	CipherType_value = map[string]int32{
-		"AES256_GCM": 0,
+		"AES256_GCM":         0,
+		"XCHACHA20_POLY1305": 1,
+		"AES256_GCM_SIV":     2,
 	}
 )
 
@@ -67,6 +73,53 @@ func (CipherType) EnumDescriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{0}
 }
 
+// Compression is the type of compression applied to an item before it is encrypted.
+type Compression int32
+
+const (
+	Compression_UNCOMPRESSED Compression = 0
+	Compression_GZIP         Compression = 1
+)
+
+// Enum value maps for Compression.
+var (
+	Compression_name = map[int32]string{
+		0: "UNCOMPRESSED",
+		1: "GZIP",
+	}
+	Compression_value = map[string]int32{
+		"UNCOMPRESSED": 0,
+		"GZIP":         1,
+	}
+)
+
+func (x Compression) Enum() *Compression {
+	p := new(Compression)
+	*p = x
+	return p
+}
+
+func (x Compression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Compression) Descriptor() protoreflect.EnumDescriptor {
+	return file_kstash_proto_enumTypes[1].Descriptor()
+}
+
+func (Compression) Type() protoreflect.EnumType {
+	return &file_kstash_proto_enumTypes[1]
+}
+
+func (x Compression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Compression.Descriptor instead.
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{1}
+}
+
 // Permission for an ACL.
 type Permission int32
 
@@ -110,11 +163,11 @@ func (x Permission) String() string {
 }
 
 func (Permission) Descriptor() protoreflect.EnumDescriptor {
-	return file_kstash_proto_enumTypes[1].Descriptor()
+	return file_kstash_proto_enumTypes[2].Descriptor()
 }
 
 func (Permission) Type() protoreflect.EnumType {
-	return &file_kstash_proto_enumTypes[1]
+	return &file_kstash_proto_enumTypes[2]
 }
 
 func (x Permission) Number() protoreflect.EnumNumber {
@@ -123,7 +176,104 @@ func (x Permission) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Permission.Descriptor instead.
 func (Permission) EnumDescriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{1}
+	return file_kstash_proto_rawDescGZIP(), []int{2}
+}
+
+// PKIKeyType is the type of the private key of a certificate issued by the PKI engine.
+type PKIKeyType int32
+
+const (
+	PKIKeyType_EC_P256  PKIKeyType = 0
+	PKIKeyType_RSA_2048 PKIKeyType = 1
+	PKIKeyType_ED25519  PKIKeyType = 2
+)
+
+// Enum value maps for PKIKeyType.
+var (
+	PKIKeyType_name = map[int32]string{
+		0: "EC_P256",
+		1: "RSA_2048",
+		2: "ED25519",
+	}
+	PKIKeyType_value = map[string]int32{
+		"EC_P256":  0,
+		"RSA_2048": 1,
+		"ED25519":  2,
+	}
+)
+
+func (x PKIKeyType) Enum() *PKIKeyType {
+	p := new(PKIKeyType)
+	*p = x
+	return p
+}
+
+func (x PKIKeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PKIKeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kstash_proto_enumTypes[3].Descriptor()
+}
+
+func (PKIKeyType) Type() protoreflect.EnumType {
+	return &file_kstash_proto_enumTypes[3]
+}
+
+func (x PKIKeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PKIKeyType.Descriptor instead.
+func (PKIKeyType) EnumDescriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{3}
+}
+
+// SSHCertificateType is the type of certificates signed by an SSH CA.
+type SSHCertificateType int32
+
+const (
+	SSHCertificateType_USER SSHCertificateType = 0
+	SSHCertificateType_HOST SSHCertificateType = 1
+)
+
+// Enum value maps for SSHCertificateType.
+var (
+	SSHCertificateType_name = map[int32]string{
+		0: "USER",
+		1: "HOST",
+	}
+	SSHCertificateType_value = map[string]int32{
+		"USER": 0,
+		"HOST": 1,
+	}
+)
+
+func (x SSHCertificateType) Enum() *SSHCertificateType {
+	p := new(SSHCertificateType)
+	*p = x
+	return p
+}
+
+func (x SSHCertificateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SSHCertificateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kstash_proto_enumTypes[4].Descriptor()
+}
+
+func (SSHCertificateType) Type() protoreflect.EnumType {
+	return &file_kstash_proto_enumTypes[4]
+}
+
+func (x SSHCertificateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SSHCertificateType.Descriptor instead.
+func (SSHCertificateType) EnumDescriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{4}
 }
 
 // EncryptionKey holds the details for encrypting or decrypting an item.
@@ -207,6 +357,8 @@ type KeychainSnapshot struct {
 	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Keys    []*EncryptionKey       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	// syncKey encrypts the records that distribute keys added by rotations to other replicas.
+	SyncKey []byte `protobuf:"bytes,4,opt,name=syncKey,proto3" json:"syncKey,omitempty"`
 }
 
 func (x *KeychainSnapshot) Reset() {
@@ -262,6 +414,13 @@ func (x *KeychainSnapshot) GetCreated() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *KeychainSnapshot) GetSyncKey() []byte {
+	if x != nil {
+		return x.SyncKey
+	}
+	return nil
+}
+
 // BackendItem represents an item stored in a physical backend.
 type BackendItem struct {
 	state         protoimpl.MessageState
@@ -271,6 +430,17 @@ type BackendItem struct {
 	Key             string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	EncryptionKeyID uint32 `protobuf:"varint,2,opt,name=encryptionKeyID,proto3" json:"encryptionKeyID,omitempty"`
 	Val             []byte `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	// version of the item's encryption format. Items with version 0 were encrypted without binding their key and encryption key ID.
+	Version uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// dataKey is the item's data key encrypted by the keychain key with encryptionKeyID, if the item was encrypted with envelope encryption.
+	DataKey []byte `protobuf:"bytes,5,opt,name=dataKey,proto3" json:"dataKey,omitempty"`
+	// chunks describes the chunks the encrypted value of a large item is split into. The item's own value is empty if it is set.
+	Chunks *ChunkManifest `protobuf:"bytes,6,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// compression applied to the item before it was encrypted.
+	Compression Compression `protobuf:"varint,7,opt,name=compression,proto3,enum=kstash.v1.Compression" json:"compression,omitempty"`
+	// expiresAt is a copy of the item's expiry, which can be read without decrypting the item. It is bound to the encrypted value,
+	// but the expiry within the item is authoritative. Items written before it was added have none until they are put again.
+	ExpiresAt int64 `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
 func (x *BackendItem) Reset() {
@@ -326,19 +496,54 @@ func (x *BackendItem) GetVal() []byte {
 	return nil
 }
 
-// Item represents an item retrieved from a physical backend and decrypted based on CipherInfo.
-type Item struct {
+func (x *BackendItem) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BackendItem) GetDataKey() []byte {
+	if x != nil {
+		return x.DataKey
+	}
+	return nil
+}
+
+func (x *BackendItem) GetChunks() *ChunkManifest {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *BackendItem) GetCompression() Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return Compression_UNCOMPRESSED
+}
+
+func (x *BackendItem) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// ChunkManifest describes the chunks of a large item. Each chunk is encrypted and authenticated separately, bound to its item, index, and the number of chunks.
+type ChunkManifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Raw []byte                `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
-	Map map[string]*anypb.Any `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Size  uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *Item) Reset() {
-	*x = Item{}
+func (x *ChunkManifest) Reset() {
+	*x = ChunkManifest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -346,13 +551,13 @@ func (x *Item) Reset() {
 	}
 }
 
-func (x *Item) String() string {
+func (x *ChunkManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Item) ProtoMessage() {}
+func (*ChunkManifest) ProtoMessage() {}
 
-func (x *Item) ProtoReflect() protoreflect.Message {
+func (x *ChunkManifest) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -364,44 +569,48 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Item.ProtoReflect.Descriptor instead.
-func (*Item) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChunkManifest.ProtoReflect.Descriptor instead.
+func (*ChunkManifest) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Item) GetKey() string {
+func (x *ChunkManifest) GetId() string {
 	if x != nil {
-		return x.Key
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Item) GetRaw() []byte {
+func (x *ChunkManifest) GetCount() uint32 {
 	if x != nil {
-		return x.Raw
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *Item) GetMap() map[string]*anypb.Any {
+func (x *ChunkManifest) GetSize() uint64 {
 	if x != nil {
-		return x.Map
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-// ACL is an access control list for an access token.
-type ACL struct {
+// NamespaceSecret holds the secret that is mixed into a namespace's derived encryption keys.
+type NamespaceSecret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path        string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Permissions []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=kstash.v1.Permission" json:"permissions,omitempty"`
+	// secret is encrypted by the tenant's wrapping key if tenantWrapped is set.
+	Secret        []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	TenantWrapped bool   `protobuf:"varint,2,opt,name=tenantWrapped,proto3" json:"tenantWrapped,omitempty"`
+	// previousSecret is the secret the namespace's items were encrypted with before its tenant's wrapping key was configured.
+	// It is only kept until every item has been re-encrypted with the new secret.
+	PreviousSecret []byte `protobuf:"bytes,3,opt,name=previousSecret,proto3" json:"previousSecret,omitempty"`
 }
 
-func (x *ACL) Reset() {
-	*x = ACL{}
+func (x *NamespaceSecret) Reset() {
+	*x = NamespaceSecret{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,13 +618,13 @@ func (x *ACL) Reset() {
 	}
 }
 
-func (x *ACL) String() string {
+func (x *NamespaceSecret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ACL) ProtoMessage() {}
+func (*NamespaceSecret) ProtoMessage() {}
 
-func (x *ACL) ProtoReflect() protoreflect.Message {
+func (x *NamespaceSecret) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,43 +636,47 @@ func (x *ACL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ACL.ProtoReflect.Descriptor instead.
-func (*ACL) Descriptor() ([]byte, []int) {
+// Deprecated: Use NamespaceSecret.ProtoReflect.Descriptor instead.
+func (*NamespaceSecret) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ACL) GetPath() string {
+func (x *NamespaceSecret) GetSecret() []byte {
 	if x != nil {
-		return x.Path
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-func (x *ACL) GetPermissions() []Permission {
+func (x *NamespaceSecret) GetTenantWrapped() bool {
 	if x != nil {
-		return x.Permissions
+		return x.TenantWrapped
+	}
+	return false
+}
+
+func (x *NamespaceSecret) GetPreviousSecret() []byte {
+	if x != nil {
+		return x.PreviousSecret
 	}
 	return nil
 }
 
-// AccessToken an access token used for authentication and authorization.
-type AccessToken struct {
+// Item represents an item retrieved from a physical backend and decrypted based on CipherInfo.
+type Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ReferenceID string            `protobuf:"bytes,2,opt,name=referenceID,proto3" json:"referenceID,omitempty"`
-	Namespace   string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	CreatedAt   int64             `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	EnabledAt   int64             `protobuf:"varint,5,opt,name=enabledAt,proto3" json:"enabledAt,omitempty"`
-	ExpiresAt   int64             `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
-	Metadata    map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Acls        []*ACL            `protobuf:"bytes,8,rep,name=acls,proto3" json:"acls,omitempty"`
+	Key string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Raw []byte                `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	Map map[string]*anypb.Any `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// expiresAt is the time the item is deleted at. Items without it never expire.
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *AccessToken) Reset() {
-	*x = AccessToken{}
+func (x *Item) Reset() {
+	*x = Item{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -471,13 +684,13 @@ func (x *AccessToken) Reset() {
 	}
 }
 
-func (x *AccessToken) String() string {
+func (x *Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessToken) ProtoMessage() {}
+func (*Item) ProtoMessage() {}
 
-func (x *AccessToken) ProtoReflect() protoreflect.Message {
+func (x *Item) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -489,78 +702,53 @@ func (x *AccessToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
-func (*AccessToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AccessToken) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *AccessToken) GetReferenceID() string {
-	if x != nil {
-		return x.ReferenceID
-	}
-	return ""
-}
-
-func (x *AccessToken) GetNamespace() string {
+func (x *Item) GetKey() string {
 	if x != nil {
-		return x.Namespace
+		return x.Key
 	}
 	return ""
 }
 
-func (x *AccessToken) GetCreatedAt() int64 {
+func (x *Item) GetRaw() []byte {
 	if x != nil {
-		return x.CreatedAt
+		return x.Raw
 	}
-	return 0
+	return nil
 }
 
-func (x *AccessToken) GetEnabledAt() int64 {
+func (x *Item) GetMap() map[string]*anypb.Any {
 	if x != nil {
-		return x.EnabledAt
+		return x.Map
 	}
-	return 0
+	return nil
 }
 
-func (x *AccessToken) GetExpiresAt() int64 {
+func (x *Item) GetExpiresAt() int64 {
 	if x != nil {
 		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *AccessToken) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *AccessToken) GetAcls() []*ACL {
-	if x != nil {
-		return x.Acls
-	}
-	return nil
-}
-
-type AuthTokenLookupRequest struct {
+// ACL is an access control list for an access token.
+type ACL struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenID          string `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
-	TokenReferenceID string `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
+	Path        string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Permissions []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=kstash.v1.Permission" json:"permissions,omitempty"`
+	// conditions restrict the requests the ACL applies to.
+	Conditions *ACLConditions `protobuf:"bytes,3,opt,name=conditions,proto3" json:"conditions,omitempty"`
 }
 
-func (x *AuthTokenLookupRequest) Reset() {
-	*x = AuthTokenLookupRequest{}
+func (x *ACL) Reset() {
+	*x = ACL{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,13 +756,13 @@ func (x *AuthTokenLookupRequest) Reset() {
 	}
 }
 
-func (x *AuthTokenLookupRequest) String() string {
+func (x *ACL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthTokenLookupRequest) ProtoMessage() {}
+func (*ACL) ProtoMessage() {}
 
-func (x *AuthTokenLookupRequest) ProtoReflect() protoreflect.Message {
+func (x *ACL) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,35 +774,49 @@ func (x *AuthTokenLookupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthTokenLookupRequest.ProtoReflect.Descriptor instead.
-func (*AuthTokenLookupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ACL.ProtoReflect.Descriptor instead.
+func (*ACL) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AuthTokenLookupRequest) GetTokenID() string {
+func (x *ACL) GetPath() string {
 	if x != nil {
-		return x.TokenID
+		return x.Path
 	}
 	return ""
 }
 
-func (x *AuthTokenLookupRequest) GetTokenReferenceID() string {
+func (x *ACL) GetPermissions() []Permission {
 	if x != nil {
-		return x.TokenReferenceID
+		return x.Permissions
 	}
-	return ""
+	return nil
 }
 
-type AuthTokenLookupResponse struct {
+func (x *ACL) GetConditions() *ACLConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// ACLConditions restrict the requests an ACL applies to. Every condition that is set must be met.
+type ACLConditions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token *AccessToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// cidrs are the networks of the clients the ACL applies to, e.g. `10.0.0.0/8`.
+	Cidrs []string `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	// timeWindows are the times of day the ACL applies in. A request must be made in one of them.
+	TimeWindows []*TimeWindow `protobuf:"bytes,2,rep,name=timeWindows,proto3" json:"timeWindows,omitempty"`
+	// requiredMetadata are the keys of the metadata a request must carry, e.g. `x-change-ticket`.
+	// If a key has a value, the metadata must have that value, otherwise any value is accepted.
+	RequiredMetadata map[string]string `protobuf:"bytes,3,rep,name=requiredMetadata,proto3" json:"requiredMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *AuthTokenLookupResponse) Reset() {
-	*x = AuthTokenLookupResponse{}
+func (x *ACLConditions) Reset() {
+	*x = ACLConditions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,13 +824,13 @@ func (x *AuthTokenLookupResponse) Reset() {
 	}
 }
 
-func (x *AuthTokenLookupResponse) String() string {
+func (x *ACLConditions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthTokenLookupResponse) ProtoMessage() {}
+func (*ACLConditions) ProtoMessage() {}
 
-func (x *AuthTokenLookupResponse) ProtoReflect() protoreflect.Message {
+func (x *ACLConditions) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -640,30 +842,46 @@ func (x *AuthTokenLookupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthTokenLookupResponse.ProtoReflect.Descriptor instead.
-func (*AuthTokenLookupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ACLConditions.ProtoReflect.Descriptor instead.
+func (*ACLConditions) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AuthTokenLookupResponse) GetToken() *AccessToken {
+func (x *ACLConditions) GetCidrs() []string {
 	if x != nil {
-		return x.Token
+		return x.Cidrs
 	}
 	return nil
 }
 
-type AuthTokenRenewRequest struct {
+func (x *ACLConditions) GetTimeWindows() []*TimeWindow {
+	if x != nil {
+		return x.TimeWindows
+	}
+	return nil
+}
+
+func (x *ACLConditions) GetRequiredMetadata() map[string]string {
+	if x != nil {
+		return x.RequiredMetadata
+	}
+	return nil
+}
+
+// TimeWindow is a time of day window, e.g. from `09:00` to `17:00`. Windows that end before they start span midnight.
+type TimeWindow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenID          string `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
-	TokenReferenceID string `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
-	Ttl              string `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// timezone is the IANA name of the timezone of the window, e.g. `Europe/Warsaw`. It defaults to UTC.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
 }
 
-func (x *AuthTokenRenewRequest) Reset() {
-	*x = AuthTokenRenewRequest{}
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -671,13 +889,13 @@ func (x *AuthTokenRenewRequest) Reset() {
 	}
 }
 
-func (x *AuthTokenRenewRequest) String() string {
+func (x *TimeWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthTokenRenewRequest) ProtoMessage() {}
+func (*TimeWindow) ProtoMessage() {}
 
-func (x *AuthTokenRenewRequest) ProtoReflect() protoreflect.Message {
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -689,42 +907,51 @@ func (x *AuthTokenRenewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthTokenRenewRequest.ProtoReflect.Descriptor instead.
-func (*AuthTokenRenewRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AuthTokenRenewRequest) GetTokenID() string {
+func (x *TimeWindow) GetStart() string {
 	if x != nil {
-		return x.TokenID
+		return x.Start
 	}
 	return ""
 }
 
-func (x *AuthTokenRenewRequest) GetTokenReferenceID() string {
+func (x *TimeWindow) GetEnd() string {
 	if x != nil {
-		return x.TokenReferenceID
+		return x.End
 	}
 	return ""
 }
 
-func (x *AuthTokenRenewRequest) GetTtl() string {
+func (x *TimeWindow) GetTimezone() string {
 	if x != nil {
-		return x.Ttl
+		return x.Timezone
 	}
 	return ""
 }
 
-type AuthTokenRenewResponse struct {
+// ACLRule is an ACL of an access token as considered when explaining its permissions.
+type ACLRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token *AccessToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Acl *ACL `protobuf:"bytes,1,opt,name=acl,proto3" json:"acl,omitempty"`
+	// source is where the ACL comes from, either `token` or `policy/<name>`.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// matched is whether the path of the ACL matches the explained path.
+	Matched bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// applied is whether the ACL is one of the most specific ACLs that match the path, which are the only ones that apply.
+	Applied bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	// conditionFailure describes why the request does not meet the conditions of the ACL, if it does not.
+	ConditionFailure string `protobuf:"bytes,5,opt,name=conditionFailure,proto3" json:"conditionFailure,omitempty"`
 }
 
-func (x *AuthTokenRenewResponse) Reset() {
-	*x = AuthTokenRenewResponse{}
+func (x *ACLRule) Reset() {
+	*x = ACLRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,13 +959,13 @@ func (x *AuthTokenRenewResponse) Reset() {
 	}
 }
 
-func (x *AuthTokenRenewResponse) String() string {
+func (x *ACLRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthTokenRenewResponse) ProtoMessage() {}
+func (*ACLRule) ProtoMessage() {}
 
-func (x *AuthTokenRenewResponse) ProtoReflect() protoreflect.Message {
+func (x *ACLRule) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -750,29 +977,67 @@ func (x *AuthTokenRenewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthTokenRenewResponse.ProtoReflect.Descriptor instead.
-func (*AuthTokenRenewResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ACLRule.ProtoReflect.Descriptor instead.
+func (*ACLRule) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AuthTokenRenewResponse) GetToken() *AccessToken {
+func (x *ACLRule) GetAcl() *ACL {
 	if x != nil {
-		return x.Token
+		return x.Acl
 	}
 	return nil
 }
 
-type AuthTokenRevokeRequest struct {
+func (x *ACLRule) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ACLRule) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *ACLRule) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ACLRule) GetConditionFailure() string {
+	if x != nil {
+		return x.ConditionFailure
+	}
+	return ""
+}
+
+// ACLExplanation explains whether an access token has a permission on a path.
+type ACLExplanation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenID          string `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
-	TokenReferenceID string `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
-}
-
-func (x *AuthTokenRevokeRequest) Reset() {
-	*x = AuthTokenRevokeRequest{}
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// reason describes why the permission is granted or not.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// matchedRule is the rule that decided, if there is one.
+	MatchedRule *ACLRule `protobuf:"bytes,3,opt,name=matchedRule,proto3" json:"matchedRule,omitempty"`
+	// rules are all ACLs of the token, the most specific matching ones first, followed by the ones that do not match in the order of the token.
+	Rules []*ACLRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	// deny is the DENY rule that applied, if there is one.
+	Deny *ACLRule `protobuf:"bytes,5,opt,name=deny,proto3" json:"deny,omitempty"`
+	// effectivePermissions are the permissions of the token on the path without evaluating the conditions of its ACLs.
+	EffectivePermissions []Permission `protobuf:"varint,6,rep,packed,name=effectivePermissions,proto3,enum=kstash.v1.Permission" json:"effectivePermissions,omitempty"`
+}
+
+func (x *ACLExplanation) Reset() {
+	*x = ACLExplanation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -780,13 +1045,13 @@ func (x *AuthTokenRevokeRequest) Reset() {
 	}
 }
 
-func (x *AuthTokenRevokeRequest) String() string {
+func (x *ACLExplanation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthTokenRevokeRequest) ProtoMessage() {}
+func (*ACLExplanation) ProtoMessage() {}
 
-func (x *AuthTokenRevokeRequest) ProtoReflect() protoreflect.Message {
+func (x *ACLExplanation) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -798,33 +1063,73 @@ func (x *AuthTokenRevokeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthTokenRevokeRequest.ProtoReflect.Descriptor instead.
-func (*AuthTokenRevokeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ACLExplanation.ProtoReflect.Descriptor instead.
+func (*ACLExplanation) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AuthTokenRevokeRequest) GetTokenID() string {
+func (x *ACLExplanation) GetAllowed() bool {
 	if x != nil {
-		return x.TokenID
+		return x.Allowed
 	}
-	return ""
+	return false
 }
 
-func (x *AuthTokenRevokeRequest) GetTokenReferenceID() string {
+func (x *ACLExplanation) GetReason() string {
 	if x != nil {
-		return x.TokenReferenceID
+		return x.Reason
 	}
 	return ""
 }
 
-type AuthTokenRevokeResponse struct {
+func (x *ACLExplanation) GetMatchedRule() *ACLRule {
+	if x != nil {
+		return x.MatchedRule
+	}
+	return nil
+}
+
+func (x *ACLExplanation) GetRules() []*ACLRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ACLExplanation) GetDeny() *ACLRule {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
+func (x *ACLExplanation) GetEffectivePermissions() []Permission {
+	if x != nil {
+		return x.EffectivePermissions
+	}
+	return nil
+}
+
+// AccessToken an access token used for authentication and authorization.
+type AccessToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReferenceID string            `protobuf:"bytes,2,opt,name=referenceID,proto3" json:"referenceID,omitempty"`
+	Namespace   string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CreatedAt   int64             `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	EnabledAt   int64             `protobuf:"varint,5,opt,name=enabledAt,proto3" json:"enabledAt,omitempty"`
+	ExpiresAt   int64             `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Metadata    map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Acls        []*ACL            `protobuf:"bytes,8,rep,name=acls,proto3" json:"acls,omitempty"`
+	// policies are the names of the policies whose ACLs apply to the token in addition to its own.
+	Policies []string `protobuf:"bytes,9,rep,name=policies,proto3" json:"policies,omitempty"`
 }
 
-func (x *AuthTokenRevokeResponse) Reset() {
-	*x = AuthTokenRevokeResponse{}
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,13 +1137,13 @@ func (x *AuthTokenRevokeResponse) Reset() {
 	}
 }
 
-func (x *AuthTokenRevokeResponse) String() string {
+func (x *AccessToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthTokenRevokeResponse) ProtoMessage() {}
+func (*AccessToken) ProtoMessage() {}
 
-func (x *AuthTokenRevokeResponse) ProtoReflect() protoreflect.Message {
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -850,83 +1155,101 @@ func (x *AuthTokenRevokeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthTokenRevokeResponse.ProtoReflect.Descriptor instead.
-func (*AuthTokenRevokeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessToken) Descriptor() ([]byte, []int) {
 	return file_kstash_proto_rawDescGZIP(), []int{11}
 }
 
-type KVListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AccessToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+func (x *AccessToken) GetReferenceID() string {
+	if x != nil {
+		return x.ReferenceID
+	}
+	return ""
 }
 
-func (x *KVListRequest) Reset() {
-	*x = KVListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccessToken) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
 	}
+	return ""
 }
 
-func (x *KVListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccessToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (*KVListRequest) ProtoMessage() {}
+func (x *AccessToken) GetEnabledAt() int64 {
+	if x != nil {
+		return x.EnabledAt
+	}
+	return 0
+}
 
-func (x *KVListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccessToken) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use KVListRequest.ProtoReflect.Descriptor instead.
-func (*KVListRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{12}
+func (x *AccessToken) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *KVListRequest) GetPath() string {
+func (x *AccessToken) GetAcls() []*ACL {
 	if x != nil {
-		return x.Path
+		return x.Acls
 	}
-	return ""
+	return nil
 }
 
-type KVListResponse struct {
+func (x *AccessToken) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// Policy is a named set of ACLs that access tokens reference by name, so they can be changed for all of their tokens at once.
+type Policy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Acls []*ACL `protobuf:"bytes,2,rep,name=acls,proto3" json:"acls,omitempty"`
 }
 
-func (x *KVListResponse) Reset() {
-	*x = KVListResponse{}
+func (x *Policy) Reset() {
+	*x = Policy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[13]
+		mi := &file_kstash_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KVListResponse) String() string {
+func (x *Policy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVListResponse) ProtoMessage() {}
+func (*Policy) ProtoMessage() {}
 
-func (x *KVListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[13]
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,43 +1260,58 @@ func (x *KVListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVListResponse.ProtoReflect.Descriptor instead.
-func (*KVListResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *KVListResponse) GetPaths() []string {
+func (x *Policy) GetName() string {
 	if x != nil {
-		return x.Paths
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Policy) GetAcls() []*ACL {
+	if x != nil {
+		return x.Acls
 	}
 	return nil
 }
 
-type KVGetRequest struct {
+// GatekeeperToken holds the encrypted gatekeeper key of a gatekeeper token along with its metadata.
+type GatekeeperToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Accessor      string `protobuf:"bytes,1,opt,name=accessor,proto3" json:"accessor,omitempty"`
+	Key           []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Creator       string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt     int64  `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	NumUses       uint32 `protobuf:"varint,7,opt,name=numUses,proto3" json:"numUses,omitempty"`
+	UsesRemaining uint32 `protobuf:"varint,8,opt,name=usesRemaining,proto3" json:"usesRemaining,omitempty"`
 }
 
-func (x *KVGetRequest) Reset() {
-	*x = KVGetRequest{}
+func (x *GatekeeperToken) Reset() {
+	*x = GatekeeperToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[14]
+		mi := &file_kstash_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KVGetRequest) String() string {
+func (x *GatekeeperToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVGetRequest) ProtoMessage() {}
+func (*GatekeeperToken) ProtoMessage() {}
 
-func (x *KVGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[14]
+func (x *GatekeeperToken) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,90 +1322,95 @@ func (x *KVGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVGetRequest.ProtoReflect.Descriptor instead.
-func (*KVGetRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GatekeeperToken.ProtoReflect.Descriptor instead.
+func (*GatekeeperToken) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *KVGetRequest) GetPath() string {
+func (x *GatekeeperToken) GetAccessor() string {
 	if x != nil {
-		return x.Path
+		return x.Accessor
 	}
 	return ""
 }
 
-type KVGetResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+func (x *GatekeeperToken) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
 }
 
-func (x *KVGetResponse) Reset() {
-	*x = KVGetResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GatekeeperToken) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
+	return ""
 }
 
-func (x *KVGetResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GatekeeperToken) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
 }
 
-func (*KVGetResponse) ProtoMessage() {}
+func (x *GatekeeperToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
 
-func (x *KVGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GatekeeperToken) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use KVGetResponse.ProtoReflect.Descriptor instead.
-func (*KVGetResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{15}
+func (x *GatekeeperToken) GetNumUses() uint32 {
+	if x != nil {
+		return x.NumUses
+	}
+	return 0
 }
 
-func (x *KVGetResponse) GetItem() *Item {
+func (x *GatekeeperToken) GetUsesRemaining() uint32 {
 	if x != nil {
-		return x.Item
+		return x.UsesRemaining
 	}
-	return nil
+	return 0
 }
 
-type KVPutRequest struct {
+// LeaderInfo describes the active node of a highly available deployment.
+type LeaderInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	NodeID    string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ElectedAt int64  `protobuf:"varint,3,opt,name=electedAt,proto3" json:"electedAt,omitempty"`
 }
 
-func (x *KVPutRequest) Reset() {
-	*x = KVPutRequest{}
+func (x *LeaderInfo) Reset() {
+	*x = LeaderInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[16]
+		mi := &file_kstash_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KVPutRequest) String() string {
+func (x *LeaderInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVPutRequest) ProtoMessage() {}
+func (*LeaderInfo) ProtoMessage() {}
 
-func (x *KVPutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[16]
+func (x *LeaderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,41 +1421,60 @@ func (x *KVPutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVPutRequest.ProtoReflect.Descriptor instead.
-func (*KVPutRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use LeaderInfo.ProtoReflect.Descriptor instead.
+func (*LeaderInfo) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *KVPutRequest) GetItem() *Item {
+func (x *LeaderInfo) GetNodeID() string {
 	if x != nil {
-		return x.Item
+		return x.NodeID
 	}
-	return nil
+	return ""
 }
 
-type KVPutResponse struct {
+func (x *LeaderInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LeaderInfo) GetElectedAt() int64 {
+	if x != nil {
+		return x.ElectedAt
+	}
+	return 0
+}
+
+// SnapshotHeader describes a snapshot archive of the encrypted store.
+type SnapshotHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version   uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	BarrierID []byte                 `protobuf:"bytes,2,opt,name=barrierID,proto3" json:"barrierID,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
 }
 
-func (x *KVPutResponse) Reset() {
-	*x = KVPutResponse{}
+func (x *SnapshotHeader) Reset() {
+	*x = SnapshotHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[17]
+		mi := &file_kstash_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KVPutResponse) String() string {
+func (x *SnapshotHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVPutResponse) ProtoMessage() {}
+func (*SnapshotHeader) ProtoMessage() {}
 
-func (x *KVPutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[17]
+func (x *SnapshotHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,36 +1485,70 @@ func (x *KVPutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVPutResponse.ProtoReflect.Descriptor instead.
-func (*KVPutResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SnapshotHeader.ProtoReflect.Descriptor instead.
+func (*SnapshotHeader) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{15}
 }
 
-type KVDeleteRequest struct {
+func (x *SnapshotHeader) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SnapshotHeader) GetBarrierID() []byte {
+	if x != nil {
+		return x.BarrierID
+	}
+	return nil
+}
+
+func (x *SnapshotHeader) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+// KVMetadata describes a KV item. It is stored separately from the item's value, so it can be read without being allowed to read the value.
+type KVMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-}
-
-func (x *KVDeleteRequest) Reset() {
-	*x = KVDeleteRequest{}
+	Path        string            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Owner       string            `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// rotationDueAt is the time the item's value is due to be rotated.
+	RotationDueAt int64 `protobuf:"varint,5,opt,name=rotationDueAt,proto3" json:"rotationDueAt,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// createdBy is the reference ID of the token that created the item.
+	CreatedBy string `protobuf:"bytes,7,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// updatedBy is the reference ID of the token that last put the item.
+	UpdatedBy string `protobuf:"bytes,9,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,10,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *KVMetadata) Reset() {
+	*x = KVMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[18]
+		mi := &file_kstash_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KVDeleteRequest) String() string {
+func (x *KVMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVDeleteRequest) ProtoMessage() {}
+func (*KVMetadata) ProtoMessage() {}
 
-func (x *KVDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[18]
+func (x *KVMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,86 +1559,118 @@ func (x *KVDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVDeleteRequest.ProtoReflect.Descriptor instead.
-func (*KVDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use KVMetadata.ProtoReflect.Descriptor instead.
+func (*KVMetadata) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *KVDeleteRequest) GetPath() string {
+func (x *KVMetadata) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-type KVDeleteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *KVMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
 }
 
-func (x *KVDeleteResponse) Reset() {
-	*x = KVDeleteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *KVMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
+	return ""
 }
 
-func (x *KVDeleteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *KVMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
-func (*KVDeleteResponse) ProtoMessage() {}
+func (x *KVMetadata) GetRotationDueAt() int64 {
+	if x != nil {
+		return x.RotationDueAt
+	}
+	return 0
+}
 
-func (x *KVDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *KVMetadata) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use KVDeleteResponse.ProtoReflect.Descriptor instead.
-func (*KVDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{19}
+func (x *KVMetadata) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-type SystemGenerateAccessTokenRequest struct {
+func (x *KVMetadata) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *KVMetadata) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *KVMetadata) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Lease tracks the lifetime of a dynamic secret. Revoking the lease, or the token that requested it, revokes the secret.
+type Lease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessKey string            `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
-	Namespace string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	EnabledAt int64             `protobuf:"varint,3,opt,name=enabledAt,proto3" json:"enabledAt,omitempty"`
-	ExpiresAt int64             `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
-	Metadata  map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Acls      []*ACL            `protobuf:"bytes,6,rep,name=acls,proto3" json:"acls,omitempty"`
-}
-
-func (x *SystemGenerateAccessTokenRequest) Reset() {
-	*x = SystemGenerateAccessTokenRequest{}
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// engine that issued the secret and revokes it when the lease ends.
+	Engine           string `protobuf:"bytes,3,opt,name=engine,proto3" json:"engine,omitempty"`
+	TokenReferenceID string `protobuf:"bytes,4,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
+	IssuedAt         int64  `protobuf:"varint,5,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt        int64  `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// data holds what the engine needs to revoke the secret.
+	Data map[string]string `protobuf:"bytes,7,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// maxExpiresAt is the latest time the lease can be renewed to. The lease can be renewed as long as its token is valid if it is not set.
+	MaxExpiresAt int64 `protobuf:"varint,8,opt,name=maxExpiresAt,proto3" json:"maxExpiresAt,omitempty"`
+	RenewedAt    int64 `protobuf:"varint,9,opt,name=renewedAt,proto3" json:"renewedAt,omitempty"`
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[20]
+		mi := &file_kstash_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemGenerateAccessTokenRequest) String() string {
+func (x *Lease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemGenerateAccessTokenRequest) ProtoMessage() {}
+func (*Lease) ProtoMessage() {}
 
-func (x *SystemGenerateAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[20]
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,78 +1681,107 @@ func (x *SystemGenerateAccessTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemGenerateAccessTokenRequest.ProtoReflect.Descriptor instead.
-func (*SystemGenerateAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SystemGenerateAccessTokenRequest) GetAccessKey() string {
+func (x *Lease) GetId() string {
 	if x != nil {
-		return x.AccessKey
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SystemGenerateAccessTokenRequest) GetNamespace() string {
+func (x *Lease) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *SystemGenerateAccessTokenRequest) GetEnabledAt() int64 {
+func (x *Lease) GetEngine() string {
 	if x != nil {
-		return x.EnabledAt
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *Lease) GetTokenReferenceID() string {
+	if x != nil {
+		return x.TokenReferenceID
+	}
+	return ""
+}
+
+func (x *Lease) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
 	}
 	return 0
 }
 
-func (x *SystemGenerateAccessTokenRequest) GetExpiresAt() int64 {
+func (x *Lease) GetExpiresAt() int64 {
 	if x != nil {
 		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *SystemGenerateAccessTokenRequest) GetMetadata() map[string]string {
+func (x *Lease) GetData() map[string]string {
 	if x != nil {
-		return x.Metadata
+		return x.Data
 	}
 	return nil
 }
 
-func (x *SystemGenerateAccessTokenRequest) GetAcls() []*ACL {
+func (x *Lease) GetMaxExpiresAt() int64 {
 	if x != nil {
-		return x.Acls
+		return x.MaxExpiresAt
 	}
-	return nil
+	return 0
 }
 
-type SystemGenerateAccessTokenResponse struct {
+func (x *Lease) GetRenewedAt() int64 {
+	if x != nil {
+		return x.RenewedAt
+	}
+	return 0
+}
+
+// DatabaseConnection describes how the database engine connects to a database to manage its users.
+type DatabaseConnection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token *AccessToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// plugin is the type of database, either `postgresql` or `mysql`.
+	Plugin string `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// driver is the name of the database/sql driver. The plugin's default driver is used if it is empty.
+	Driver        string `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	ConnectionURL string `protobuf:"bytes,4,opt,name=connectionURL,proto3" json:"connectionURL,omitempty"`
+	// allowedRoles are the roles allowed to use the connection. `*` allows all roles.
+	AllowedRoles []string `protobuf:"bytes,5,rep,name=allowedRoles,proto3" json:"allowedRoles,omitempty"`
 }
 
-func (x *SystemGenerateAccessTokenResponse) Reset() {
-	*x = SystemGenerateAccessTokenResponse{}
+func (x *DatabaseConnection) Reset() {
+	*x = DatabaseConnection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[21]
+		mi := &file_kstash_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemGenerateAccessTokenResponse) String() string {
+func (x *DatabaseConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemGenerateAccessTokenResponse) ProtoMessage() {}
+func (*DatabaseConnection) ProtoMessage() {}
 
-func (x *SystemGenerateAccessTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[21]
+func (x *DatabaseConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,43 +1792,79 @@ func (x *SystemGenerateAccessTokenResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemGenerateAccessTokenResponse.ProtoReflect.Descriptor instead.
-func (*SystemGenerateAccessTokenResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DatabaseConnection.ProtoReflect.Descriptor instead.
+func (*DatabaseConnection) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SystemGenerateAccessTokenResponse) GetToken() *AccessToken {
+func (x *DatabaseConnection) GetName() string {
 	if x != nil {
-		return x.Token
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetConnectionURL() string {
+	if x != nil {
+		return x.ConnectionURL
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetAllowedRoles() []string {
+	if x != nil {
+		return x.AllowedRoles
 	}
 	return nil
 }
 
-type SystemGenerateGatekeeperTokenRequest struct {
+// DatabaseRole describes the users created by the database engine.
+type DatabaseRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnsealKeys []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Connection string `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
+	// creationStatements create a user. `{{name}}`, `{{password}}`, and `{{expiration}}` are replaced with the user's details.
+	CreationStatements []string `protobuf:"bytes,3,rep,name=creationStatements,proto3" json:"creationStatements,omitempty"`
+	// revocationStatements drop a user. `{{name}}` is replaced with the user's name.
+	RevocationStatements []string `protobuf:"bytes,4,rep,name=revocationStatements,proto3" json:"revocationStatements,omitempty"`
+	Ttl                  int64    `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxTTL               int64    `protobuf:"varint,6,opt,name=maxTTL,proto3" json:"maxTTL,omitempty"`
 }
 
-func (x *SystemGenerateGatekeeperTokenRequest) Reset() {
-	*x = SystemGenerateGatekeeperTokenRequest{}
+func (x *DatabaseRole) Reset() {
+	*x = DatabaseRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[22]
+		mi := &file_kstash_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemGenerateGatekeeperTokenRequest) String() string {
+func (x *DatabaseRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemGenerateGatekeeperTokenRequest) ProtoMessage() {}
+func (*DatabaseRole) ProtoMessage() {}
 
-func (x *SystemGenerateGatekeeperTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[22]
+func (x *DatabaseRole) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1382,43 +1875,80 @@ func (x *SystemGenerateGatekeeperTokenRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemGenerateGatekeeperTokenRequest.ProtoReflect.Descriptor instead.
-func (*SystemGenerateGatekeeperTokenRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use DatabaseRole.ProtoReflect.Descriptor instead.
+func (*DatabaseRole) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SystemGenerateGatekeeperTokenRequest) GetUnsealKeys() []string {
+func (x *DatabaseRole) GetName() string {
 	if x != nil {
-		return x.UnsealKeys
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseRole) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *DatabaseRole) GetCreationStatements() []string {
+	if x != nil {
+		return x.CreationStatements
 	}
 	return nil
 }
 
-type SystemGenerateGatekeeperTokenResponse struct {
+func (x *DatabaseRole) GetRevocationStatements() []string {
+	if x != nil {
+		return x.RevocationStatements
+	}
+	return nil
+}
+
+func (x *DatabaseRole) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *DatabaseRole) GetMaxTTL() int64 {
+	if x != nil {
+		return x.MaxTTL
+	}
+	return 0
+}
+
+// PKICA is the certificate authority of a namespace's PKI engine along with its PEM encoded private key.
+type PKICA struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey  string `protobuf:"bytes,2,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
 }
 
-func (x *SystemGenerateGatekeeperTokenResponse) Reset() {
-	*x = SystemGenerateGatekeeperTokenResponse{}
+func (x *PKICA) Reset() {
+	*x = PKICA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[23]
+		mi := &file_kstash_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemGenerateGatekeeperTokenResponse) String() string {
+func (x *PKICA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemGenerateGatekeeperTokenResponse) ProtoMessage() {}
+func (*PKICA) ProtoMessage() {}
 
-func (x *SystemGenerateGatekeeperTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[23]
+func (x *PKICA) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,45 +1959,58 @@ func (x *SystemGenerateGatekeeperTokenResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemGenerateGatekeeperTokenResponse.ProtoReflect.Descriptor instead.
-func (*SystemGenerateGatekeeperTokenResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PKICA.ProtoReflect.Descriptor instead.
+func (*PKICA) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SystemGenerateGatekeeperTokenResponse) GetGatekeeperToken() string {
+func (x *PKICA) GetCertificate() string {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.Certificate
 	}
 	return ""
 }
 
-type SystemInitializeRequest struct {
+func (x *PKICA) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+// PKIRole restricts the certificates the PKI engine issues. TTLs are in seconds, and zero disables a limit.
+type PKIRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumUnsealKeys           uint32 `protobuf:"varint,1,opt,name=numUnsealKeys,proto3" json:"numUnsealKeys,omitempty"`
-	UnsealKeyThreshold      uint32 `protobuf:"varint,2,opt,name=unsealKeyThreshold,proto3" json:"unsealKeyThreshold,omitempty"`
-	GenerateGatekeeperToken bool   `protobuf:"varint,3,opt,name=generateGatekeeperToken,proto3" json:"generateGatekeeperToken,omitempty"`
+	Name             string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AllowedDomains   []string   `protobuf:"bytes,2,rep,name=allowedDomains,proto3" json:"allowedDomains,omitempty"`
+	AllowBareDomains bool       `protobuf:"varint,3,opt,name=allowBareDomains,proto3" json:"allowBareDomains,omitempty"`
+	AllowSubdomains  bool       `protobuf:"varint,4,opt,name=allowSubdomains,proto3" json:"allowSubdomains,omitempty"`
+	AllowIPSANs      bool       `protobuf:"varint,5,opt,name=allowIPSANs,proto3" json:"allowIPSANs,omitempty"`
+	KeyType          PKIKeyType `protobuf:"varint,6,opt,name=keyType,proto3,enum=kstash.v1.PKIKeyType" json:"keyType,omitempty"`
+	Ttl              int64      `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxTTL           int64      `protobuf:"varint,8,opt,name=maxTTL,proto3" json:"maxTTL,omitempty"`
 }
 
-func (x *SystemInitializeRequest) Reset() {
-	*x = SystemInitializeRequest{}
+func (x *PKIRole) Reset() {
+	*x = PKIRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[24]
+		mi := &file_kstash_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemInitializeRequest) String() string {
+func (x *PKIRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInitializeRequest) ProtoMessage() {}
+func (*PKIRole) ProtoMessage() {}
 
-func (x *SystemInitializeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[24]
+func (x *PKIRole) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,59 +2021,96 @@ func (x *SystemInitializeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInitializeRequest.ProtoReflect.Descriptor instead.
-func (*SystemInitializeRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PKIRole.ProtoReflect.Descriptor instead.
+func (*PKIRole) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SystemInitializeRequest) GetNumUnsealKeys() uint32 {
+func (x *PKIRole) GetName() string {
 	if x != nil {
-		return x.NumUnsealKeys
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemInitializeRequest) GetUnsealKeyThreshold() uint32 {
+func (x *PKIRole) GetAllowedDomains() []string {
 	if x != nil {
-		return x.UnsealKeyThreshold
+		return x.AllowedDomains
 	}
-	return 0
+	return nil
 }
 
-func (x *SystemInitializeRequest) GetGenerateGatekeeperToken() bool {
+func (x *PKIRole) GetAllowBareDomains() bool {
 	if x != nil {
-		return x.GenerateGatekeeperToken
+		return x.AllowBareDomains
 	}
 	return false
 }
 
-type SystemInitializeResponse struct {
+func (x *PKIRole) GetAllowSubdomains() bool {
+	if x != nil {
+		return x.AllowSubdomains
+	}
+	return false
+}
+
+func (x *PKIRole) GetAllowIPSANs() bool {
+	if x != nil {
+		return x.AllowIPSANs
+	}
+	return false
+}
+
+func (x *PKIRole) GetKeyType() PKIKeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return PKIKeyType_EC_P256
+}
+
+func (x *PKIRole) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *PKIRole) GetMaxTTL() int64 {
+	if x != nil {
+		return x.MaxTTL
+	}
+	return 0
+}
+
+// PKICertificate records a certificate issued by the PKI engine, which is needed to revoke it.
+type PKICertificate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
-	AccessKey       string   `protobuf:"bytes,2,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
-	GatekeeperToken string   `protobuf:"bytes,3,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	SerialNumber string `protobuf:"bytes,1,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+	Certificate  string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ExpiresAt    int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	RevokedAt    int64  `protobuf:"varint,4,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
 }
 
-func (x *SystemInitializeResponse) Reset() {
-	*x = SystemInitializeResponse{}
+func (x *PKICertificate) Reset() {
+	*x = PKICertificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[25]
+		mi := &file_kstash_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemInitializeResponse) String() string {
+func (x *PKICertificate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInitializeResponse) ProtoMessage() {}
+func (*PKICertificate) ProtoMessage() {}
 
-func (x *SystemInitializeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[25]
+func (x *PKICertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,57 +2121,69 @@ func (x *SystemInitializeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInitializeResponse.ProtoReflect.Descriptor instead.
-func (*SystemInitializeResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PKICertificate.ProtoReflect.Descriptor instead.
+func (*PKICertificate) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SystemInitializeResponse) GetUnsealKeys() []string {
+func (x *PKICertificate) GetSerialNumber() string {
 	if x != nil {
-		return x.UnsealKeys
+		return x.SerialNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemInitializeResponse) GetAccessKey() string {
+func (x *PKICertificate) GetCertificate() string {
 	if x != nil {
-		return x.AccessKey
+		return x.Certificate
 	}
 	return ""
 }
 
-func (x *SystemInitializeResponse) GetGatekeeperToken() string {
+func (x *PKICertificate) GetExpiresAt() int64 {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.ExpiresAt
 	}
-	return ""
+	return 0
 }
 
-type SystemPruneTokensRequest struct {
+func (x *PKICertificate) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+// PKIIssuedCertificate is a PEM encoded certificate issued by the PKI engine. The private key is only set if it was generated by K-Stash.
+type PKIIssuedCertificate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	SerialNumber  string `protobuf:"bytes,1,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+	Certificate   string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey    string `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	CaCertificate string `protobuf:"bytes,4,opt,name=caCertificate,proto3" json:"caCertificate,omitempty"`
+	ExpiresAt     int64  `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *SystemPruneTokensRequest) Reset() {
-	*x = SystemPruneTokensRequest{}
+func (x *PKIIssuedCertificate) Reset() {
+	*x = PKIIssuedCertificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[26]
+		mi := &file_kstash_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemPruneTokensRequest) String() string {
+func (x *PKIIssuedCertificate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemPruneTokensRequest) ProtoMessage() {}
+func (*PKIIssuedCertificate) ProtoMessage() {}
 
-func (x *SystemPruneTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[26]
+func (x *PKIIssuedCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,41 +2194,73 @@ func (x *SystemPruneTokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemPruneTokensRequest.ProtoReflect.Descriptor instead.
-func (*SystemPruneTokensRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PKIIssuedCertificate.ProtoReflect.Descriptor instead.
+func (*PKIIssuedCertificate) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SystemPruneTokensRequest) GetAccessKey() string {
+func (x *PKIIssuedCertificate) GetSerialNumber() string {
 	if x != nil {
-		return x.AccessKey
+		return x.SerialNumber
 	}
 	return ""
 }
 
-type SystemPruneTokensResponse struct {
+func (x *PKIIssuedCertificate) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *PKIIssuedCertificate) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *PKIIssuedCertificate) GetCaCertificate() string {
+	if x != nil {
+		return x.CaCertificate
+	}
+	return ""
+}
+
+func (x *PKIIssuedCertificate) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// SSHCA is the certificate authority of a namespace's SSH engine, with its PEM encoded private key and its public key in authorized_keys format.
+type SSHCA struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PrivateKey string `protobuf:"bytes,1,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	PublicKey  string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 }
 
-func (x *SystemPruneTokensResponse) Reset() {
-	*x = SystemPruneTokensResponse{}
+func (x *SSHCA) Reset() {
+	*x = SSHCA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[27]
+		mi := &file_kstash_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemPruneTokensResponse) String() string {
+func (x *SSHCA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemPruneTokensResponse) ProtoMessage() {}
+func (*SSHCA) ProtoMessage() {}
 
-func (x *SystemPruneTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[27]
+func (x *SSHCA) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,36 +2271,59 @@ func (x *SystemPruneTokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemPruneTokensResponse.ProtoReflect.Descriptor instead.
-func (*SystemPruneTokensResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SSHCA.ProtoReflect.Descriptor instead.
+func (*SSHCA) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{24}
 }
 
-type SystemRotateAccessKeyRequest struct {
+func (x *SSHCA) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHCA) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+// SSHRole restricts the SSH certificates a CA signs. Extensions and critical options are added to every user certificate signed for the role.
+// TTLs are in seconds, and zero disables a limit.
+type SSHRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	Name              string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CertType          SSHCertificateType `protobuf:"varint,2,opt,name=certType,proto3,enum=kstash.v1.SSHCertificateType" json:"certType,omitempty"`
+	AllowedPrincipals []string           `protobuf:"bytes,3,rep,name=allowedPrincipals,proto3" json:"allowedPrincipals,omitempty"`
+	DefaultPrincipals []string           `protobuf:"bytes,4,rep,name=defaultPrincipals,proto3" json:"defaultPrincipals,omitempty"`
+	Extensions        map[string]string  `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CriticalOptions   map[string]string  `protobuf:"bytes,6,rep,name=criticalOptions,proto3" json:"criticalOptions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ttl               int64              `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxTTL            int64              `protobuf:"varint,8,opt,name=maxTTL,proto3" json:"maxTTL,omitempty"`
 }
 
-func (x *SystemRotateAccessKeyRequest) Reset() {
-	*x = SystemRotateAccessKeyRequest{}
+func (x *SSHRole) Reset() {
+	*x = SSHRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[28]
+		mi := &file_kstash_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateAccessKeyRequest) String() string {
+func (x *SSHRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateAccessKeyRequest) ProtoMessage() {}
+func (*SSHRole) ProtoMessage() {}
 
-func (x *SystemRotateAccessKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[28]
+func (x *SSHRole) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,91 +2334,97 @@ func (x *SystemRotateAccessKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateAccessKeyRequest.ProtoReflect.Descriptor instead.
-func (*SystemRotateAccessKeyRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SSHRole.ProtoReflect.Descriptor instead.
+func (*SSHRole) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SystemRotateAccessKeyRequest) GetAccessKey() string {
+func (x *SSHRole) GetName() string {
 	if x != nil {
-		return x.AccessKey
+		return x.Name
 	}
 	return ""
 }
 
-type SystemRotateAccessKeyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+func (x *SSHRole) GetCertType() SSHCertificateType {
+	if x != nil {
+		return x.CertType
+	}
+	return SSHCertificateType_USER
 }
 
-func (x *SystemRotateAccessKeyResponse) Reset() {
-	*x = SystemRotateAccessKeyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SSHRole) GetAllowedPrincipals() []string {
+	if x != nil {
+		return x.AllowedPrincipals
 	}
+	return nil
 }
 
-func (x *SystemRotateAccessKeyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SSHRole) GetDefaultPrincipals() []string {
+	if x != nil {
+		return x.DefaultPrincipals
+	}
+	return nil
 }
 
-func (*SystemRotateAccessKeyResponse) ProtoMessage() {}
+func (x *SSHRole) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
 
-func (x *SystemRotateAccessKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SSHRole) GetCriticalOptions() map[string]string {
+	if x != nil {
+		return x.CriticalOptions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SystemRotateAccessKeyResponse.ProtoReflect.Descriptor instead.
-func (*SystemRotateAccessKeyResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{29}
+func (x *SSHRole) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
 }
 
-func (x *SystemRotateAccessKeyResponse) GetAccessKey() string {
+func (x *SSHRole) GetMaxTTL() int64 {
 	if x != nil {
-		return x.AccessKey
+		return x.MaxTTL
 	}
-	return ""
+	return 0
 }
 
-type SystemRotateEncryptionKeyRequest struct {
+// TransitKey is a named key of the transit engine. Rotating it adds a new version, which is used for all new ciphertexts,
+// signatures, and HMACs, while older versions are kept to decrypt and verify existing ones.
+type TransitKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
-	Renew           bool   `protobuf:"varint,2,opt,name=renew,proto3" json:"renew,omitempty"`
+	Name      string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type      CipherType           `protobuf:"varint,2,opt,name=type,proto3,enum=kstash.v1.CipherType" json:"type,omitempty"`
+	CreatedAt int64                `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Versions  []*TransitKeyVersion `protobuf:"bytes,4,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *SystemRotateEncryptionKeyRequest) Reset() {
-	*x = SystemRotateEncryptionKeyRequest{}
+func (x *TransitKey) Reset() {
+	*x = TransitKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[30]
+		mi := &file_kstash_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateEncryptionKeyRequest) String() string {
+func (x *TransitKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateEncryptionKeyRequest) ProtoMessage() {}
+func (*TransitKey) ProtoMessage() {}
 
-func (x *SystemRotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[30]
+func (x *TransitKey) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,48 +2435,70 @@ func (x *SystemRotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
-func (*SystemRotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use TransitKey.ProtoReflect.Descriptor instead.
+func (*TransitKey) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SystemRotateEncryptionKeyRequest) GetGatekeeperToken() string {
+func (x *TransitKey) GetName() string {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SystemRotateEncryptionKeyRequest) GetRenew() bool {
+func (x *TransitKey) GetType() CipherType {
 	if x != nil {
-		return x.Renew
+		return x.Type
 	}
-	return false
+	return CipherType_AES256_GCM
 }
 
-type SystemRotateEncryptionKeyResponse struct {
+func (x *TransitKey) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TransitKey) GetVersions() []*TransitKeyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// TransitKeyVersion holds the key material of a single version of a TransitKey.
+type TransitKeyVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version       uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	EncryptionKey []byte `protobuf:"bytes,2,opt,name=encryptionKey,proto3" json:"encryptionKey,omitempty"`
+	// signingKey is an ed25519 private key.
+	SigningKey []byte `protobuf:"bytes,3,opt,name=signingKey,proto3" json:"signingKey,omitempty"`
+	HmacKey    []byte `protobuf:"bytes,4,opt,name=hmacKey,proto3" json:"hmacKey,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *SystemRotateEncryptionKeyResponse) Reset() {
-	*x = SystemRotateEncryptionKeyResponse{}
+func (x *TransitKeyVersion) Reset() {
+	*x = TransitKeyVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[31]
+		mi := &file_kstash_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateEncryptionKeyResponse) String() string {
+func (x *TransitKeyVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateEncryptionKeyResponse) ProtoMessage() {}
+func (*TransitKeyVersion) ProtoMessage() {}
 
-func (x *SystemRotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[31]
+func (x *TransitKeyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1834,36 +2509,76 @@ func (x *SystemRotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
-func (*SystemRotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use TransitKeyVersion.ProtoReflect.Descriptor instead.
+func (*TransitKeyVersion) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{27}
 }
 
-type SystemRotateGatekeeperTokenRequest struct {
+func (x *TransitKeyVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TransitKeyVersion) GetEncryptionKey() []byte {
+	if x != nil {
+		return x.EncryptionKey
+	}
+	return nil
+}
+
+func (x *TransitKeyVersion) GetSigningKey() []byte {
+	if x != nil {
+		return x.SigningKey
+	}
+	return nil
+}
+
+func (x *TransitKeyVersion) GetHmacKey() []byte {
+	if x != nil {
+		return x.HmacKey
+	}
+	return nil
+}
+
+func (x *TransitKeyVersion) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// TransitKeyInfo describes a TransitKey without revealing its key material.
+type TransitKeyInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Name          string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CipherType          `protobuf:"varint,2,opt,name=type,proto3,enum=kstash.v1.CipherType" json:"type,omitempty"`
+	CreatedAt     int64               `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LatestVersion uint32              `protobuf:"varint,4,opt,name=latestVersion,proto3" json:"latestVersion,omitempty"`
+	PublicKeys    []*TransitPublicKey `protobuf:"bytes,5,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
 }
 
-func (x *SystemRotateGatekeeperTokenRequest) Reset() {
-	*x = SystemRotateGatekeeperTokenRequest{}
+func (x *TransitKeyInfo) Reset() {
+	*x = TransitKeyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[32]
+		mi := &file_kstash_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateGatekeeperTokenRequest) String() string {
+func (x *TransitKeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateGatekeeperTokenRequest) ProtoMessage() {}
+func (*TransitKeyInfo) ProtoMessage() {}
 
-func (x *SystemRotateGatekeeperTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[32]
+func (x *TransitKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1874,43 +2589,74 @@ func (x *SystemRotateGatekeeperTokenRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateGatekeeperTokenRequest.ProtoReflect.Descriptor instead.
-func (*SystemRotateGatekeeperTokenRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use TransitKeyInfo.ProtoReflect.Descriptor instead.
+func (*TransitKeyInfo) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SystemRotateGatekeeperTokenRequest) GetGatekeeperToken() string {
+func (x *TransitKeyInfo) GetName() string {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.Name
 	}
 	return ""
 }
 
-type SystemRotateGatekeeperTokenResponse struct {
+func (x *TransitKeyInfo) GetType() CipherType {
+	if x != nil {
+		return x.Type
+	}
+	return CipherType_AES256_GCM
+}
+
+func (x *TransitKeyInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TransitKeyInfo) GetLatestVersion() uint32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *TransitKeyInfo) GetPublicKeys() []*TransitPublicKey {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+// TransitPublicKey is the ed25519 public key of a TransitKeyVersion, which verifies the signatures made with that version.
+type TransitPublicKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Version   uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *SystemRotateGatekeeperTokenResponse) Reset() {
-	*x = SystemRotateGatekeeperTokenResponse{}
+func (x *TransitPublicKey) Reset() {
+	*x = TransitPublicKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[33]
+		mi := &file_kstash_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateGatekeeperTokenResponse) String() string {
+func (x *TransitPublicKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateGatekeeperTokenResponse) ProtoMessage() {}
+func (*TransitPublicKey) ProtoMessage() {}
 
-func (x *SystemRotateGatekeeperTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[33]
+func (x *TransitPublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1921,45 +2667,62 @@ func (x *SystemRotateGatekeeperTokenResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateGatekeeperTokenResponse.ProtoReflect.Descriptor instead.
-func (*SystemRotateGatekeeperTokenResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use TransitPublicKey.ProtoReflect.Descriptor instead.
+func (*TransitPublicKey) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SystemRotateGatekeeperTokenResponse) GetGatekeeperToken() string {
+func (x *TransitPublicKey) GetVersion() uint32 {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type SystemRotateUnsealKeysRequest struct {
+func (x *TransitPublicKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *TransitPublicKey) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ACLExplainRequest explains the access token of the request, the token with the given ID, or, with the access key, the token with the given reference ID.
+type ACLExplainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnsealKeys         []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
-	NumUnsealKeys      uint32   `protobuf:"varint,2,opt,name=numUnsealKeys,proto3" json:"numUnsealKeys,omitempty"`
-	UnsealKeyThreshold uint32   `protobuf:"varint,3,opt,name=unsealKeyThreshold,proto3" json:"unsealKeyThreshold,omitempty"`
+	TokenID          string     `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	TokenReferenceID string     `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
+	AccessKey        string     `protobuf:"bytes,3,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	Path             string     `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Permission       Permission `protobuf:"varint,5,opt,name=permission,proto3,enum=kstash.v1.Permission" json:"permission,omitempty"`
 }
 
-func (x *SystemRotateUnsealKeysRequest) Reset() {
-	*x = SystemRotateUnsealKeysRequest{}
+func (x *ACLExplainRequest) Reset() {
+	*x = ACLExplainRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[34]
+		mi := &file_kstash_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateUnsealKeysRequest) String() string {
+func (x *ACLExplainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateUnsealKeysRequest) ProtoMessage() {}
+func (*ACLExplainRequest) ProtoMessage() {}
 
-func (x *SystemRotateUnsealKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[34]
+func (x *ACLExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1970,57 +2733,71 @@ func (x *SystemRotateUnsealKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateUnsealKeysRequest.ProtoReflect.Descriptor instead.
-func (*SystemRotateUnsealKeysRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ACLExplainRequest.ProtoReflect.Descriptor instead.
+func (*ACLExplainRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SystemRotateUnsealKeysRequest) GetUnsealKeys() []string {
+func (x *ACLExplainRequest) GetTokenID() string {
 	if x != nil {
-		return x.UnsealKeys
+		return x.TokenID
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemRotateUnsealKeysRequest) GetNumUnsealKeys() uint32 {
+func (x *ACLExplainRequest) GetTokenReferenceID() string {
 	if x != nil {
-		return x.NumUnsealKeys
+		return x.TokenReferenceID
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemRotateUnsealKeysRequest) GetUnsealKeyThreshold() uint32 {
+func (x *ACLExplainRequest) GetAccessKey() string {
 	if x != nil {
-		return x.UnsealKeyThreshold
+		return x.AccessKey
 	}
-	return 0
+	return ""
 }
 
-type SystemRotateUnsealKeysResponse struct {
+func (x *ACLExplainRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ACLExplainRequest) GetPermission() Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return Permission_DENY
+}
+
+type ACLExplainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnsealKeys []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	Explanation *ACLExplanation `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`
 }
 
-func (x *SystemRotateUnsealKeysResponse) Reset() {
-	*x = SystemRotateUnsealKeysResponse{}
+func (x *ACLExplainResponse) Reset() {
+	*x = ACLExplainResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[35]
+		mi := &file_kstash_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRotateUnsealKeysResponse) String() string {
+func (x *ACLExplainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRotateUnsealKeysResponse) ProtoMessage() {}
+func (*ACLExplainResponse) ProtoMessage() {}
 
-func (x *SystemRotateUnsealKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[35]
+func (x *ACLExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,43 +2808,44 @@ func (x *SystemRotateUnsealKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRotateUnsealKeysResponse.ProtoReflect.Descriptor instead.
-func (*SystemRotateUnsealKeysResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ACLExplainResponse.ProtoReflect.Descriptor instead.
+func (*ACLExplainResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SystemRotateUnsealKeysResponse) GetUnsealKeys() []string {
+func (x *ACLExplainResponse) GetExplanation() *ACLExplanation {
 	if x != nil {
-		return x.UnsealKeys
+		return x.Explanation
 	}
 	return nil
 }
 
-type SystemRevokeGatekeeperTokenRequest struct {
+type AuthTokenLookupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	TokenID          string `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	TokenReferenceID string `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
 }
 
-func (x *SystemRevokeGatekeeperTokenRequest) Reset() {
-	*x = SystemRevokeGatekeeperTokenRequest{}
+func (x *AuthTokenLookupRequest) Reset() {
+	*x = AuthTokenLookupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[36]
+		mi := &file_kstash_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRevokeGatekeeperTokenRequest) String() string {
+func (x *AuthTokenLookupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRevokeGatekeeperTokenRequest) ProtoMessage() {}
+func (*AuthTokenLookupRequest) ProtoMessage() {}
 
-func (x *SystemRevokeGatekeeperTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[36]
+func (x *AuthTokenLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,41 +2856,50 @@ func (x *SystemRevokeGatekeeperTokenRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRevokeGatekeeperTokenRequest.ProtoReflect.Descriptor instead.
-func (*SystemRevokeGatekeeperTokenRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AuthTokenLookupRequest.ProtoReflect.Descriptor instead.
+func (*AuthTokenLookupRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SystemRevokeGatekeeperTokenRequest) GetGatekeeperToken() string {
+func (x *AuthTokenLookupRequest) GetTokenID() string {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.TokenID
 	}
 	return ""
 }
 
-type SystemRevokeGatekeeperTokenResponse struct {
+func (x *AuthTokenLookupRequest) GetTokenReferenceID() string {
+	if x != nil {
+		return x.TokenReferenceID
+	}
+	return ""
+}
+
+type AuthTokenLookupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token *AccessToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *SystemRevokeGatekeeperTokenResponse) Reset() {
-	*x = SystemRevokeGatekeeperTokenResponse{}
+func (x *AuthTokenLookupResponse) Reset() {
+	*x = AuthTokenLookupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[37]
+		mi := &file_kstash_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemRevokeGatekeeperTokenResponse) String() string {
+func (x *AuthTokenLookupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemRevokeGatekeeperTokenResponse) ProtoMessage() {}
+func (*AuthTokenLookupResponse) ProtoMessage() {}
 
-func (x *SystemRevokeGatekeeperTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[37]
+func (x *AuthTokenLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,37 +2910,45 @@ func (x *SystemRevokeGatekeeperTokenResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemRevokeGatekeeperTokenResponse.ProtoReflect.Descriptor instead.
-func (*SystemRevokeGatekeeperTokenResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use AuthTokenLookupResponse.ProtoReflect.Descriptor instead.
+func (*AuthTokenLookupResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{33}
 }
 
-type SystemSealRequest struct {
+func (x *AuthTokenLookupResponse) GetToken() *AccessToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type AuthTokenRenewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
-	Renew           bool   `protobuf:"varint,2,opt,name=renew,proto3" json:"renew,omitempty"`
+	TokenID          string `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	TokenReferenceID string `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
+	Ttl              string `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *SystemSealRequest) Reset() {
-	*x = SystemSealRequest{}
+func (x *AuthTokenRenewRequest) Reset() {
+	*x = AuthTokenRenewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[38]
+		mi := &file_kstash_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemSealRequest) String() string {
+func (x *AuthTokenRenewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemSealRequest) ProtoMessage() {}
+func (*AuthTokenRenewRequest) ProtoMessage() {}
 
-func (x *SystemSealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[38]
+func (x *AuthTokenRenewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,50 +2959,57 @@ func (x *SystemSealRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemSealRequest.ProtoReflect.Descriptor instead.
-func (*SystemSealRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use AuthTokenRenewRequest.ProtoReflect.Descriptor instead.
+func (*AuthTokenRenewRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SystemSealRequest) GetGatekeeperToken() string {
+func (x *AuthTokenRenewRequest) GetTokenID() string {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.TokenID
 	}
 	return ""
 }
 
-func (x *SystemSealRequest) GetRenew() bool {
+func (x *AuthTokenRenewRequest) GetTokenReferenceID() string {
 	if x != nil {
-		return x.Renew
+		return x.TokenReferenceID
 	}
-	return false
+	return ""
 }
 
-type SystemSealResponse struct {
+func (x *AuthTokenRenewRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type AuthTokenRenewResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sealed bool `protobuf:"varint,1,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	Token *AccessToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *SystemSealResponse) Reset() {
-	*x = SystemSealResponse{}
+func (x *AuthTokenRenewResponse) Reset() {
+	*x = AuthTokenRenewResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[39]
+		mi := &file_kstash_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemSealResponse) String() string {
+func (x *AuthTokenRenewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemSealResponse) ProtoMessage() {}
+func (*AuthTokenRenewResponse) ProtoMessage() {}
 
-func (x *SystemSealResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[39]
+func (x *AuthTokenRenewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,41 +3020,44 @@ func (x *SystemSealResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemSealResponse.ProtoReflect.Descriptor instead.
-func (*SystemSealResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use AuthTokenRenewResponse.ProtoReflect.Descriptor instead.
+func (*AuthTokenRenewResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SystemSealResponse) GetSealed() bool {
+func (x *AuthTokenRenewResponse) GetToken() *AccessToken {
 	if x != nil {
-		return x.Sealed
+		return x.Token
 	}
-	return false
+	return nil
 }
 
-type SystemStatusRequest struct {
+type AuthTokenRevokeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TokenID          string `protobuf:"bytes,1,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	TokenReferenceID string `protobuf:"bytes,2,opt,name=tokenReferenceID,proto3" json:"tokenReferenceID,omitempty"`
 }
 
-func (x *SystemStatusRequest) Reset() {
-	*x = SystemStatusRequest{}
+func (x *AuthTokenRevokeRequest) Reset() {
+	*x = AuthTokenRevokeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[40]
+		mi := &file_kstash_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemStatusRequest) String() string {
+func (x *AuthTokenRevokeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemStatusRequest) ProtoMessage() {}
+func (*AuthTokenRevokeRequest) ProtoMessage() {}
 
-func (x *SystemStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[40]
+func (x *AuthTokenRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,38 +3068,48 @@ func (x *SystemStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemStatusRequest.ProtoReflect.Descriptor instead.
-func (*SystemStatusRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use AuthTokenRevokeRequest.ProtoReflect.Descriptor instead.
+func (*AuthTokenRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{36}
 }
 
-type SystemStatusResponse struct {
+func (x *AuthTokenRevokeRequest) GetTokenID() string {
+	if x != nil {
+		return x.TokenID
+	}
+	return ""
+}
+
+func (x *AuthTokenRevokeRequest) GetTokenReferenceID() string {
+	if x != nil {
+		return x.TokenReferenceID
+	}
+	return ""
+}
+
+type AuthTokenRevokeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ServerTimestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=serverTimestamp,proto3" json:"serverTimestamp,omitempty"`
-	Initialized     bool                   `protobuf:"varint,2,opt,name=initialized,proto3" json:"initialized,omitempty"`
-	Sealed          bool                   `protobuf:"varint,3,opt,name=sealed,proto3" json:"sealed,omitempty"`
 }
 
-func (x *SystemStatusResponse) Reset() {
-	*x = SystemStatusResponse{}
+func (x *AuthTokenRevokeResponse) Reset() {
+	*x = AuthTokenRevokeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[41]
+		mi := &file_kstash_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemStatusResponse) String() string {
+func (x *AuthTokenRevokeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemStatusResponse) ProtoMessage() {}
+func (*AuthTokenRevokeResponse) ProtoMessage() {}
 
-func (x *SystemStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[41]
+func (x *AuthTokenRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,59 +3120,40 @@ func (x *SystemStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemStatusResponse.ProtoReflect.Descriptor instead.
-func (*SystemStatusResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *SystemStatusResponse) GetServerTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ServerTimestamp
-	}
-	return nil
-}
-
-func (x *SystemStatusResponse) GetInitialized() bool {
-	if x != nil {
-		return x.Initialized
-	}
-	return false
-}
-
-func (x *SystemStatusResponse) GetSealed() bool {
-	if x != nil {
-		return x.Sealed
-	}
-	return false
+// Deprecated: Use AuthTokenRevokeResponse.ProtoReflect.Descriptor instead.
+func (*AuthTokenRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{37}
 }
 
-type SystemUnsealRequest struct {
+type DatabaseConfigureConnectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnsealKeys           []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
-	GatekeeperToken      string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
-	RenewGatekeeperToken bool     `protobuf:"varint,3,opt,name=renewGatekeeperToken,proto3" json:"renewGatekeeperToken,omitempty"`
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Plugin        string   `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Driver        string   `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	ConnectionURL string   `protobuf:"bytes,4,opt,name=connectionURL,proto3" json:"connectionURL,omitempty"`
+	AllowedRoles  []string `protobuf:"bytes,5,rep,name=allowedRoles,proto3" json:"allowedRoles,omitempty"`
 }
 
-func (x *SystemUnsealRequest) Reset() {
-	*x = SystemUnsealRequest{}
+func (x *DatabaseConfigureConnectionRequest) Reset() {
+	*x = DatabaseConfigureConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kstash_proto_msgTypes[42]
+		mi := &file_kstash_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemUnsealRequest) String() string {
+func (x *DatabaseConfigureConnectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemUnsealRequest) ProtoMessage() {}
+func (*DatabaseConfigureConnectionRequest) ProtoMessage() {}
 
-func (x *SystemUnsealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kstash_proto_msgTypes[42]
+func (x *DatabaseConfigureConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2368,42 +3164,224 @@ func (x *SystemUnsealRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemUnsealRequest.ProtoReflect.Descriptor instead.
-func (*SystemUnsealRequest) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DatabaseConfigureConnectionRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseConfigureConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SystemUnsealRequest) GetUnsealKeys() []string {
+func (x *DatabaseConfigureConnectionRequest) GetName() string {
 	if x != nil {
-		return x.UnsealKeys
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemUnsealRequest) GetGatekeeperToken() string {
+func (x *DatabaseConfigureConnectionRequest) GetPlugin() string {
 	if x != nil {
-		return x.GatekeeperToken
+		return x.Plugin
 	}
 	return ""
 }
 
-func (x *SystemUnsealRequest) GetRenewGatekeeperToken() bool {
+func (x *DatabaseConfigureConnectionRequest) GetDriver() string {
 	if x != nil {
-		return x.RenewGatekeeperToken
+		return x.Driver
 	}
-	return false
+	return ""
 }
 
-type SystemUnsealResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DatabaseConfigureConnectionRequest) GetConnectionURL() string {
+	if x != nil {
+		return x.ConnectionURL
+	}
+	return ""
+}
 
-	Sealed bool `protobuf:"varint,1,opt,name=sealed,proto3" json:"sealed,omitempty"`
+func (x *DatabaseConfigureConnectionRequest) GetAllowedRoles() []string {
+	if x != nil {
+		return x.AllowedRoles
+	}
+	return nil
 }
 
-func (x *SystemUnsealResponse) Reset() {
-	*x = SystemUnsealResponse{}
+type DatabaseConfigureConnectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DatabaseConfigureConnectionResponse) Reset() {
+	*x = DatabaseConfigureConnectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseConfigureConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseConfigureConnectionResponse) ProtoMessage() {}
+
+func (x *DatabaseConfigureConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseConfigureConnectionResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseConfigureConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{39}
+}
+
+type DatabaseDeleteConnectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DatabaseDeleteConnectionRequest) Reset() {
+	*x = DatabaseDeleteConnectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseDeleteConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseDeleteConnectionRequest) ProtoMessage() {}
+
+func (x *DatabaseDeleteConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseDeleteConnectionRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseDeleteConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DatabaseDeleteConnectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DatabaseDeleteConnectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DatabaseDeleteConnectionResponse) Reset() {
+	*x = DatabaseDeleteConnectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseDeleteConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseDeleteConnectionResponse) ProtoMessage() {}
+
+func (x *DatabaseDeleteConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseDeleteConnectionResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseDeleteConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{41}
+}
+
+type DatabaseDeleteRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DatabaseDeleteRoleRequest) Reset() {
+	*x = DatabaseDeleteRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseDeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseDeleteRoleRequest) ProtoMessage() {}
+
+func (x *DatabaseDeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseDeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseDeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DatabaseDeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DatabaseDeleteRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DatabaseDeleteRoleResponse) Reset() {
+	*x = DatabaseDeleteRoleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kstash_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2411,600 +3389,10846 @@ func (x *SystemUnsealResponse) Reset() {
 	}
 }
 
-func (x *SystemUnsealResponse) String() string {
+func (x *DatabaseDeleteRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemUnsealResponse) ProtoMessage() {}
+func (*DatabaseDeleteRoleResponse) ProtoMessage() {}
 
-func (x *SystemUnsealResponse) ProtoReflect() protoreflect.Message {
+func (x *DatabaseDeleteRoleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_kstash_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SystemUnsealResponse.ProtoReflect.Descriptor instead.
-func (*SystemUnsealResponse) Descriptor() ([]byte, []int) {
-	return file_kstash_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *SystemUnsealResponse) GetSealed() bool {
-	if x != nil {
-		return x.Sealed
-	}
-	return false
-}
-
-var File_kstash_proto protoreflect.FileDescriptor
-
-var file_kstash_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
-	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12,
-	0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x49, 0x44,
-	0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x76,
-	0x61, 0x6c, 0x22, 0xa4, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12,
-	0x2a, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b,
-	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x1a, 0x4c, 0x0a, 0x08, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x03, 0x41, 0x43, 0x4c,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xda, 0x02,
-	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x73, 0x74, 0x61,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x04, 0x61, 0x63, 0x6c,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x1a, 0x3b, 0x0a,
-	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x16, 0x41, 0x75,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x2a,
-	0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0x47, 0x0a, 0x17, 0x41, 0x75,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x74, 0x74, 0x6c, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x16,
-	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44,
-	0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x17,
-	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x4b, 0x56, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x26, 0x0a, 0x0e,
-	0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a, 0x0d, 0x4b, 0x56, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x33,
-	0x0a, 0x0c, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b,
-	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x22, 0x0f, 0x0a, 0x0d, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x4b, 0x56, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x12, 0x0a, 0x10, 0x4b,
-	0x56, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xd2, 0x02, 0x0a, 0x20, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x55, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43,
-	0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x21, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x24, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22,
-	0x51, 0x0a, 0x25, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65,
-	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65,
-	0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47,
-	0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82,
-	0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
-	0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74,
-	0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75,
-	0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22, 0x1b, 0x0a,
-	0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1c, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22, 0x3d, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22, 0x62, 0x0a, 0x20, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67,
-	0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x23, 0x0a, 0x21, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4e, 0x0a, 0x22, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
-	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65,
-	0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x4f, 0x0a, 0x23, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
-	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x95, 0x01, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61,
-	0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b,
-	0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x55,
-	0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x6e, 0x73,
-	0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x40, 0x0a, 0x1e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
-	0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x4e, 0x0a, 0x22, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65,
-	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x23, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x53, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
-	0x65, 0x61, 0x6c, 0x65, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a,
-	0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
-	0x65, 0x61, 0x6c, 0x65, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x0a,
-	0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x77,
-	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x47, 0x61, 0x74, 0x65,
-	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e, 0x0a, 0x14, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x2a, 0x1c, 0x0a, 0x0a, 0x43,
-	0x69, 0x70, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x45, 0x53,
-	0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x10, 0x00, 0x2a, 0x4e, 0x0a, 0x0a, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52,
-	0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x0a, 0x0a,
-	0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05, 0x32, 0x9d, 0x13, 0x0a, 0x06, 0x4b, 0x53,
-	0x74, 0x61, 0x73, 0x68, 0x12, 0x7a, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x73, 0x74,
-	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a,
-	0x12, 0x76, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e,
-	0x65, 0x77, 0x12, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22,
-	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
-	0x72, 0x65, 0x6e, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x73,
-	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5c, 0x0a, 0x06, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a,
-	0x01, 0x2a, 0x12, 0x58, 0x0a, 0x05, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x6b, 0x73,
-	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x05,
-	0x4b, 0x56, 0x50, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
-	0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f,
-	0x70, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x08, 0x4b, 0x56, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
-	0x56, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f,
-	0x6b, 0x76, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9c, 0x01, 0x0a,
-	0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x6b, 0x73, 0x74,
-	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xad, 0x01, 0x0a, 0x1d,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61,
-	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x2e,
-	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x61,
-	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x10, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12,
-	0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x12, 0x23, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
-	0x70, 0x72, 0x75, 0x6e, 0x65, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x8f,
-	0x01, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
-	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x9f, 0x01, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74,
-	0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x2b,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6b, 0x73,
-	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f,
-	0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72, 0x6f,
-	0x74, 0x61, 0x74, 0x65, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a, 0x1b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65,
-	0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x61, 0x74,
-	0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x92, 0x01, 0x0a, 0x16, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61,
-	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e,
-	0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65,
-	0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72,
-	0x6f, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x3a, 0x01, 0x2a, 0x12,
-	0xa5, 0x01, 0x0a, 0x1b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x2d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
-	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65,
-	0x65, 0x70, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x65, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x61, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x6a,
-	0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x6b, 0x73, 0x74,
-	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73,
-	0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x73, 0x74,
-	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73,
-	0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
-	0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x3a, 0x01, 0x2a, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x6b, 0x61, 0x77, 0x69,
-	0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_kstash_proto_rawDescOnce sync.Once
-	file_kstash_proto_rawDescData = file_kstash_proto_rawDesc
-)
-
-func file_kstash_proto_rawDescGZIP() []byte {
-	file_kstash_proto_rawDescOnce.Do(func() {
-		file_kstash_proto_rawDescData = protoimpl.X.CompressGZIP(file_kstash_proto_rawDescData)
-	})
-	return file_kstash_proto_rawDescData
-}
-
-var file_kstash_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_kstash_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
-var file_kstash_proto_goTypes = []interface{}{
-	(CipherType)(0),                               // 0: kstash.v1.CipherType
-	(Permission)(0),                               // 1: kstash.v1.Permission
-	(*EncryptionKey)(nil),                         // 2: kstash.v1.EncryptionKey
-	(*KeychainSnapshot)(nil),                      // 3: kstash.v1.KeychainSnapshot
-	(*BackendItem)(nil),                           // 4: kstash.v1.BackendItem
-	(*Item)(nil),                                  // 5: kstash.v1.Item
-	(*ACL)(nil),                                   // 6: kstash.v1.ACL
-	(*AccessToken)(nil),                           // 7: kstash.v1.AccessToken
-	(*AuthTokenLookupRequest)(nil),                // 8: kstash.v1.AuthTokenLookupRequest
-	(*AuthTokenLookupResponse)(nil),               // 9: kstash.v1.AuthTokenLookupResponse
-	(*AuthTokenRenewRequest)(nil),                 // 10: kstash.v1.AuthTokenRenewRequest
-	(*AuthTokenRenewResponse)(nil),                // 11: kstash.v1.AuthTokenRenewResponse
-	(*AuthTokenRevokeRequest)(nil),                // 12: kstash.v1.AuthTokenRevokeRequest
-	(*AuthTokenRevokeResponse)(nil),               // 13: kstash.v1.AuthTokenRevokeResponse
-	(*KVListRequest)(nil),                         // 14: kstash.v1.KVListRequest
-	(*KVListResponse)(nil),                        // 15: kstash.v1.KVListResponse
-	(*KVGetRequest)(nil),                          // 16: kstash.v1.KVGetRequest
-	(*KVGetResponse)(nil),                         // 17: kstash.v1.KVGetResponse
-	(*KVPutRequest)(nil),                          // 18: kstash.v1.KVPutRequest
-	(*KVPutResponse)(nil),                         // 19: kstash.v1.KVPutResponse
-	(*KVDeleteRequest)(nil),                       // 20: kstash.v1.KVDeleteRequest
-	(*KVDeleteResponse)(nil),                      // 21: kstash.v1.KVDeleteResponse
-	(*SystemGenerateAccessTokenRequest)(nil),      // 22: kstash.v1.SystemGenerateAccessTokenRequest
-	(*SystemGenerateAccessTokenResponse)(nil),     // 23: kstash.v1.SystemGenerateAccessTokenResponse
-	(*SystemGenerateGatekeeperTokenRequest)(nil),  // 24: kstash.v1.SystemGenerateGatekeeperTokenRequest
-	(*SystemGenerateGatekeeperTokenResponse)(nil), // 25: kstash.v1.SystemGenerateGatekeeperTokenResponse
-	(*SystemInitializeRequest)(nil),               // 26: kstash.v1.SystemInitializeRequest
-	(*SystemInitializeResponse)(nil),              // 27: kstash.v1.SystemInitializeResponse
-	(*SystemPruneTokensRequest)(nil),              // 28: kstash.v1.SystemPruneTokensRequest
-	(*SystemPruneTokensResponse)(nil),             // 29: kstash.v1.SystemPruneTokensResponse
-	(*SystemRotateAccessKeyRequest)(nil),          // 30: kstash.v1.SystemRotateAccessKeyRequest
-	(*SystemRotateAccessKeyResponse)(nil),         // 31: kstash.v1.SystemRotateAccessKeyResponse
-	(*SystemRotateEncryptionKeyRequest)(nil),      // 32: kstash.v1.SystemRotateEncryptionKeyRequest
-	(*SystemRotateEncryptionKeyResponse)(nil),     // 33: kstash.v1.SystemRotateEncryptionKeyResponse
-	(*SystemRotateGatekeeperTokenRequest)(nil),    // 34: kstash.v1.SystemRotateGatekeeperTokenRequest
-	(*SystemRotateGatekeeperTokenResponse)(nil),   // 35: kstash.v1.SystemRotateGatekeeperTokenResponse
-	(*SystemRotateUnsealKeysRequest)(nil),         // 36: kstash.v1.SystemRotateUnsealKeysRequest
-	(*SystemRotateUnsealKeysResponse)(nil),        // 37: kstash.v1.SystemRotateUnsealKeysResponse
-	(*SystemRevokeGatekeeperTokenRequest)(nil),    // 38: kstash.v1.SystemRevokeGatekeeperTokenRequest
-	(*SystemRevokeGatekeeperTokenResponse)(nil),   // 39: kstash.v1.SystemRevokeGatekeeperTokenResponse
-	(*SystemSealRequest)(nil),                     // 40: kstash.v1.SystemSealRequest
-	(*SystemSealResponse)(nil),                    // 41: kstash.v1.SystemSealResponse
-	(*SystemStatusRequest)(nil),                   // 42: kstash.v1.SystemStatusRequest
-	(*SystemStatusResponse)(nil),                  // 43: kstash.v1.SystemStatusResponse
-	(*SystemUnsealRequest)(nil),                   // 44: kstash.v1.SystemUnsealRequest
-	(*SystemUnsealResponse)(nil),                  // 45: kstash.v1.SystemUnsealResponse
-	nil,                                           // 46: kstash.v1.Item.MapEntry
-	nil,                                           // 47: kstash.v1.AccessToken.MetadataEntry
-	nil,                                           // 48: kstash.v1.SystemGenerateAccessTokenRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),                 // 49: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                             // 50: google.protobuf.Any
-}
-var file_kstash_proto_depIdxs = []int32{
-	0,  // 0: kstash.v1.EncryptionKey.type:type_name -> kstash.v1.CipherType
-	49, // 1: kstash.v1.EncryptionKey.created:type_name -> google.protobuf.Timestamp
-	2,  // 2: kstash.v1.KeychainSnapshot.keys:type_name -> kstash.v1.EncryptionKey
-	49, // 3: kstash.v1.KeychainSnapshot.created:type_name -> google.protobuf.Timestamp
-	46, // 4: kstash.v1.Item.map:type_name -> kstash.v1.Item.MapEntry
-	1,  // 5: kstash.v1.ACL.permissions:type_name -> kstash.v1.Permission
-	47, // 6: kstash.v1.AccessToken.metadata:type_name -> kstash.v1.AccessToken.MetadataEntry
-	6,  // 7: kstash.v1.AccessToken.acls:type_name -> kstash.v1.ACL
-	7,  // 8: kstash.v1.AuthTokenLookupResponse.token:type_name -> kstash.v1.AccessToken
-	7,  // 9: kstash.v1.AuthTokenRenewResponse.token:type_name -> kstash.v1.AccessToken
-	5,  // 10: kstash.v1.KVGetResponse.item:type_name -> kstash.v1.Item
-	5,  // 11: kstash.v1.KVPutRequest.item:type_name -> kstash.v1.Item
-	48, // 12: kstash.v1.SystemGenerateAccessTokenRequest.metadata:type_name -> kstash.v1.SystemGenerateAccessTokenRequest.MetadataEntry
-	6,  // 13: kstash.v1.SystemGenerateAccessTokenRequest.acls:type_name -> kstash.v1.ACL
-	7,  // 14: kstash.v1.SystemGenerateAccessTokenResponse.token:type_name -> kstash.v1.AccessToken
-	49, // 15: kstash.v1.SystemStatusResponse.serverTimestamp:type_name -> google.protobuf.Timestamp
-	50, // 16: kstash.v1.Item.MapEntry.value:type_name -> google.protobuf.Any
-	8,  // 17: kstash.v1.KStash.AuthTokenLookup:input_type -> kstash.v1.AuthTokenLookupRequest
-	10, // 18: kstash.v1.KStash.AuthTokenRenew:input_type -> kstash.v1.AuthTokenRenewRequest
-	12, // 19: kstash.v1.KStash.AuthTokenRevoke:input_type -> kstash.v1.AuthTokenRevokeRequest
-	14, // 20: kstash.v1.KStash.KVList:input_type -> kstash.v1.KVListRequest
-	16, // 21: kstash.v1.KStash.KVGet:input_type -> kstash.v1.KVGetRequest
-	18, // 22: kstash.v1.KStash.KVPut:input_type -> kstash.v1.KVPutRequest
-	20, // 23: kstash.v1.KStash.KVDelete:input_type -> kstash.v1.KVDeleteRequest
-	22, // 24: kstash.v1.KStash.SystemGenerateAccessToken:input_type -> kstash.v1.SystemGenerateAccessTokenRequest
-	24, // 25: kstash.v1.KStash.SystemGenerateGatekeeperToken:input_type -> kstash.v1.SystemGenerateGatekeeperTokenRequest
-	26, // 26: kstash.v1.KStash.SystemInitialize:input_type -> kstash.v1.SystemInitializeRequest
-	28, // 27: kstash.v1.KStash.SystemPruneTokens:input_type -> kstash.v1.SystemPruneTokensRequest
-	30, // 28: kstash.v1.KStash.SystemRotateAccessKey:input_type -> kstash.v1.SystemRotateAccessKeyRequest
-	32, // 29: kstash.v1.KStash.SystemRotateEncryptionKey:input_type -> kstash.v1.SystemRotateEncryptionKeyRequest
-	34, // 30: kstash.v1.KStash.SystemRotateGatekeeperToken:input_type -> kstash.v1.SystemRotateGatekeeperTokenRequest
-	36, // 31: kstash.v1.KStash.SystemRotateUnsealKeys:input_type -> kstash.v1.SystemRotateUnsealKeysRequest
-	38, // 32: kstash.v1.KStash.SystemRevokeGatekeeperToken:input_type -> kstash.v1.SystemRevokeGatekeeperTokenRequest
-	40, // 33: kstash.v1.KStash.SystemSeal:input_type -> kstash.v1.SystemSealRequest
-	42, // 34: kstash.v1.KStash.SystemStatus:input_type -> kstash.v1.SystemStatusRequest
-	44, // 35: kstash.v1.KStash.SystemUnseal:input_type -> kstash.v1.SystemUnsealRequest
-	9,  // 36: kstash.v1.KStash.AuthTokenLookup:output_type -> kstash.v1.AuthTokenLookupResponse
-	11, // 37: kstash.v1.KStash.AuthTokenRenew:output_type -> kstash.v1.AuthTokenRenewResponse
-	13, // 38: kstash.v1.KStash.AuthTokenRevoke:output_type -> kstash.v1.AuthTokenRevokeResponse
-	15, // 39: kstash.v1.KStash.KVList:output_type -> kstash.v1.KVListResponse
-	17, // 40: kstash.v1.KStash.KVGet:output_type -> kstash.v1.KVGetResponse
-	19, // 41: kstash.v1.KStash.KVPut:output_type -> kstash.v1.KVPutResponse
-	21, // 42: kstash.v1.KStash.KVDelete:output_type -> kstash.v1.KVDeleteResponse
-	23, // 43: kstash.v1.KStash.SystemGenerateAccessToken:output_type -> kstash.v1.SystemGenerateAccessTokenResponse
-	25, // 44: kstash.v1.KStash.SystemGenerateGatekeeperToken:output_type -> kstash.v1.SystemGenerateGatekeeperTokenResponse
-	27, // 45: kstash.v1.KStash.SystemInitialize:output_type -> kstash.v1.SystemInitializeResponse
-	29, // 46: kstash.v1.KStash.SystemPruneTokens:output_type -> kstash.v1.SystemPruneTokensResponse
-	31, // 47: kstash.v1.KStash.SystemRotateAccessKey:output_type -> kstash.v1.SystemRotateAccessKeyResponse
-	33, // 48: kstash.v1.KStash.SystemRotateEncryptionKey:output_type -> kstash.v1.SystemRotateEncryptionKeyResponse
-	35, // 49: kstash.v1.KStash.SystemRotateGatekeeperToken:output_type -> kstash.v1.SystemRotateGatekeeperTokenResponse
-	37, // 50: kstash.v1.KStash.SystemRotateUnsealKeys:output_type -> kstash.v1.SystemRotateUnsealKeysResponse
-	39, // 51: kstash.v1.KStash.SystemRevokeGatekeeperToken:output_type -> kstash.v1.SystemRevokeGatekeeperTokenResponse
-	41, // 52: kstash.v1.KStash.SystemSeal:output_type -> kstash.v1.SystemSealResponse
-	43, // 53: kstash.v1.KStash.SystemStatus:output_type -> kstash.v1.SystemStatusResponse
-	45, // 54: kstash.v1.KStash.SystemUnseal:output_type -> kstash.v1.SystemUnsealResponse
-	36, // [36:55] is the sub-list for method output_type
-	17, // [17:36] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
-}
-
-func init() { file_kstash_proto_init() }
-func file_kstash_proto_init() {
-	if File_kstash_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_kstash_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptionKey); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseDeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseDeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{43}
+}
+
+type DatabaseGenerateCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Ttl  string `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *DatabaseGenerateCredentialsRequest) Reset() {
+	*x = DatabaseGenerateCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseGenerateCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseGenerateCredentialsRequest) ProtoMessage() {}
+
+func (x *DatabaseGenerateCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseGenerateCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseGenerateCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DatabaseGenerateCredentialsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *DatabaseGenerateCredentialsRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type DatabaseGenerateCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username  string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	LeaseID   string `protobuf:"bytes,3,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *DatabaseGenerateCredentialsResponse) Reset() {
+	*x = DatabaseGenerateCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseGenerateCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseGenerateCredentialsResponse) ProtoMessage() {}
+
+func (x *DatabaseGenerateCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseGenerateCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseGenerateCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DatabaseGenerateCredentialsResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DatabaseGenerateCredentialsResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DatabaseGenerateCredentialsResponse) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *DatabaseGenerateCredentialsResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type DatabaseGetRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DatabaseGetRoleRequest) Reset() {
+	*x = DatabaseGetRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseGetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseGetRoleRequest) ProtoMessage() {}
+
+func (x *DatabaseGetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseGetRoleRequest.ProtoReflect.Descriptor instead.
+func (*DatabaseGetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DatabaseGetRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DatabaseGetRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *DatabaseRole `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *DatabaseGetRoleResponse) Reset() {
+	*x = DatabaseGetRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseGetRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseGetRoleResponse) ProtoMessage() {}
+
+func (x *DatabaseGetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseGetRoleResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseGetRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DatabaseGetRoleResponse) GetRole() *DatabaseRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type DatabasePutRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Connection           string   `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
+	CreationStatements   []string `protobuf:"bytes,3,rep,name=creationStatements,proto3" json:"creationStatements,omitempty"`
+	RevocationStatements []string `protobuf:"bytes,4,rep,name=revocationStatements,proto3" json:"revocationStatements,omitempty"`
+	Ttl                  string   `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxTTL               string   `protobuf:"bytes,6,opt,name=maxTTL,proto3" json:"maxTTL,omitempty"`
+}
+
+func (x *DatabasePutRoleRequest) Reset() {
+	*x = DatabasePutRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabasePutRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabasePutRoleRequest) ProtoMessage() {}
+
+func (x *DatabasePutRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabasePutRoleRequest.ProtoReflect.Descriptor instead.
+func (*DatabasePutRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DatabasePutRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabasePutRoleRequest) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *DatabasePutRoleRequest) GetCreationStatements() []string {
+	if x != nil {
+		return x.CreationStatements
+	}
+	return nil
+}
+
+func (x *DatabasePutRoleRequest) GetRevocationStatements() []string {
+	if x != nil {
+		return x.RevocationStatements
+	}
+	return nil
+}
+
+func (x *DatabasePutRoleRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *DatabasePutRoleRequest) GetMaxTTL() string {
+	if x != nil {
+		return x.MaxTTL
+	}
+	return ""
+}
+
+type DatabasePutRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DatabasePutRoleResponse) Reset() {
+	*x = DatabasePutRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabasePutRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabasePutRoleResponse) ProtoMessage() {}
+
+func (x *DatabasePutRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabasePutRoleResponse.ProtoReflect.Descriptor instead.
+func (*DatabasePutRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{49}
+}
+
+// KVListEntry describes an item or folder listed by KVList.
+type KVListEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *KVListEntry) Reset() {
+	*x = KVListEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVListEntry) ProtoMessage() {}
+
+func (x *KVListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVListEntry.ProtoReflect.Descriptor instead.
+func (*KVListEntry) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *KVListEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KVListEntry) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type KVListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// recursive lists all items below the path instead of the names of its items and folders.
+	Recursive bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	// pageSize is the maximum number of entries in the response. All entries are listed if it is not set.
+	PageSize uint32 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// pageToken is the nextPageToken of the previous response.
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// readable lists only the items the token is permitted to read. Folders are listed if the token is permitted to list them and to read an item below them.
+	Readable bool `protobuf:"varint,5,opt,name=readable,proto3" json:"readable,omitempty"`
+}
+
+func (x *KVListRequest) Reset() {
+	*x = KVListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVListRequest) ProtoMessage() {}
+
+func (x *KVListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVListRequest.ProtoReflect.Descriptor instead.
+func (*KVListRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *KVListRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KVListRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *KVListRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *KVListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *KVListRequest) GetReadable() bool {
+	if x != nil {
+		return x.Readable
+	}
+	return false
+}
+
+type KVListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths   []string       `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	Entries []*KVListEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// nextPageToken lists the next page of entries, if there are more.
+	NextPageToken string `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *KVListResponse) Reset() {
+	*x = KVListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVListResponse) ProtoMessage() {}
+
+func (x *KVListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVListResponse.ProtoReflect.Descriptor instead.
+func (*KVListResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *KVListResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *KVListResponse) GetEntries() []*KVListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *KVListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type KVGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *KVGetRequest) Reset() {
+	*x = KVGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGetRequest) ProtoMessage() {}
+
+func (x *KVGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVGetRequest.ProtoReflect.Descriptor instead.
+func (*KVGetRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *KVGetRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type KVGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *KVGetResponse) Reset() {
+	*x = KVGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGetResponse) ProtoMessage() {}
+
+func (x *KVGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVGetResponse.ProtoReflect.Descriptor instead.
+func (*KVGetResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *KVGetResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type KVPutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// ttl after which the item is deleted. It cannot be combined with the item's expiresAt.
+	Ttl string `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *KVPutRequest) Reset() {
+	*x = KVPutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVPutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVPutRequest) ProtoMessage() {}
+
+func (x *KVPutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVPutRequest.ProtoReflect.Descriptor instead.
+func (*KVPutRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *KVPutRequest) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *KVPutRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type KVPutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KVPutResponse) Reset() {
+	*x = KVPutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVPutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVPutResponse) ProtoMessage() {}
+
+func (x *KVPutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVPutResponse.ProtoReflect.Descriptor instead.
+func (*KVPutResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{56}
+}
+
+type KVDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *KVDeleteRequest) Reset() {
+	*x = KVDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVDeleteRequest) ProtoMessage() {}
+
+func (x *KVDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVDeleteRequest.ProtoReflect.Descriptor instead.
+func (*KVDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *KVDeleteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type KVDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KVDeleteResponse) Reset() {
+	*x = KVDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVDeleteResponse) ProtoMessage() {}
+
+func (x *KVDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVDeleteResponse.ProtoReflect.Descriptor instead.
+func (*KVDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{58}
+}
+
+type KVGetMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *KVGetMetadataRequest) Reset() {
+	*x = KVGetMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVGetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGetMetadataRequest) ProtoMessage() {}
+
+func (x *KVGetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVGetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*KVGetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *KVGetMetadataRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type KVGetMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *KVMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *KVGetMetadataResponse) Reset() {
+	*x = KVGetMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVGetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVGetMetadataResponse) ProtoMessage() {}
+
+func (x *KVGetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVGetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*KVGetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *KVGetMetadataResponse) GetMetadata() *KVMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type KVPutMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path          string            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Owner         string            `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description   string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RotationDueAt int64             `protobuf:"varint,5,opt,name=rotationDueAt,proto3" json:"rotationDueAt,omitempty"`
+}
+
+func (x *KVPutMetadataRequest) Reset() {
+	*x = KVPutMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVPutMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVPutMetadataRequest) ProtoMessage() {}
+
+func (x *KVPutMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVPutMetadataRequest.ProtoReflect.Descriptor instead.
+func (*KVPutMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *KVPutMetadataRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KVPutMetadataRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *KVPutMetadataRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KVPutMetadataRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *KVPutMetadataRequest) GetRotationDueAt() int64 {
+	if x != nil {
+		return x.RotationDueAt
+	}
+	return 0
+}
+
+type KVPutMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *KVMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *KVPutMetadataResponse) Reset() {
+	*x = KVPutMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVPutMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVPutMetadataResponse) ProtoMessage() {}
+
+func (x *KVPutMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVPutMetadataResponse.ProtoReflect.Descriptor instead.
+func (*KVPutMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *KVPutMetadataResponse) GetMetadata() *KVMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type KVSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// selector filters items by their labels, e.g. `team=payments,env in (prod,staging),!deprecated`.
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// path limits the search to the items below a folder.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// rotationDueBefore limits the search to items that are due for rotation before the given time.
+	RotationDueBefore int64 `protobuf:"varint,3,opt,name=rotationDueBefore,proto3" json:"rotationDueBefore,omitempty"`
+}
+
+func (x *KVSearchRequest) Reset() {
+	*x = KVSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVSearchRequest) ProtoMessage() {}
+
+func (x *KVSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVSearchRequest.ProtoReflect.Descriptor instead.
+func (*KVSearchRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *KVSearchRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *KVSearchRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KVSearchRequest) GetRotationDueBefore() int64 {
+	if x != nil {
+		return x.RotationDueBefore
+	}
+	return 0
+}
+
+type KVSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*KVMetadata `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *KVSearchResponse) Reset() {
+	*x = KVSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVSearchResponse) ProtoMessage() {}
+
+func (x *KVSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVSearchResponse.ProtoReflect.Descriptor instead.
+func (*KVSearchResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *KVSearchResponse) GetResults() []*KVMetadata {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type LeaseRenewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	// increment is the TTL of the lease from now on. The TTL the lease was last issued or renewed with is used if it is not set.
+	Increment string `protobuf:"bytes,2,opt,name=increment,proto3" json:"increment,omitempty"`
+}
+
+func (x *LeaseRenewRequest) Reset() {
+	*x = LeaseRenewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRenewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRenewRequest) ProtoMessage() {}
+
+func (x *LeaseRenewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRenewRequest.ProtoReflect.Descriptor instead.
+func (*LeaseRenewRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LeaseRenewRequest) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *LeaseRenewRequest) GetIncrement() string {
+	if x != nil {
+		return x.Increment
+	}
+	return ""
+}
+
+type LeaseRenewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *LeaseRenewResponse) Reset() {
+	*x = LeaseRenewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRenewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRenewResponse) ProtoMessage() {}
+
+func (x *LeaseRenewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRenewResponse.ProtoReflect.Descriptor instead.
+func (*LeaseRenewResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LeaseRenewResponse) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type LeaseRevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+}
+
+func (x *LeaseRevokeRequest) Reset() {
+	*x = LeaseRevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRevokeRequest) ProtoMessage() {}
+
+func (x *LeaseRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRevokeRequest.ProtoReflect.Descriptor instead.
+func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LeaseRevokeRequest) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type LeaseRevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaseRevokeResponse) Reset() {
+	*x = LeaseRevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRevokeResponse) ProtoMessage() {}
+
+func (x *LeaseRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRevokeResponse.ProtoReflect.Descriptor instead.
+func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{68}
+}
+
+type LeaseRevokePrefixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *LeaseRevokePrefixRequest) Reset() {
+	*x = LeaseRevokePrefixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRevokePrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRevokePrefixRequest) ProtoMessage() {}
+
+func (x *LeaseRevokePrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRevokePrefixRequest.ProtoReflect.Descriptor instead.
+func (*LeaseRevokePrefixRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LeaseRevokePrefixRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type LeaseRevokePrefixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revoked uint32 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (x *LeaseRevokePrefixResponse) Reset() {
+	*x = LeaseRevokePrefixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseRevokePrefixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseRevokePrefixResponse) ProtoMessage() {}
+
+func (x *LeaseRevokePrefixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseRevokePrefixResponse.ProtoReflect.Descriptor instead.
+func (*LeaseRevokePrefixResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LeaseRevokePrefixResponse) GetRevoked() uint32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type NamespaceConfigureKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currentWrappingKey is required to replace the wrapping key of a namespace that already has one.
+	CurrentWrappingKey []byte `protobuf:"bytes,1,opt,name=currentWrappingKey,proto3" json:"currentWrappingKey,omitempty"`
+	WrappingKey        []byte `protobuf:"bytes,2,opt,name=wrappingKey,proto3" json:"wrappingKey,omitempty"`
+}
+
+func (x *NamespaceConfigureKeyRequest) Reset() {
+	*x = NamespaceConfigureKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceConfigureKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceConfigureKeyRequest) ProtoMessage() {}
+
+func (x *NamespaceConfigureKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceConfigureKeyRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceConfigureKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *NamespaceConfigureKeyRequest) GetCurrentWrappingKey() []byte {
+	if x != nil {
+		return x.CurrentWrappingKey
+	}
+	return nil
+}
+
+func (x *NamespaceConfigureKeyRequest) GetWrappingKey() []byte {
+	if x != nil {
+		return x.WrappingKey
+	}
+	return nil
+}
+
+type NamespaceConfigureKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NamespaceConfigureKeyResponse) Reset() {
+	*x = NamespaceConfigureKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceConfigureKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceConfigureKeyResponse) ProtoMessage() {}
+
+func (x *NamespaceConfigureKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceConfigureKeyResponse.ProtoReflect.Descriptor instead.
+func (*NamespaceConfigureKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{72}
+}
+
+type NamespaceLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NamespaceLockRequest) Reset() {
+	*x = NamespaceLockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceLockRequest) ProtoMessage() {}
+
+func (x *NamespaceLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceLockRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceLockRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{73}
+}
+
+type NamespaceLockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NamespaceLockResponse) Reset() {
+	*x = NamespaceLockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceLockResponse) ProtoMessage() {}
+
+func (x *NamespaceLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceLockResponse.ProtoReflect.Descriptor instead.
+func (*NamespaceLockResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{74}
+}
+
+type NamespaceStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NamespaceStatusRequest) Reset() {
+	*x = NamespaceStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceStatusRequest) ProtoMessage() {}
+
+func (x *NamespaceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceStatusRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{75}
+}
+
+type NamespaceStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantKeyed bool `protobuf:"varint,1,opt,name=tenantKeyed,proto3" json:"tenantKeyed,omitempty"`
+	Locked      bool `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
+}
+
+func (x *NamespaceStatusResponse) Reset() {
+	*x = NamespaceStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceStatusResponse) ProtoMessage() {}
+
+func (x *NamespaceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceStatusResponse.ProtoReflect.Descriptor instead.
+func (*NamespaceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *NamespaceStatusResponse) GetTenantKeyed() bool {
+	if x != nil {
+		return x.TenantKeyed
+	}
+	return false
+}
+
+func (x *NamespaceStatusResponse) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type NamespaceUnlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WrappingKey []byte `protobuf:"bytes,1,opt,name=wrappingKey,proto3" json:"wrappingKey,omitempty"`
+}
+
+func (x *NamespaceUnlockRequest) Reset() {
+	*x = NamespaceUnlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceUnlockRequest) ProtoMessage() {}
+
+func (x *NamespaceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *NamespaceUnlockRequest) GetWrappingKey() []byte {
+	if x != nil {
+		return x.WrappingKey
+	}
+	return nil
+}
+
+type NamespaceUnlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NamespaceUnlockResponse) Reset() {
+	*x = NamespaceUnlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceUnlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceUnlockResponse) ProtoMessage() {}
+
+func (x *NamespaceUnlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceUnlockResponse.ProtoReflect.Descriptor instead.
+func (*NamespaceUnlockResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{78}
+}
+
+type PKIDeleteRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PKIDeleteRoleRequest) Reset() {
+	*x = PKIDeleteRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIDeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIDeleteRoleRequest) ProtoMessage() {}
+
+func (x *PKIDeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIDeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*PKIDeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PKIDeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PKIDeleteRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PKIDeleteRoleResponse) Reset() {
+	*x = PKIDeleteRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIDeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIDeleteRoleResponse) ProtoMessage() {}
+
+func (x *PKIDeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIDeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*PKIDeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{80}
+}
+
+type PKIGenerateCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonName string     `protobuf:"bytes,1,opt,name=commonName,proto3" json:"commonName,omitempty"`
+	KeyType    PKIKeyType `protobuf:"varint,2,opt,name=keyType,proto3,enum=kstash.v1.PKIKeyType" json:"keyType,omitempty"`
+	Ttl        string     `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *PKIGenerateCARequest) Reset() {
+	*x = PKIGenerateCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGenerateCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGenerateCARequest) ProtoMessage() {}
+
+func (x *PKIGenerateCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGenerateCARequest.ProtoReflect.Descriptor instead.
+func (*PKIGenerateCARequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PKIGenerateCARequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *PKIGenerateCARequest) GetKeyType() PKIKeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return PKIKeyType_EC_P256
+}
+
+func (x *PKIGenerateCARequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type PKIGenerateCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *PKIGenerateCAResponse) Reset() {
+	*x = PKIGenerateCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGenerateCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGenerateCAResponse) ProtoMessage() {}
+
+func (x *PKIGenerateCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGenerateCAResponse.ProtoReflect.Descriptor instead.
+func (*PKIGenerateCAResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PKIGenerateCAResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type PKIGetCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PKIGetCARequest) Reset() {
+	*x = PKIGetCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGetCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGetCARequest) ProtoMessage() {}
+
+func (x *PKIGetCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGetCARequest.ProtoReflect.Descriptor instead.
+func (*PKIGetCARequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{83}
+}
+
+type PKIGetCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *PKIGetCAResponse) Reset() {
+	*x = PKIGetCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGetCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGetCAResponse) ProtoMessage() {}
+
+func (x *PKIGetCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGetCAResponse.ProtoReflect.Descriptor instead.
+func (*PKIGetCAResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PKIGetCAResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type PKIGetCRLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PKIGetCRLRequest) Reset() {
+	*x = PKIGetCRLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGetCRLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGetCRLRequest) ProtoMessage() {}
+
+func (x *PKIGetCRLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGetCRLRequest.ProtoReflect.Descriptor instead.
+func (*PKIGetCRLRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{85}
+}
+
+type PKIGetCRLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crl string `protobuf:"bytes,1,opt,name=crl,proto3" json:"crl,omitempty"`
+}
+
+func (x *PKIGetCRLResponse) Reset() {
+	*x = PKIGetCRLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGetCRLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGetCRLResponse) ProtoMessage() {}
+
+func (x *PKIGetCRLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGetCRLResponse.ProtoReflect.Descriptor instead.
+func (*PKIGetCRLResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PKIGetCRLResponse) GetCrl() string {
+	if x != nil {
+		return x.Crl
+	}
+	return ""
+}
+
+type PKIGetRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PKIGetRoleRequest) Reset() {
+	*x = PKIGetRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGetRoleRequest) ProtoMessage() {}
+
+func (x *PKIGetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGetRoleRequest.ProtoReflect.Descriptor instead.
+func (*PKIGetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PKIGetRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PKIGetRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *PKIRole `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *PKIGetRoleResponse) Reset() {
+	*x = PKIGetRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIGetRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIGetRoleResponse) ProtoMessage() {}
+
+func (x *PKIGetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIGetRoleResponse.ProtoReflect.Descriptor instead.
+func (*PKIGetRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PKIGetRoleResponse) GetRole() *PKIRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type PKIImportCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey  string `protobuf:"bytes,2,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+}
+
+func (x *PKIImportCARequest) Reset() {
+	*x = PKIImportCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIImportCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIImportCARequest) ProtoMessage() {}
+
+func (x *PKIImportCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIImportCARequest.ProtoReflect.Descriptor instead.
+func (*PKIImportCARequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PKIImportCARequest) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *PKIImportCARequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+type PKIImportCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PKIImportCAResponse) Reset() {
+	*x = PKIImportCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIImportCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIImportCAResponse) ProtoMessage() {}
+
+func (x *PKIImportCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIImportCAResponse.ProtoReflect.Descriptor instead.
+func (*PKIImportCAResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{90}
+}
+
+type PKIIssueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role       string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	CommonName string   `protobuf:"bytes,2,opt,name=commonName,proto3" json:"commonName,omitempty"`
+	AltNames   []string `protobuf:"bytes,3,rep,name=altNames,proto3" json:"altNames,omitempty"`
+	IpSANs     []string `protobuf:"bytes,4,rep,name=ipSANs,proto3" json:"ipSANs,omitempty"`
+	Ttl        string   `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *PKIIssueRequest) Reset() {
+	*x = PKIIssueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIIssueRequest) ProtoMessage() {}
+
+func (x *PKIIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIIssueRequest.ProtoReflect.Descriptor instead.
+func (*PKIIssueRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PKIIssueRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PKIIssueRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *PKIIssueRequest) GetAltNames() []string {
+	if x != nil {
+		return x.AltNames
+	}
+	return nil
+}
+
+func (x *PKIIssueRequest) GetIpSANs() []string {
+	if x != nil {
+		return x.IpSANs
+	}
+	return nil
+}
+
+func (x *PKIIssueRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type PKIIssueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate *PKIIssuedCertificate `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *PKIIssueResponse) Reset() {
+	*x = PKIIssueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIIssueResponse) ProtoMessage() {}
+
+func (x *PKIIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIIssueResponse.ProtoReflect.Descriptor instead.
+func (*PKIIssueResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PKIIssueResponse) GetCertificate() *PKIIssuedCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type PKIPutRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AllowedDomains   []string   `protobuf:"bytes,2,rep,name=allowedDomains,proto3" json:"allowedDomains,omitempty"`
+	AllowBareDomains bool       `protobuf:"varint,3,opt,name=allowBareDomains,proto3" json:"allowBareDomains,omitempty"`
+	AllowSubdomains  bool       `protobuf:"varint,4,opt,name=allowSubdomains,proto3" json:"allowSubdomains,omitempty"`
+	AllowIPSANs      bool       `protobuf:"varint,5,opt,name=allowIPSANs,proto3" json:"allowIPSANs,omitempty"`
+	KeyType          PKIKeyType `protobuf:"varint,6,opt,name=keyType,proto3,enum=kstash.v1.PKIKeyType" json:"keyType,omitempty"`
+	Ttl              string     `protobuf:"bytes,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxTTL           string     `protobuf:"bytes,8,opt,name=maxTTL,proto3" json:"maxTTL,omitempty"`
+}
+
+func (x *PKIPutRoleRequest) Reset() {
+	*x = PKIPutRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIPutRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIPutRoleRequest) ProtoMessage() {}
+
+func (x *PKIPutRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIPutRoleRequest.ProtoReflect.Descriptor instead.
+func (*PKIPutRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PKIPutRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PKIPutRoleRequest) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *PKIPutRoleRequest) GetAllowBareDomains() bool {
+	if x != nil {
+		return x.AllowBareDomains
+	}
+	return false
+}
+
+func (x *PKIPutRoleRequest) GetAllowSubdomains() bool {
+	if x != nil {
+		return x.AllowSubdomains
+	}
+	return false
+}
+
+func (x *PKIPutRoleRequest) GetAllowIPSANs() bool {
+	if x != nil {
+		return x.AllowIPSANs
+	}
+	return false
+}
+
+func (x *PKIPutRoleRequest) GetKeyType() PKIKeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return PKIKeyType_EC_P256
+}
+
+func (x *PKIPutRoleRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *PKIPutRoleRequest) GetMaxTTL() string {
+	if x != nil {
+		return x.MaxTTL
+	}
+	return ""
+}
+
+type PKIPutRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PKIPutRoleResponse) Reset() {
+	*x = PKIPutRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIPutRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIPutRoleResponse) ProtoMessage() {}
+
+func (x *PKIPutRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIPutRoleResponse.ProtoReflect.Descriptor instead.
+func (*PKIPutRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{94}
+}
+
+type PKIRevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SerialNumber string `protobuf:"bytes,1,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+}
+
+func (x *PKIRevokeRequest) Reset() {
+	*x = PKIRevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIRevokeRequest) ProtoMessage() {}
+
+func (x *PKIRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIRevokeRequest.ProtoReflect.Descriptor instead.
+func (*PKIRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PKIRevokeRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type PKIRevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevokedAt int64 `protobuf:"varint,1,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+}
+
+func (x *PKIRevokeResponse) Reset() {
+	*x = PKIRevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKIRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIRevokeResponse) ProtoMessage() {}
+
+func (x *PKIRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIRevokeResponse.ProtoReflect.Descriptor instead.
+func (*PKIRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PKIRevokeResponse) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+type PKISignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// csr is a PEM encoded certificate signing request.
+	Csr string `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
+	Ttl string `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *PKISignRequest) Reset() {
+	*x = PKISignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKISignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKISignRequest) ProtoMessage() {}
+
+func (x *PKISignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKISignRequest.ProtoReflect.Descriptor instead.
+func (*PKISignRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PKISignRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PKISignRequest) GetCsr() string {
+	if x != nil {
+		return x.Csr
+	}
+	return ""
+}
+
+func (x *PKISignRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type PKISignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate *PKIIssuedCertificate `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *PKISignResponse) Reset() {
+	*x = PKISignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PKISignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKISignResponse) ProtoMessage() {}
+
+func (x *PKISignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKISignResponse.ProtoReflect.Descriptor instead.
+func (*PKISignResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PKISignResponse) GetCertificate() *PKIIssuedCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type PolicyDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PolicyDeleteRequest) Reset() {
+	*x = PolicyDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyDeleteRequest) ProtoMessage() {}
+
+func (x *PolicyDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PolicyDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PolicyDeleteRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *PolicyDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PolicyDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PolicyDeleteResponse) Reset() {
+	*x = PolicyDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyDeleteResponse) ProtoMessage() {}
+
+func (x *PolicyDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyDeleteResponse.ProtoReflect.Descriptor instead.
+func (*PolicyDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{100}
+}
+
+type PolicyGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PolicyGetRequest) Reset() {
+	*x = PolicyGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyGetRequest) ProtoMessage() {}
+
+func (x *PolicyGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyGetRequest.ProtoReflect.Descriptor instead.
+func (*PolicyGetRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PolicyGetRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *PolicyGetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PolicyGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *PolicyGetResponse) Reset() {
+	*x = PolicyGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyGetResponse) ProtoMessage() {}
+
+func (x *PolicyGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyGetResponse.ProtoReflect.Descriptor instead.
+func (*PolicyGetResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PolicyGetResponse) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type PolicyListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+}
+
+func (x *PolicyListRequest) Reset() {
+	*x = PolicyListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyListRequest) ProtoMessage() {}
+
+func (x *PolicyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyListRequest.ProtoReflect.Descriptor instead.
+func (*PolicyListRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PolicyListRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+type PolicyListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *PolicyListResponse) Reset() {
+	*x = PolicyListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyListResponse) ProtoMessage() {}
+
+func (x *PolicyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyListResponse.ProtoReflect.Descriptor instead.
+func (*PolicyListResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *PolicyListResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type PolicyPutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string  `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	Policy    *Policy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *PolicyPutRequest) Reset() {
+	*x = PolicyPutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyPutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyPutRequest) ProtoMessage() {}
+
+func (x *PolicyPutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyPutRequest.ProtoReflect.Descriptor instead.
+func (*PolicyPutRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *PolicyPutRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *PolicyPutRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type PolicyPutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PolicyPutResponse) Reset() {
+	*x = PolicyPutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyPutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyPutResponse) ProtoMessage() {}
+
+func (x *PolicyPutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyPutResponse.ProtoReflect.Descriptor instead.
+func (*PolicyPutResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{106}
+}
+
+type SSHDeleteRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SSHDeleteRoleRequest) Reset() {
+	*x = SSHDeleteRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHDeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHDeleteRoleRequest) ProtoMessage() {}
+
+func (x *SSHDeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHDeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*SSHDeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SSHDeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SSHDeleteRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SSHDeleteRoleResponse) Reset() {
+	*x = SSHDeleteRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHDeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHDeleteRoleResponse) ProtoMessage() {}
+
+func (x *SSHDeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHDeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*SSHDeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{108}
+}
+
+type SSHGenerateCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SSHGenerateCARequest) Reset() {
+	*x = SSHGenerateCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHGenerateCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHGenerateCARequest) ProtoMessage() {}
+
+func (x *SSHGenerateCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHGenerateCARequest.ProtoReflect.Descriptor instead.
+func (*SSHGenerateCARequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{109}
+}
+
+type SSHGenerateCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+}
+
+func (x *SSHGenerateCAResponse) Reset() {
+	*x = SSHGenerateCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHGenerateCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHGenerateCAResponse) ProtoMessage() {}
+
+func (x *SSHGenerateCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHGenerateCAResponse.ProtoReflect.Descriptor instead.
+func (*SSHGenerateCAResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SSHGenerateCAResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type SSHGetCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SSHGetCARequest) Reset() {
+	*x = SSHGetCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHGetCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHGetCARequest) ProtoMessage() {}
+
+func (x *SSHGetCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHGetCARequest.ProtoReflect.Descriptor instead.
+func (*SSHGetCARequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{111}
+}
+
+type SSHGetCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+}
+
+func (x *SSHGetCAResponse) Reset() {
+	*x = SSHGetCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHGetCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHGetCAResponse) ProtoMessage() {}
+
+func (x *SSHGetCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHGetCAResponse.ProtoReflect.Descriptor instead.
+func (*SSHGetCAResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SSHGetCAResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type SSHGetRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SSHGetRoleRequest) Reset() {
+	*x = SSHGetRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHGetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHGetRoleRequest) ProtoMessage() {}
+
+func (x *SSHGetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHGetRoleRequest.ProtoReflect.Descriptor instead.
+func (*SSHGetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SSHGetRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SSHGetRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *SSHRole `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *SSHGetRoleResponse) Reset() {
+	*x = SSHGetRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHGetRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHGetRoleResponse) ProtoMessage() {}
+
+func (x *SSHGetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHGetRoleResponse.ProtoReflect.Descriptor instead.
+func (*SSHGetRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SSHGetRoleResponse) GetRole() *SSHRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type SSHImportCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey string `protobuf:"bytes,1,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+}
+
+func (x *SSHImportCARequest) Reset() {
+	*x = SSHImportCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHImportCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHImportCARequest) ProtoMessage() {}
+
+func (x *SSHImportCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHImportCARequest.ProtoReflect.Descriptor instead.
+func (*SSHImportCARequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SSHImportCARequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+type SSHImportCAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+}
+
+func (x *SSHImportCAResponse) Reset() {
+	*x = SSHImportCAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHImportCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHImportCAResponse) ProtoMessage() {}
+
+func (x *SSHImportCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHImportCAResponse.ProtoReflect.Descriptor instead.
+func (*SSHImportCAResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SSHImportCAResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type SSHPutRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CertType          SSHCertificateType `protobuf:"varint,2,opt,name=certType,proto3,enum=kstash.v1.SSHCertificateType" json:"certType,omitempty"`
+	AllowedPrincipals []string           `protobuf:"bytes,3,rep,name=allowedPrincipals,proto3" json:"allowedPrincipals,omitempty"`
+	DefaultPrincipals []string           `protobuf:"bytes,4,rep,name=defaultPrincipals,proto3" json:"defaultPrincipals,omitempty"`
+	Extensions        map[string]string  `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CriticalOptions   map[string]string  `protobuf:"bytes,6,rep,name=criticalOptions,proto3" json:"criticalOptions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ttl               string             `protobuf:"bytes,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxTTL            string             `protobuf:"bytes,8,opt,name=maxTTL,proto3" json:"maxTTL,omitempty"`
+}
+
+func (x *SSHPutRoleRequest) Reset() {
+	*x = SSHPutRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHPutRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHPutRoleRequest) ProtoMessage() {}
+
+func (x *SSHPutRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHPutRoleRequest.ProtoReflect.Descriptor instead.
+func (*SSHPutRoleRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SSHPutRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SSHPutRoleRequest) GetCertType() SSHCertificateType {
+	if x != nil {
+		return x.CertType
+	}
+	return SSHCertificateType_USER
+}
+
+func (x *SSHPutRoleRequest) GetAllowedPrincipals() []string {
+	if x != nil {
+		return x.AllowedPrincipals
+	}
+	return nil
+}
+
+func (x *SSHPutRoleRequest) GetDefaultPrincipals() []string {
+	if x != nil {
+		return x.DefaultPrincipals
+	}
+	return nil
+}
+
+func (x *SSHPutRoleRequest) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *SSHPutRoleRequest) GetCriticalOptions() map[string]string {
+	if x != nil {
+		return x.CriticalOptions
+	}
+	return nil
+}
+
+func (x *SSHPutRoleRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *SSHPutRoleRequest) GetMaxTTL() string {
+	if x != nil {
+		return x.MaxTTL
+	}
+	return ""
+}
+
+type SSHPutRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SSHPutRoleResponse) Reset() {
+	*x = SSHPutRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHPutRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHPutRoleResponse) ProtoMessage() {}
+
+func (x *SSHPutRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHPutRoleResponse.ProtoReflect.Descriptor instead.
+func (*SSHPutRoleResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{118}
+}
+
+type SignSSHKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// publicKey is the key to sign in authorized_keys format.
+	PublicKey  string   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Principals []string `protobuf:"bytes,3,rep,name=principals,proto3" json:"principals,omitempty"`
+	Ttl        string   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *SignSSHKeyRequest) Reset() {
+	*x = SignSSHKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignSSHKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSSHKeyRequest) ProtoMessage() {}
+
+func (x *SignSSHKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSSHKeyRequest.ProtoReflect.Descriptor instead.
+func (*SignSSHKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SignSSHKeyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SignSSHKeyRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SignSSHKeyRequest) GetPrincipals() []string {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+func (x *SignSSHKeyRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type SignSSHKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signedKey is the signed certificate in authorized_keys format.
+	SignedKey    string `protobuf:"bytes,1,opt,name=signedKey,proto3" json:"signedKey,omitempty"`
+	SerialNumber uint64 `protobuf:"varint,2,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+	ValidBefore  int64  `protobuf:"varint,3,opt,name=validBefore,proto3" json:"validBefore,omitempty"`
+}
+
+func (x *SignSSHKeyResponse) Reset() {
+	*x = SignSSHKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignSSHKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSSHKeyResponse) ProtoMessage() {}
+
+func (x *SignSSHKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSSHKeyResponse.ProtoReflect.Descriptor instead.
+func (*SignSSHKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SignSSHKeyResponse) GetSignedKey() string {
+	if x != nil {
+		return x.SignedKey
+	}
+	return ""
+}
+
+func (x *SignSSHKeyResponse) GetSerialNumber() uint64 {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return 0
+}
+
+func (x *SignSSHKeyResponse) GetValidBefore() int64 {
+	if x != nil {
+		return x.ValidBefore
+	}
+	return 0
+}
+
+type SystemGenerateAccessTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string            `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	Namespace string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	EnabledAt int64             `protobuf:"varint,3,opt,name=enabledAt,proto3" json:"enabledAt,omitempty"`
+	ExpiresAt int64             `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Metadata  map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Acls      []*ACL            `protobuf:"bytes,6,rep,name=acls,proto3" json:"acls,omitempty"`
+	Policies  []string          `protobuf:"bytes,7,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *SystemGenerateAccessTokenRequest) Reset() {
+	*x = SystemGenerateAccessTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGenerateAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGenerateAccessTokenRequest) ProtoMessage() {}
+
+func (x *SystemGenerateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGenerateAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*SystemGenerateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetEnabledAt() int64 {
+	if x != nil {
+		return x.EnabledAt
+	}
+	return 0
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetAcls() []*ACL {
+	if x != nil {
+		return x.Acls
+	}
+	return nil
+}
+
+func (x *SystemGenerateAccessTokenRequest) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SystemGenerateAccessTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token *AccessToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *SystemGenerateAccessTokenResponse) Reset() {
+	*x = SystemGenerateAccessTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGenerateAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGenerateAccessTokenResponse) ProtoMessage() {}
+
+func (x *SystemGenerateAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGenerateAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*SystemGenerateAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SystemGenerateAccessTokenResponse) GetToken() *AccessToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type SystemGenerateGatekeeperTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	Ttl        string   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	NumUses    uint32   `protobuf:"varint,3,opt,name=numUses,proto3" json:"numUses,omitempty"`
+	Label      string   `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *SystemGenerateGatekeeperTokenRequest) Reset() {
+	*x = SystemGenerateGatekeeperTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGenerateGatekeeperTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGenerateGatekeeperTokenRequest) ProtoMessage() {}
+
+func (x *SystemGenerateGatekeeperTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGenerateGatekeeperTokenRequest.ProtoReflect.Descriptor instead.
+func (*SystemGenerateGatekeeperTokenRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *SystemGenerateGatekeeperTokenRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemGenerateGatekeeperTokenRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *SystemGenerateGatekeeperTokenRequest) GetNumUses() uint32 {
+	if x != nil {
+		return x.NumUses
+	}
+	return 0
+}
+
+func (x *SystemGenerateGatekeeperTokenRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type SystemGenerateGatekeeperTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Accessor        string `protobuf:"bytes,2,opt,name=accessor,proto3" json:"accessor,omitempty"`
+}
+
+func (x *SystemGenerateGatekeeperTokenResponse) Reset() {
+	*x = SystemGenerateGatekeeperTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGenerateGatekeeperTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGenerateGatekeeperTokenResponse) ProtoMessage() {}
+
+func (x *SystemGenerateGatekeeperTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGenerateGatekeeperTokenResponse.ProtoReflect.Descriptor instead.
+func (*SystemGenerateGatekeeperTokenResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SystemGenerateGatekeeperTokenResponse) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemGenerateGatekeeperTokenResponse) GetAccessor() string {
+	if x != nil {
+		return x.Accessor
+	}
+	return ""
+}
+
+type SystemInitializeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumUnsealKeys           uint32 `protobuf:"varint,1,opt,name=numUnsealKeys,proto3" json:"numUnsealKeys,omitempty"`
+	UnsealKeyThreshold      uint32 `protobuf:"varint,2,opt,name=unsealKeyThreshold,proto3" json:"unsealKeyThreshold,omitempty"`
+	GenerateGatekeeperToken bool   `protobuf:"varint,3,opt,name=generateGatekeeperToken,proto3" json:"generateGatekeeperToken,omitempty"`
+}
+
+func (x *SystemInitializeRequest) Reset() {
+	*x = SystemInitializeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemInitializeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInitializeRequest) ProtoMessage() {}
+
+func (x *SystemInitializeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInitializeRequest.ProtoReflect.Descriptor instead.
+func (*SystemInitializeRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SystemInitializeRequest) GetNumUnsealKeys() uint32 {
+	if x != nil {
+		return x.NumUnsealKeys
+	}
+	return 0
+}
+
+func (x *SystemInitializeRequest) GetUnsealKeyThreshold() uint32 {
+	if x != nil {
+		return x.UnsealKeyThreshold
+	}
+	return 0
+}
+
+func (x *SystemInitializeRequest) GetGenerateGatekeeperToken() bool {
+	if x != nil {
+		return x.GenerateGatekeeperToken
+	}
+	return false
+}
+
+type SystemInitializeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	AccessKey       string   `protobuf:"bytes,2,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,3,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+}
+
+func (x *SystemInitializeResponse) Reset() {
+	*x = SystemInitializeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemInitializeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInitializeResponse) ProtoMessage() {}
+
+func (x *SystemInitializeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInitializeResponse.ProtoReflect.Descriptor instead.
+func (*SystemInitializeResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SystemInitializeResponse) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemInitializeResponse) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *SystemInitializeResponse) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+type SystemListGatekeeperTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool     `protobuf:"varint,3,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemListGatekeeperTokensRequest) Reset() {
+	*x = SystemListGatekeeperTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemListGatekeeperTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemListGatekeeperTokensRequest) ProtoMessage() {}
+
+func (x *SystemListGatekeeperTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemListGatekeeperTokensRequest.ProtoReflect.Descriptor instead.
+func (*SystemListGatekeeperTokensRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SystemListGatekeeperTokensRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemListGatekeeperTokensRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemListGatekeeperTokensRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemListGatekeeperTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*GatekeeperToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *SystemListGatekeeperTokensResponse) Reset() {
+	*x = SystemListGatekeeperTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemListGatekeeperTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemListGatekeeperTokensResponse) ProtoMessage() {}
+
+func (x *SystemListGatekeeperTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemListGatekeeperTokensResponse.ProtoReflect.Descriptor instead.
+func (*SystemListGatekeeperTokensResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SystemListGatekeeperTokensResponse) GetTokens() []*GatekeeperToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type SystemPruneTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+}
+
+func (x *SystemPruneTokensRequest) Reset() {
+	*x = SystemPruneTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPruneTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPruneTokensRequest) ProtoMessage() {}
+
+func (x *SystemPruneTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPruneTokensRequest.ProtoReflect.Descriptor instead.
+func (*SystemPruneTokensRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SystemPruneTokensRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+type SystemPruneTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SystemPruneTokensResponse) Reset() {
+	*x = SystemPruneTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPruneTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPruneTokensResponse) ProtoMessage() {}
+
+func (x *SystemPruneTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPruneTokensResponse.ProtoReflect.Descriptor instead.
+func (*SystemPruneTokensResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{130}
+}
+
+type SystemRekeyItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool     `protobuf:"varint,3,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemRekeyItemsRequest) Reset() {
+	*x = SystemRekeyItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRekeyItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRekeyItemsRequest) ProtoMessage() {}
+
+func (x *SystemRekeyItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRekeyItemsRequest.ProtoReflect.Descriptor instead.
+func (*SystemRekeyItemsRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SystemRekeyItemsRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemRekeyItemsRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemRekeyItemsRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemRekeyItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumItems uint64 `protobuf:"varint,1,opt,name=numItems,proto3" json:"numItems,omitempty"`
+}
+
+func (x *SystemRekeyItemsResponse) Reset() {
+	*x = SystemRekeyItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRekeyItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRekeyItemsResponse) ProtoMessage() {}
+
+func (x *SystemRekeyItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRekeyItemsResponse.ProtoReflect.Descriptor instead.
+func (*SystemRekeyItemsResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SystemRekeyItemsResponse) GetNumItems() uint64 {
+	if x != nil {
+		return x.NumItems
+	}
+	return 0
+}
+
+type SystemRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SystemRestoreRequest) Reset() {
+	*x = SystemRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRestoreRequest) ProtoMessage() {}
+
+func (x *SystemRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRestoreRequest.ProtoReflect.Descriptor instead.
+func (*SystemRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *SystemRestoreRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SystemRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BarrierID string `protobuf:"bytes,1,opt,name=barrierID,proto3" json:"barrierID,omitempty"`
+	NumItems  uint64 `protobuf:"varint,2,opt,name=numItems,proto3" json:"numItems,omitempty"`
+}
+
+func (x *SystemRestoreResponse) Reset() {
+	*x = SystemRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRestoreResponse) ProtoMessage() {}
+
+func (x *SystemRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRestoreResponse.ProtoReflect.Descriptor instead.
+func (*SystemRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *SystemRestoreResponse) GetBarrierID() string {
+	if x != nil {
+		return x.BarrierID
+	}
+	return ""
+}
+
+func (x *SystemRestoreResponse) GetNumItems() uint64 {
+	if x != nil {
+		return x.NumItems
+	}
+	return 0
+}
+
+type SystemRotateAccessKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+}
+
+func (x *SystemRotateAccessKeyRequest) Reset() {
+	*x = SystemRotateAccessKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateAccessKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateAccessKeyRequest) ProtoMessage() {}
+
+func (x *SystemRotateAccessKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateAccessKeyRequest.ProtoReflect.Descriptor instead.
+func (*SystemRotateAccessKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SystemRotateAccessKeyRequest) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+type SystemRotateAccessKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+}
+
+func (x *SystemRotateAccessKeyResponse) Reset() {
+	*x = SystemRotateAccessKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateAccessKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateAccessKeyResponse) ProtoMessage() {}
+
+func (x *SystemRotateAccessKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateAccessKeyResponse.ProtoReflect.Descriptor instead.
+func (*SystemRotateAccessKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *SystemRotateAccessKeyResponse) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+type SystemRotateEncryptionKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool   `protobuf:"varint,2,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemRotateEncryptionKeyRequest) Reset() {
+	*x = SystemRotateEncryptionKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *SystemRotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*SystemRotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *SystemRotateEncryptionKeyRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemRotateEncryptionKeyRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemRotateEncryptionKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SystemRotateEncryptionKeyResponse) Reset() {
+	*x = SystemRotateEncryptionKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *SystemRotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*SystemRotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{138}
+}
+
+type SystemRotateGatekeeperTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+}
+
+func (x *SystemRotateGatekeeperTokenRequest) Reset() {
+	*x = SystemRotateGatekeeperTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateGatekeeperTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateGatekeeperTokenRequest) ProtoMessage() {}
+
+func (x *SystemRotateGatekeeperTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateGatekeeperTokenRequest.ProtoReflect.Descriptor instead.
+func (*SystemRotateGatekeeperTokenRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *SystemRotateGatekeeperTokenRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+type SystemRotateGatekeeperTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Accessor        string `protobuf:"bytes,2,opt,name=accessor,proto3" json:"accessor,omitempty"`
+}
+
+func (x *SystemRotateGatekeeperTokenResponse) Reset() {
+	*x = SystemRotateGatekeeperTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateGatekeeperTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateGatekeeperTokenResponse) ProtoMessage() {}
+
+func (x *SystemRotateGatekeeperTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateGatekeeperTokenResponse.ProtoReflect.Descriptor instead.
+func (*SystemRotateGatekeeperTokenResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *SystemRotateGatekeeperTokenResponse) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemRotateGatekeeperTokenResponse) GetAccessor() string {
+	if x != nil {
+		return x.Accessor
+	}
+	return ""
+}
+
+type SystemRotateUnsealKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys         []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	NumUnsealKeys      uint32   `protobuf:"varint,2,opt,name=numUnsealKeys,proto3" json:"numUnsealKeys,omitempty"`
+	UnsealKeyThreshold uint32   `protobuf:"varint,3,opt,name=unsealKeyThreshold,proto3" json:"unsealKeyThreshold,omitempty"`
+}
+
+func (x *SystemRotateUnsealKeysRequest) Reset() {
+	*x = SystemRotateUnsealKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateUnsealKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateUnsealKeysRequest) ProtoMessage() {}
+
+func (x *SystemRotateUnsealKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateUnsealKeysRequest.ProtoReflect.Descriptor instead.
+func (*SystemRotateUnsealKeysRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *SystemRotateUnsealKeysRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemRotateUnsealKeysRequest) GetNumUnsealKeys() uint32 {
+	if x != nil {
+		return x.NumUnsealKeys
+	}
+	return 0
+}
+
+func (x *SystemRotateUnsealKeysRequest) GetUnsealKeyThreshold() uint32 {
+	if x != nil {
+		return x.UnsealKeyThreshold
+	}
+	return 0
+}
+
+type SystemRotateUnsealKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+}
+
+func (x *SystemRotateUnsealKeysResponse) Reset() {
+	*x = SystemRotateUnsealKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRotateUnsealKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRotateUnsealKeysResponse) ProtoMessage() {}
+
+func (x *SystemRotateUnsealKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRotateUnsealKeysResponse.ProtoReflect.Descriptor instead.
+func (*SystemRotateUnsealKeysResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *SystemRotateUnsealKeysResponse) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+type SystemRevokeGatekeeperTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+}
+
+func (x *SystemRevokeGatekeeperTokenRequest) Reset() {
+	*x = SystemRevokeGatekeeperTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRevokeGatekeeperTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRevokeGatekeeperTokenRequest) ProtoMessage() {}
+
+func (x *SystemRevokeGatekeeperTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRevokeGatekeeperTokenRequest.ProtoReflect.Descriptor instead.
+func (*SystemRevokeGatekeeperTokenRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SystemRevokeGatekeeperTokenRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+type SystemRevokeGatekeeperTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SystemRevokeGatekeeperTokenResponse) Reset() {
+	*x = SystemRevokeGatekeeperTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRevokeGatekeeperTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRevokeGatekeeperTokenResponse) ProtoMessage() {}
+
+func (x *SystemRevokeGatekeeperTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRevokeGatekeeperTokenResponse.ProtoReflect.Descriptor instead.
+func (*SystemRevokeGatekeeperTokenResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{144}
+}
+
+type SystemRevokeGatekeeperTokenAccessorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accessor        string   `protobuf:"bytes,1,opt,name=accessor,proto3" json:"accessor,omitempty"`
+	UnsealKeys      []string `protobuf:"bytes,2,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,3,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool     `protobuf:"varint,4,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) Reset() {
+	*x = SystemRevokeGatekeeperTokenAccessorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRevokeGatekeeperTokenAccessorRequest) ProtoMessage() {}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRevokeGatekeeperTokenAccessorRequest.ProtoReflect.Descriptor instead.
+func (*SystemRevokeGatekeeperTokenAccessorRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) GetAccessor() string {
+	if x != nil {
+		return x.Accessor
+	}
+	return ""
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemRevokeGatekeeperTokenAccessorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorResponse) Reset() {
+	*x = SystemRevokeGatekeeperTokenAccessorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRevokeGatekeeperTokenAccessorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRevokeGatekeeperTokenAccessorResponse) ProtoMessage() {}
+
+func (x *SystemRevokeGatekeeperTokenAccessorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRevokeGatekeeperTokenAccessorResponse.ProtoReflect.Descriptor instead.
+func (*SystemRevokeGatekeeperTokenAccessorResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{146}
+}
+
+type SystemSealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatekeeperToken string `protobuf:"bytes,1,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool   `protobuf:"varint,2,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemSealRequest) Reset() {
+	*x = SystemSealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSealRequest) ProtoMessage() {}
+
+func (x *SystemSealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSealRequest.ProtoReflect.Descriptor instead.
+func (*SystemSealRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *SystemSealRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemSealRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemSealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sealed bool `protobuf:"varint,1,opt,name=sealed,proto3" json:"sealed,omitempty"`
+}
+
+func (x *SystemSealResponse) Reset() {
+	*x = SystemSealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSealResponse) ProtoMessage() {}
+
+func (x *SystemSealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSealResponse.ProtoReflect.Descriptor instead.
+func (*SystemSealResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *SystemSealResponse) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+type SystemShredNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool     `protobuf:"varint,3,opt,name=renew,proto3" json:"renew,omitempty"`
+	Namespace       string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *SystemShredNamespaceRequest) Reset() {
+	*x = SystemShredNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemShredNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemShredNamespaceRequest) ProtoMessage() {}
+
+func (x *SystemShredNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemShredNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*SystemShredNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SystemShredNamespaceRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemShredNamespaceRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemShredNamespaceRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+func (x *SystemShredNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type SystemShredNamespaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumItems uint64 `protobuf:"varint,1,opt,name=numItems,proto3" json:"numItems,omitempty"`
+}
+
+func (x *SystemShredNamespaceResponse) Reset() {
+	*x = SystemShredNamespaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemShredNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemShredNamespaceResponse) ProtoMessage() {}
+
+func (x *SystemShredNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemShredNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*SystemShredNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SystemShredNamespaceResponse) GetNumItems() uint64 {
+	if x != nil {
+		return x.NumItems
+	}
+	return 0
+}
+
+type SystemSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool     `protobuf:"varint,3,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemSnapshotRequest) Reset() {
+	*x = SystemSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSnapshotRequest) ProtoMessage() {}
+
+func (x *SystemSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SystemSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SystemSnapshotRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemSnapshotRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemSnapshotRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SystemSnapshotResponse) Reset() {
+	*x = SystemSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSnapshotResponse) ProtoMessage() {}
+
+func (x *SystemSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SystemSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SystemSnapshotResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SystemStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SystemStatusRequest) Reset() {
+	*x = SystemStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStatusRequest) ProtoMessage() {}
+
+func (x *SystemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStatusRequest.ProtoReflect.Descriptor instead.
+func (*SystemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{153}
+}
+
+type SystemStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerTimestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=serverTimestamp,proto3" json:"serverTimestamp,omitempty"`
+	Initialized     bool                   `protobuf:"varint,2,opt,name=initialized,proto3" json:"initialized,omitempty"`
+	Sealed          bool                   `protobuf:"varint,3,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	HaEnabled       bool                   `protobuf:"varint,4,opt,name=haEnabled,proto3" json:"haEnabled,omitempty"`
+	Active          bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	NodeID          string                 `protobuf:"bytes,6,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Leader          *LeaderInfo            `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
+}
+
+func (x *SystemStatusResponse) Reset() {
+	*x = SystemStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStatusResponse) ProtoMessage() {}
+
+func (x *SystemStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStatusResponse.ProtoReflect.Descriptor instead.
+func (*SystemStatusResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SystemStatusResponse) GetServerTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTimestamp
+	}
+	return nil
+}
+
+func (x *SystemStatusResponse) GetInitialized() bool {
+	if x != nil {
+		return x.Initialized
+	}
+	return false
+}
+
+func (x *SystemStatusResponse) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+func (x *SystemStatusResponse) GetHaEnabled() bool {
+	if x != nil {
+		return x.HaEnabled
+	}
+	return false
+}
+
+func (x *SystemStatusResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SystemStatusResponse) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *SystemStatusResponse) GetLeader() *LeaderInfo {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+type SystemUnsealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys           []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken      string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	RenewGatekeeperToken bool     `protobuf:"varint,3,opt,name=renewGatekeeperToken,proto3" json:"renewGatekeeperToken,omitempty"`
+}
+
+func (x *SystemUnsealRequest) Reset() {
+	*x = SystemUnsealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemUnsealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUnsealRequest) ProtoMessage() {}
+
+func (x *SystemUnsealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUnsealRequest.ProtoReflect.Descriptor instead.
+func (*SystemUnsealRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SystemUnsealRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemUnsealRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemUnsealRequest) GetRenewGatekeeperToken() bool {
+	if x != nil {
+		return x.RenewGatekeeperToken
+	}
+	return false
+}
+
+type SystemUnsealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sealed bool `protobuf:"varint,1,opt,name=sealed,proto3" json:"sealed,omitempty"`
+}
+
+func (x *SystemUnsealResponse) Reset() {
+	*x = SystemUnsealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemUnsealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUnsealResponse) ProtoMessage() {}
+
+func (x *SystemUnsealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUnsealResponse.ProtoReflect.Descriptor instead.
+func (*SystemUnsealResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SystemUnsealResponse) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+type SystemUpgradeItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnsealKeys      []string `protobuf:"bytes,1,rep,name=unsealKeys,proto3" json:"unsealKeys,omitempty"`
+	GatekeeperToken string   `protobuf:"bytes,2,opt,name=gatekeeperToken,proto3" json:"gatekeeperToken,omitempty"`
+	Renew           bool     `protobuf:"varint,3,opt,name=renew,proto3" json:"renew,omitempty"`
+}
+
+func (x *SystemUpgradeItemsRequest) Reset() {
+	*x = SystemUpgradeItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemUpgradeItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUpgradeItemsRequest) ProtoMessage() {}
+
+func (x *SystemUpgradeItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUpgradeItemsRequest.ProtoReflect.Descriptor instead.
+func (*SystemUpgradeItemsRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SystemUpgradeItemsRequest) GetUnsealKeys() []string {
+	if x != nil {
+		return x.UnsealKeys
+	}
+	return nil
+}
+
+func (x *SystemUpgradeItemsRequest) GetGatekeeperToken() string {
+	if x != nil {
+		return x.GatekeeperToken
+	}
+	return ""
+}
+
+func (x *SystemUpgradeItemsRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+type SystemUpgradeItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumItems uint64 `protobuf:"varint,1,opt,name=numItems,proto3" json:"numItems,omitempty"`
+}
+
+func (x *SystemUpgradeItemsResponse) Reset() {
+	*x = SystemUpgradeItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemUpgradeItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUpgradeItemsResponse) ProtoMessage() {}
+
+func (x *SystemUpgradeItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUpgradeItemsResponse.ProtoReflect.Descriptor instead.
+func (*SystemUpgradeItemsResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SystemUpgradeItemsResponse) GetNumItems() uint64 {
+	if x != nil {
+		return x.NumItems
+	}
+	return 0
+}
+
+type TransitCreateKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type CipherType `protobuf:"varint,2,opt,name=type,proto3,enum=kstash.v1.CipherType" json:"type,omitempty"`
+}
+
+func (x *TransitCreateKeyRequest) Reset() {
+	*x = TransitCreateKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitCreateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitCreateKeyRequest) ProtoMessage() {}
+
+func (x *TransitCreateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitCreateKeyRequest.ProtoReflect.Descriptor instead.
+func (*TransitCreateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *TransitCreateKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitCreateKeyRequest) GetType() CipherType {
+	if x != nil {
+		return x.Type
+	}
+	return CipherType_AES256_GCM
+}
+
+type TransitCreateKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TransitCreateKeyResponse) Reset() {
+	*x = TransitCreateKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitCreateKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitCreateKeyResponse) ProtoMessage() {}
+
+func (x *TransitCreateKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitCreateKeyResponse.ProtoReflect.Descriptor instead.
+func (*TransitCreateKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{160}
+}
+
+type TransitDecryptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ciphertext     string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	AssociatedData []byte `protobuf:"bytes,3,opt,name=associatedData,proto3" json:"associatedData,omitempty"`
+}
+
+func (x *TransitDecryptRequest) Reset() {
+	*x = TransitDecryptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitDecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDecryptRequest) ProtoMessage() {}
+
+func (x *TransitDecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDecryptRequest.ProtoReflect.Descriptor instead.
+func (*TransitDecryptRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *TransitDecryptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitDecryptRequest) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitDecryptRequest) GetAssociatedData() []byte {
+	if x != nil {
+		return x.AssociatedData
+	}
+	return nil
+}
+
+type TransitDecryptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plaintext []byte `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+}
+
+func (x *TransitDecryptResponse) Reset() {
+	*x = TransitDecryptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitDecryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDecryptResponse) ProtoMessage() {}
+
+func (x *TransitDecryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDecryptResponse.ProtoReflect.Descriptor instead.
+func (*TransitDecryptResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *TransitDecryptResponse) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+type TransitDeleteKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *TransitDeleteKeyRequest) Reset() {
+	*x = TransitDeleteKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitDeleteKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDeleteKeyRequest) ProtoMessage() {}
+
+func (x *TransitDeleteKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDeleteKeyRequest.ProtoReflect.Descriptor instead.
+func (*TransitDeleteKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *TransitDeleteKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TransitDeleteKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TransitDeleteKeyResponse) Reset() {
+	*x = TransitDeleteKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitDeleteKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDeleteKeyResponse) ProtoMessage() {}
+
+func (x *TransitDeleteKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDeleteKeyResponse.ProtoReflect.Descriptor instead.
+func (*TransitDeleteKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{164}
+}
+
+type TransitEncryptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Plaintext []byte `protobuf:"bytes,2,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// associatedData is authenticated but not encrypted, and the same data must be provided to decrypt the ciphertext.
+	AssociatedData []byte `protobuf:"bytes,3,opt,name=associatedData,proto3" json:"associatedData,omitempty"`
+}
+
+func (x *TransitEncryptRequest) Reset() {
+	*x = TransitEncryptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitEncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitEncryptRequest) ProtoMessage() {}
+
+func (x *TransitEncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitEncryptRequest.ProtoReflect.Descriptor instead.
+func (*TransitEncryptRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *TransitEncryptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitEncryptRequest) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *TransitEncryptRequest) GetAssociatedData() []byte {
+	if x != nil {
+		return x.AssociatedData
+	}
+	return nil
+}
+
+type TransitEncryptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ciphertext string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	KeyVersion uint32 `protobuf:"varint,2,opt,name=keyVersion,proto3" json:"keyVersion,omitempty"`
+}
+
+func (x *TransitEncryptResponse) Reset() {
+	*x = TransitEncryptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitEncryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitEncryptResponse) ProtoMessage() {}
+
+func (x *TransitEncryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitEncryptResponse.ProtoReflect.Descriptor instead.
+func (*TransitEncryptResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *TransitEncryptResponse) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitEncryptResponse) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitGetKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *TransitGetKeyRequest) Reset() {
+	*x = TransitGetKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitGetKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitGetKeyRequest) ProtoMessage() {}
+
+func (x *TransitGetKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitGetKeyRequest.ProtoReflect.Descriptor instead.
+func (*TransitGetKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *TransitGetKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TransitGetKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *TransitKeyInfo `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TransitGetKeyResponse) Reset() {
+	*x = TransitGetKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitGetKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitGetKeyResponse) ProtoMessage() {}
+
+func (x *TransitGetKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitGetKeyResponse.ProtoReflect.Descriptor instead.
+func (*TransitGetKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *TransitGetKeyResponse) GetKey() *TransitKeyInfo {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type TransitHMACRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *TransitHMACRequest) Reset() {
+	*x = TransitHMACRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitHMACRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitHMACRequest) ProtoMessage() {}
+
+func (x *TransitHMACRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitHMACRequest.ProtoReflect.Descriptor instead.
+func (*TransitHMACRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *TransitHMACRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitHMACRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type TransitHMACResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hmac       string `protobuf:"bytes,1,opt,name=hmac,proto3" json:"hmac,omitempty"`
+	KeyVersion uint32 `protobuf:"varint,2,opt,name=keyVersion,proto3" json:"keyVersion,omitempty"`
+}
+
+func (x *TransitHMACResponse) Reset() {
+	*x = TransitHMACResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitHMACResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitHMACResponse) ProtoMessage() {}
+
+func (x *TransitHMACResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitHMACResponse.ProtoReflect.Descriptor instead.
+func (*TransitHMACResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *TransitHMACResponse) GetHmac() string {
+	if x != nil {
+		return x.Hmac
+	}
+	return ""
+}
+
+func (x *TransitHMACResponse) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitRewrapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ciphertext     string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	AssociatedData []byte `protobuf:"bytes,3,opt,name=associatedData,proto3" json:"associatedData,omitempty"`
+}
+
+func (x *TransitRewrapRequest) Reset() {
+	*x = TransitRewrapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitRewrapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitRewrapRequest) ProtoMessage() {}
+
+func (x *TransitRewrapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitRewrapRequest.ProtoReflect.Descriptor instead.
+func (*TransitRewrapRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *TransitRewrapRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitRewrapRequest) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitRewrapRequest) GetAssociatedData() []byte {
+	if x != nil {
+		return x.AssociatedData
+	}
+	return nil
+}
+
+type TransitRewrapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ciphertext string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	KeyVersion uint32 `protobuf:"varint,2,opt,name=keyVersion,proto3" json:"keyVersion,omitempty"`
+}
+
+func (x *TransitRewrapResponse) Reset() {
+	*x = TransitRewrapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitRewrapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitRewrapResponse) ProtoMessage() {}
+
+func (x *TransitRewrapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitRewrapResponse.ProtoReflect.Descriptor instead.
+func (*TransitRewrapResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *TransitRewrapResponse) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitRewrapResponse) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitRotateKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *TransitRotateKeyRequest) Reset() {
+	*x = TransitRotateKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitRotateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitRotateKeyRequest) ProtoMessage() {}
+
+func (x *TransitRotateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitRotateKeyRequest.ProtoReflect.Descriptor instead.
+func (*TransitRotateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *TransitRotateKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TransitRotateKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyVersion uint32 `protobuf:"varint,1,opt,name=keyVersion,proto3" json:"keyVersion,omitempty"`
+}
+
+func (x *TransitRotateKeyResponse) Reset() {
+	*x = TransitRotateKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitRotateKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitRotateKeyResponse) ProtoMessage() {}
+
+func (x *TransitRotateKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitRotateKeyResponse.ProtoReflect.Descriptor instead.
+func (*TransitRotateKeyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *TransitRotateKeyResponse) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitSignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *TransitSignRequest) Reset() {
+	*x = TransitSignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitSignRequest) ProtoMessage() {}
+
+func (x *TransitSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitSignRequest.ProtoReflect.Descriptor instead.
+func (*TransitSignRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *TransitSignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitSignRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type TransitSignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature  string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	KeyVersion uint32 `protobuf:"varint,2,opt,name=keyVersion,proto3" json:"keyVersion,omitempty"`
+}
+
+func (x *TransitSignResponse) Reset() {
+	*x = TransitSignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitSignResponse) ProtoMessage() {}
+
+func (x *TransitSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitSignResponse.ProtoReflect.Descriptor instead.
+func (*TransitSignResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *TransitSignResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransitSignResponse) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// Exactly one of signature and hmac must be set.
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Hmac      string `protobuf:"bytes,4,opt,name=hmac,proto3" json:"hmac,omitempty"`
+}
+
+func (x *TransitVerifyRequest) Reset() {
+	*x = TransitVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitVerifyRequest) ProtoMessage() {}
+
+func (x *TransitVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitVerifyRequest.ProtoReflect.Descriptor instead.
+func (*TransitVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *TransitVerifyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitVerifyRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TransitVerifyRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransitVerifyRequest) GetHmac() string {
+	if x != nil {
+		return x.Hmac
+	}
+	return ""
+}
+
+type TransitVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *TransitVerifyResponse) Reset() {
+	*x = TransitVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kstash_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitVerifyResponse) ProtoMessage() {}
+
+func (x *TransitVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kstash_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitVerifyResponse.ProtoReflect.Descriptor instead.
+func (*TransitVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_kstash_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *TransitVerifyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+var File_kstash_proto protoreflect.FileDescriptor
+
+var file_kstash_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x4b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x4b, 0x65, 0x79, 0x22,
+	0x99, 0x02, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x4b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65,
+	0x79, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x06, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x77, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0xc2, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x2a, 0x0a, 0x03,
+	0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x4c, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x03, 0x41, 0x43, 0x4c, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x0d, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x12, 0x5a, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x41, 0x43, 0x4c, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c,
+	0x52, 0x03, 0x61, 0x63, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x95, 0x02,
+	0x0a, 0x0e, 0x41, 0x43, 0x4c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x65, 0x6e, 0x79, 0x12, 0x49, 0x0a, 0x14, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x14, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x22, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x52,
+	0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40,
+	0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04,
+	0x61, 0x63, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73,
+	0x22, 0xeb, 0x01, 0x0a, 0x0f, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x55, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x6e, 0x75, 0x6d, 0x55, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x5c,
+	0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7e, 0x0a, 0x0e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x8a, 0x03, 0x0a,
+	0x0a, 0x4b, 0x56, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75,
+	0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x75, 0x65, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xde, 0x02, 0x0a, 0x05, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x41,
+	0x74, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22,
+	0xd0, 0x01, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
+	0x78, 0x54, 0x54, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x54,
+	0x54, 0x4c, 0x22, 0x49, 0x0a, 0x05, 0x50, 0x4b, 0x49, 0x43, 0x41, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x98, 0x02,
+	0x0a, 0x07, 0x50, 0x4b, 0x49, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x61,
+	0x72, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x61, 0x72, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x53, 0x75, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x50, 0x53, 0x41, 0x4e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x50, 0x53, 0x41, 0x4e, 0x73, 0x12, 0x2f, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x4b, 0x65,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x54, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x54, 0x4c, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x50, 0x4b, 0x49,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc0, 0x01,
+	0x0a, 0x14, 0x50, 0x4b, 0x49, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x45, 0x0a, 0x05, 0x53, 0x53, 0x48, 0x43, 0x41, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xf8, 0x03, 0x0a, 0x07, 0x53, 0x53, 0x48, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x65, 0x72, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73,
+	0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63,
+	0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x42,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x53, 0x48, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x51, 0x0a, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x52, 0x6f, 0x6c, 0x65, 0x2e,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x54,
+	0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x54, 0x4c, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42,
+	0x0a, 0x14, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x6d, 0x61, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x68, 0x6d, 0x61, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x68, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x41, 0x43, 0x4c, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x12, 0x41, 0x43, 0x4c, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x43, 0x4c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x16, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12,
+	0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0x47, 0x0a, 0x17, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a,
+	0x16, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x44, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0x19, 0x0a,
+	0x17, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x22, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x25, 0x0a,
+	0x23, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x1f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2f, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a,
+	0x0a, 0x22, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x95, 0x01, 0x0a, 0x23, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x46, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x16, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x61, 0x78, 0x54, 0x54, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x54, 0x54, 0x4c, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3f, 0x0a, 0x0b, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x7e, 0x0a, 0x0e, 0x4b,
+	0x56, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x0c, 0x4b,
+	0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x34, 0x0a, 0x0d, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x45, 0x0a, 0x0c, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x0f, 0x0a, 0x0d,
+	0x4b, 0x56, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a,
+	0x0f, 0x4b, 0x56, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x12, 0x0a, 0x10, 0x4b, 0x56, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x4b, 0x56, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x4a, 0x0a, 0x15, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x88, 0x02, 0x0a, 0x14, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x65, 0x41, 0x74,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a, 0x0a, 0x15, 0x4b,
+	0x56, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x0f, 0x4b, 0x56, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x75, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x43, 0x0a, 0x10, 0x4b, 0x56, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4b, 0x0a,
+	0x11, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x32, 0x0a, 0x18, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x22, 0x35, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x1c, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x72, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x1f, 0x0a, 0x1d,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a,
+	0x14, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x0a, 0x16, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x17, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4b, 0x65, 0x79,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x3a, 0x0a,
+	0x16, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x4b, 0x49, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x17, 0x0a, 0x15, 0x50, 0x4b, 0x49, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x14, 0x50, 0x4b, 0x49,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x4b, 0x49, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x74, 0x6c, 0x22, 0x39, 0x0a, 0x15, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22,
+	0x11, 0x0a, 0x0f, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x34, 0x0a, 0x10, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x4b, 0x49, 0x47,
+	0x65, 0x74, 0x43, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x25, 0x0a, 0x11,
+	0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x72, 0x6c, 0x22, 0x27, 0x0a, 0x11, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x12,
+	0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x56, 0x0a, 0x12, 0x50, 0x4b,
+	0x49, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x4b, 0x49, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x50, 0x4b,
+	0x49, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x70, 0x53, 0x41, 0x4e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x70, 0x53, 0x41, 0x4e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x55, 0x0a, 0x10, 0x50, 0x4b, 0x49, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xa2,
+	0x02, 0x0a, 0x11, 0x50, 0x4b, 0x49, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x61, 0x72, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x42, 0x61, 0x72, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49,
+	0x50, 0x53, 0x41, 0x4e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x49, 0x50, 0x53, 0x41, 0x4e, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x54, 0x54, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x54, 0x54, 0x4c, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x4b, 0x49, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x10, 0x50, 0x4b, 0x49,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x31, 0x0a, 0x11, 0x50, 0x4b, 0x49, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x0e, 0x50, 0x4b, 0x49, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x54,
+	0x0a, 0x0f, 0x50, 0x4b, 0x49, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a,
+	0x14, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x11, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x31, 0x0a, 0x11, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22, 0x2a,
+	0x0a, 0x12, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x10, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x14,
+	0x53, 0x53, 0x48, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x53, 0x48, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x53, 0x48, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x15, 0x53, 0x53, 0x48,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x22, 0x11, 0x0a, 0x0f, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c,
+	0x0a, 0x12, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x53, 0x48, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x34, 0x0a, 0x12,
+	0x53, 0x53, 0x48, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x22, 0x33, 0x0a, 0x13, 0x53, 0x53, 0x48, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x96, 0x04, 0x0a, 0x11, 0x53, 0x53, 0x48, 0x50,
+	0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x53, 0x48, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x50, 0x75, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x50,
+	0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x54, 0x4c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x54, 0x4c, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x14, 0x0a, 0x12, 0x53, 0x53, 0x48, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x53,
+	0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22,
+	0x78, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xee, 0x02, 0x0a, 0x20, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x55, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a,
+	0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x21, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x88, 0x01,
+	0x0a, 0x24, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x55,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x55, 0x73,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x6d, 0x0a, 0x25, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65,
+	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x55,
+	0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x6e, 0x73,
+	0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x28,
+	0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x21, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x58,
+	0x0a, 0x22, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65,
+	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x38, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x79, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
+	0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61,
+	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x36, 0x0a, 0x18, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x51,
+	0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x72, 0x72, 0x69,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x3c, 0x0a, 0x1c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22,
+	0x3d, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x22, 0x62,
+	0x0a, 0x20, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74,
+	0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x22, 0x23, 0x0a, 0x21, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x22, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x23, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73,
+	0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e,
+	0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12,
+	0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x40, 0x0a, 0x1e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x4e,
+	0x0a, 0x22, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61,
+	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67,
+	0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25,
+	0x0a, 0x23, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61,
+	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x2a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77,
+	0x22, 0x2d, 0x0a, 0x2b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x53, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67,
+	0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61, 0x6c,
+	0x65, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x72,
+	0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61, 0x74,
+	0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0x3a, 0x0a, 0x1c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x72, 0x65, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x77, 0x0a, 0x15,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x2c, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x14, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x44, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x22, 0x93, 0x01, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e,
+	0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65,
+	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x47, 0x61, 0x74, 0x65, 0x6b,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x61,
+	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x22, 0x38, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x58, 0x0a,
+	0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0x36, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x2d, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x1a, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x15, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0x58,
+	0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x69,
+	0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65,
+	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x48, 0x4d, 0x41, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x48, 0x4d, 0x41, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6d, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6d, 0x61, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x77, 0x72, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0x57, 0x0a, 0x15, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x52, 0x65, 0x77, 0x72, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a,
+	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x53, 0x0a,
+	0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6d, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6d, 0x61, 0x63, 0x22, 0x2d, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x2a, 0x48, 0x0a, 0x0a, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x45, 0x53, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43,
+	0x4d, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x58, 0x43, 0x48, 0x41, 0x43, 0x48, 0x41, 0x32, 0x30,
+	0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x31, 0x33, 0x30, 0x35, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x41,
+	0x45, 0x53, 0x32, 0x35, 0x36, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x53, 0x49, 0x56, 0x10, 0x02, 0x2a,
+	0x29, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x0c, 0x55, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x47, 0x5a, 0x49, 0x50, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x0a, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05, 0x2a, 0x34, 0x0a, 0x0a, 0x50, 0x4b,
+	0x49, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x43, 0x5f, 0x50,
+	0x32, 0x35, 0x36, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x53, 0x41, 0x5f, 0x32, 0x30, 0x34,
+	0x38, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x02,
+	0x2a, 0x28, 0x0a, 0x12, 0x53, 0x53, 0x48, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x32, 0xb7, 0x46, 0x0a, 0x06, 0x4b,
+	0x53, 0x74, 0x61, 0x73, 0x68, 0x12, 0x65, 0x0a, 0x0a, 0x41, 0x43, 0x4c, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x43, 0x4c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x43,
+	0x4c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x6c, 0x2f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x0f,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12,
+	0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x20, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x3a, 0x01, 0x2a,
+	0x12, 0x7a, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa6, 0x01, 0x0a,
+	0x1b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9b, 0x01, 0x0a,
+	0x1b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x0f, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x50,
+	0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x75,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5c, 0x0a, 0x06, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x58, 0x0a, 0x05, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x05,
+	0x4b, 0x56, 0x50, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f,
+	0x70, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x08, 0x4b, 0x56, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x56, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f,
+	0x6b, 0x76, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x0d,
+	0x4b, 0x56, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x0d, 0x4b, 0x56, 0x50, 0x75, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f,
+	0x6b, 0x76, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x75, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x64, 0x0a, 0x08, 0x4b, 0x56, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x56, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22,
+	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x6b, 0x76, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x66, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x3a, 0x01, 0x2a,
+	0x12, 0x6a, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12,
+	0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x83, 0x01, 0x0a,
+	0x11, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x23, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x3a,
+	0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a,
+	0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x1f,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a,
+	0x12, 0x79, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x0f, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x75, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x50, 0x4b, 0x49, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0d, 0x50,
+	0x4b, 0x49, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x12, 0x1f, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69,
+	0x2f, 0x63, 0x61, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x5e, 0x0a, 0x08, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x41, 0x12, 0x1a, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x41,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x63, 0x61, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12,
+	0x5e, 0x0a, 0x09, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x52, 0x4c, 0x12, 0x1b, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x43, 0x52, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22,
+	0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x63, 0x72, 0x6c, 0x3a, 0x01, 0x2a, 0x12,
+	0x67, 0x0a, 0x0a, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x0b, 0x50, 0x4b, 0x49, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x12, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x63, 0x61, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5d, 0x0a, 0x08, 0x50, 0x4b, 0x49, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x12, 0x1a, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0a, 0x50, 0x4b, 0x49, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b,
+	0x49, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x50,
+	0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x09,
+	0x50, 0x4b, 0x49, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1b, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x59, 0x0a, 0x07, 0x50, 0x4b, 0x49, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x19, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x4b, 0x49, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x4b, 0x49, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6b, 0x69, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x0c, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x09, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0a,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x75, 0x74,
+	0x12, 0x1b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f,
+	0x70, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x53, 0x53, 0x48, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x73, 0x68, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0d, 0x53,
+	0x53, 0x48, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x12, 0x1f, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x73, 0x68,
+	0x2f, 0x63, 0x61, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x5e, 0x0a, 0x08, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x43, 0x41, 0x12, 0x1a, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x43, 0x41,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x73, 0x68, 0x2f, 0x63, 0x61, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12,
+	0x67, 0x0a, 0x0a, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x73, 0x68, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x0b, 0x53, 0x53, 0x48, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x12, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x53, 0x48, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x73, 0x68, 0x2f, 0x63, 0x61, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0a, 0x53, 0x53, 0x48, 0x50, 0x75, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x53, 0x48, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x53, 0x48,
+	0x50, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x73, 0x68,
+	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a,
+	0x0a, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x53, 0x48, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x73, 0x68, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x3a, 0x01,
+	0x2a, 0x12, 0x9c, 0x01, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2b, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a,
+	0x12, 0xad, 0x01, 0x0a, 0x1d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74,
+	0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x3a, 0x01, 0x2a,
+	0x12, 0x7d, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0xa0, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61,
+	0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2c,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x3a,
+	0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x2f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x6b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x6b,
+	0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x2f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x12, 0x8f, 0x01, 0x0a, 0x15,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9f, 0x01,
+	0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12,
+	0xa5, 0x01, 0x0a, 0x1b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x92, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x28, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22,
+	0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x2f, 0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a,
+	0x1b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74,
+	0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x3a, 0x01, 0x2a, 0x12, 0xc6, 0x01, 0x0a, 0x23, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x35, 0x2e, 0x6b,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x61, 0x74, 0x65,
+	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a,
+	0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x6b, 0x73,
+	0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x61,
+	0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x68, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x26, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x68, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x68, 0x72,
+	0x65, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x77, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x6a,
+	0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x73,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
+	0x75, 0x6e, 0x73, 0x65, 0x61, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x12, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x24, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x73, 0x74,
+	0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f,
+	0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x10, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x22,
+	0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x6b, 0x65, 0x79,
+	0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x0e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x20, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x47, 0x65, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x6b, 0x65, 0x79, 0x73,
+	0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x48, 0x4d, 0x41, 0x43, 0x12, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x48, 0x4d, 0x41, 0x43, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x48, 0x4d, 0x41, 0x43, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x68, 0x6d, 0x61, 0x63, 0x3a,
+	0x01, 0x2a, 0x12, 0x71, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x65, 0x77,
+	0x72, 0x61, 0x70, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x65, 0x77, 0x72, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x65, 0x77, 0x72, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x77, 0x72,
+	0x61, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e, 0x6b, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x3a, 0x01,
+	0x2a, 0x12, 0x71, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x12, 0x1f, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x3a, 0x01, 0x2a, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x6b, 0x61, 0x77, 0x69, 0x2f, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x2d, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kstash_proto_rawDescOnce sync.Once
+	file_kstash_proto_rawDescData = file_kstash_proto_rawDesc
+)
+
+func file_kstash_proto_rawDescGZIP() []byte {
+	file_kstash_proto_rawDescOnce.Do(func() {
+		file_kstash_proto_rawDescData = protoimpl.X.CompressGZIP(file_kstash_proto_rawDescData)
+	})
+	return file_kstash_proto_rawDescData
+}
+
+var file_kstash_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_kstash_proto_msgTypes = make([]protoimpl.MessageInfo, 190)
+var file_kstash_proto_goTypes = []interface{}{
+	(CipherType)(0),                                     // 0: kstash.v1.CipherType
+	(Compression)(0),                                    // 1: kstash.v1.Compression
+	(Permission)(0),                                     // 2: kstash.v1.Permission
+	(PKIKeyType)(0),                                     // 3: kstash.v1.PKIKeyType
+	(SSHCertificateType)(0),                             // 4: kstash.v1.SSHCertificateType
+	(*EncryptionKey)(nil),                               // 5: kstash.v1.EncryptionKey
+	(*KeychainSnapshot)(nil),                            // 6: kstash.v1.KeychainSnapshot
+	(*BackendItem)(nil),                                 // 7: kstash.v1.BackendItem
+	(*ChunkManifest)(nil),                               // 8: kstash.v1.ChunkManifest
+	(*NamespaceSecret)(nil),                             // 9: kstash.v1.NamespaceSecret
+	(*Item)(nil),                                        // 10: kstash.v1.Item
+	(*ACL)(nil),                                         // 11: kstash.v1.ACL
+	(*ACLConditions)(nil),                               // 12: kstash.v1.ACLConditions
+	(*TimeWindow)(nil),                                  // 13: kstash.v1.TimeWindow
+	(*ACLRule)(nil),                                     // 14: kstash.v1.ACLRule
+	(*ACLExplanation)(nil),                              // 15: kstash.v1.ACLExplanation
+	(*AccessToken)(nil),                                 // 16: kstash.v1.AccessToken
+	(*Policy)(nil),                                      // 17: kstash.v1.Policy
+	(*GatekeeperToken)(nil),                             // 18: kstash.v1.GatekeeperToken
+	(*LeaderInfo)(nil),                                  // 19: kstash.v1.LeaderInfo
+	(*SnapshotHeader)(nil),                              // 20: kstash.v1.SnapshotHeader
+	(*KVMetadata)(nil),                                  // 21: kstash.v1.KVMetadata
+	(*Lease)(nil),                                       // 22: kstash.v1.Lease
+	(*DatabaseConnection)(nil),                          // 23: kstash.v1.DatabaseConnection
+	(*DatabaseRole)(nil),                                // 24: kstash.v1.DatabaseRole
+	(*PKICA)(nil),                                       // 25: kstash.v1.PKICA
+	(*PKIRole)(nil),                                     // 26: kstash.v1.PKIRole
+	(*PKICertificate)(nil),                              // 27: kstash.v1.PKICertificate
+	(*PKIIssuedCertificate)(nil),                        // 28: kstash.v1.PKIIssuedCertificate
+	(*SSHCA)(nil),                                       // 29: kstash.v1.SSHCA
+	(*SSHRole)(nil),                                     // 30: kstash.v1.SSHRole
+	(*TransitKey)(nil),                                  // 31: kstash.v1.TransitKey
+	(*TransitKeyVersion)(nil),                           // 32: kstash.v1.TransitKeyVersion
+	(*TransitKeyInfo)(nil),                              // 33: kstash.v1.TransitKeyInfo
+	(*TransitPublicKey)(nil),                            // 34: kstash.v1.TransitPublicKey
+	(*ACLExplainRequest)(nil),                           // 35: kstash.v1.ACLExplainRequest
+	(*ACLExplainResponse)(nil),                          // 36: kstash.v1.ACLExplainResponse
+	(*AuthTokenLookupRequest)(nil),                      // 37: kstash.v1.AuthTokenLookupRequest
+	(*AuthTokenLookupResponse)(nil),                     // 38: kstash.v1.AuthTokenLookupResponse
+	(*AuthTokenRenewRequest)(nil),                       // 39: kstash.v1.AuthTokenRenewRequest
+	(*AuthTokenRenewResponse)(nil),                      // 40: kstash.v1.AuthTokenRenewResponse
+	(*AuthTokenRevokeRequest)(nil),                      // 41: kstash.v1.AuthTokenRevokeRequest
+	(*AuthTokenRevokeResponse)(nil),                     // 42: kstash.v1.AuthTokenRevokeResponse
+	(*DatabaseConfigureConnectionRequest)(nil),          // 43: kstash.v1.DatabaseConfigureConnectionRequest
+	(*DatabaseConfigureConnectionResponse)(nil),         // 44: kstash.v1.DatabaseConfigureConnectionResponse
+	(*DatabaseDeleteConnectionRequest)(nil),             // 45: kstash.v1.DatabaseDeleteConnectionRequest
+	(*DatabaseDeleteConnectionResponse)(nil),            // 46: kstash.v1.DatabaseDeleteConnectionResponse
+	(*DatabaseDeleteRoleRequest)(nil),                   // 47: kstash.v1.DatabaseDeleteRoleRequest
+	(*DatabaseDeleteRoleResponse)(nil),                  // 48: kstash.v1.DatabaseDeleteRoleResponse
+	(*DatabaseGenerateCredentialsRequest)(nil),          // 49: kstash.v1.DatabaseGenerateCredentialsRequest
+	(*DatabaseGenerateCredentialsResponse)(nil),         // 50: kstash.v1.DatabaseGenerateCredentialsResponse
+	(*DatabaseGetRoleRequest)(nil),                      // 51: kstash.v1.DatabaseGetRoleRequest
+	(*DatabaseGetRoleResponse)(nil),                     // 52: kstash.v1.DatabaseGetRoleResponse
+	(*DatabasePutRoleRequest)(nil),                      // 53: kstash.v1.DatabasePutRoleRequest
+	(*DatabasePutRoleResponse)(nil),                     // 54: kstash.v1.DatabasePutRoleResponse
+	(*KVListEntry)(nil),                                 // 55: kstash.v1.KVListEntry
+	(*KVListRequest)(nil),                               // 56: kstash.v1.KVListRequest
+	(*KVListResponse)(nil),                              // 57: kstash.v1.KVListResponse
+	(*KVGetRequest)(nil),                                // 58: kstash.v1.KVGetRequest
+	(*KVGetResponse)(nil),                               // 59: kstash.v1.KVGetResponse
+	(*KVPutRequest)(nil),                                // 60: kstash.v1.KVPutRequest
+	(*KVPutResponse)(nil),                               // 61: kstash.v1.KVPutResponse
+	(*KVDeleteRequest)(nil),                             // 62: kstash.v1.KVDeleteRequest
+	(*KVDeleteResponse)(nil),                            // 63: kstash.v1.KVDeleteResponse
+	(*KVGetMetadataRequest)(nil),                        // 64: kstash.v1.KVGetMetadataRequest
+	(*KVGetMetadataResponse)(nil),                       // 65: kstash.v1.KVGetMetadataResponse
+	(*KVPutMetadataRequest)(nil),                        // 66: kstash.v1.KVPutMetadataRequest
+	(*KVPutMetadataResponse)(nil),                       // 67: kstash.v1.KVPutMetadataResponse
+	(*KVSearchRequest)(nil),                             // 68: kstash.v1.KVSearchRequest
+	(*KVSearchResponse)(nil),                            // 69: kstash.v1.KVSearchResponse
+	(*LeaseRenewRequest)(nil),                           // 70: kstash.v1.LeaseRenewRequest
+	(*LeaseRenewResponse)(nil),                          // 71: kstash.v1.LeaseRenewResponse
+	(*LeaseRevokeRequest)(nil),                          // 72: kstash.v1.LeaseRevokeRequest
+	(*LeaseRevokeResponse)(nil),                         // 73: kstash.v1.LeaseRevokeResponse
+	(*LeaseRevokePrefixRequest)(nil),                    // 74: kstash.v1.LeaseRevokePrefixRequest
+	(*LeaseRevokePrefixResponse)(nil),                   // 75: kstash.v1.LeaseRevokePrefixResponse
+	(*NamespaceConfigureKeyRequest)(nil),                // 76: kstash.v1.NamespaceConfigureKeyRequest
+	(*NamespaceConfigureKeyResponse)(nil),               // 77: kstash.v1.NamespaceConfigureKeyResponse
+	(*NamespaceLockRequest)(nil),                        // 78: kstash.v1.NamespaceLockRequest
+	(*NamespaceLockResponse)(nil),                       // 79: kstash.v1.NamespaceLockResponse
+	(*NamespaceStatusRequest)(nil),                      // 80: kstash.v1.NamespaceStatusRequest
+	(*NamespaceStatusResponse)(nil),                     // 81: kstash.v1.NamespaceStatusResponse
+	(*NamespaceUnlockRequest)(nil),                      // 82: kstash.v1.NamespaceUnlockRequest
+	(*NamespaceUnlockResponse)(nil),                     // 83: kstash.v1.NamespaceUnlockResponse
+	(*PKIDeleteRoleRequest)(nil),                        // 84: kstash.v1.PKIDeleteRoleRequest
+	(*PKIDeleteRoleResponse)(nil),                       // 85: kstash.v1.PKIDeleteRoleResponse
+	(*PKIGenerateCARequest)(nil),                        // 86: kstash.v1.PKIGenerateCARequest
+	(*PKIGenerateCAResponse)(nil),                       // 87: kstash.v1.PKIGenerateCAResponse
+	(*PKIGetCARequest)(nil),                             // 88: kstash.v1.PKIGetCARequest
+	(*PKIGetCAResponse)(nil),                            // 89: kstash.v1.PKIGetCAResponse
+	(*PKIGetCRLRequest)(nil),                            // 90: kstash.v1.PKIGetCRLRequest
+	(*PKIGetCRLResponse)(nil),                           // 91: kstash.v1.PKIGetCRLResponse
+	(*PKIGetRoleRequest)(nil),                           // 92: kstash.v1.PKIGetRoleRequest
+	(*PKIGetRoleResponse)(nil),                          // 93: kstash.v1.PKIGetRoleResponse
+	(*PKIImportCARequest)(nil),                          // 94: kstash.v1.PKIImportCARequest
+	(*PKIImportCAResponse)(nil),                         // 95: kstash.v1.PKIImportCAResponse
+	(*PKIIssueRequest)(nil),                             // 96: kstash.v1.PKIIssueRequest
+	(*PKIIssueResponse)(nil),                            // 97: kstash.v1.PKIIssueResponse
+	(*PKIPutRoleRequest)(nil),                           // 98: kstash.v1.PKIPutRoleRequest
+	(*PKIPutRoleResponse)(nil),                          // 99: kstash.v1.PKIPutRoleResponse
+	(*PKIRevokeRequest)(nil),                            // 100: kstash.v1.PKIRevokeRequest
+	(*PKIRevokeResponse)(nil),                           // 101: kstash.v1.PKIRevokeResponse
+	(*PKISignRequest)(nil),                              // 102: kstash.v1.PKISignRequest
+	(*PKISignResponse)(nil),                             // 103: kstash.v1.PKISignResponse
+	(*PolicyDeleteRequest)(nil),                         // 104: kstash.v1.PolicyDeleteRequest
+	(*PolicyDeleteResponse)(nil),                        // 105: kstash.v1.PolicyDeleteResponse
+	(*PolicyGetRequest)(nil),                            // 106: kstash.v1.PolicyGetRequest
+	(*PolicyGetResponse)(nil),                           // 107: kstash.v1.PolicyGetResponse
+	(*PolicyListRequest)(nil),                           // 108: kstash.v1.PolicyListRequest
+	(*PolicyListResponse)(nil),                          // 109: kstash.v1.PolicyListResponse
+	(*PolicyPutRequest)(nil),                            // 110: kstash.v1.PolicyPutRequest
+	(*PolicyPutResponse)(nil),                           // 111: kstash.v1.PolicyPutResponse
+	(*SSHDeleteRoleRequest)(nil),                        // 112: kstash.v1.SSHDeleteRoleRequest
+	(*SSHDeleteRoleResponse)(nil),                       // 113: kstash.v1.SSHDeleteRoleResponse
+	(*SSHGenerateCARequest)(nil),                        // 114: kstash.v1.SSHGenerateCARequest
+	(*SSHGenerateCAResponse)(nil),                       // 115: kstash.v1.SSHGenerateCAResponse
+	(*SSHGetCARequest)(nil),                             // 116: kstash.v1.SSHGetCARequest
+	(*SSHGetCAResponse)(nil),                            // 117: kstash.v1.SSHGetCAResponse
+	(*SSHGetRoleRequest)(nil),                           // 118: kstash.v1.SSHGetRoleRequest
+	(*SSHGetRoleResponse)(nil),                          // 119: kstash.v1.SSHGetRoleResponse
+	(*SSHImportCARequest)(nil),                          // 120: kstash.v1.SSHImportCARequest
+	(*SSHImportCAResponse)(nil),                         // 121: kstash.v1.SSHImportCAResponse
+	(*SSHPutRoleRequest)(nil),                           // 122: kstash.v1.SSHPutRoleRequest
+	(*SSHPutRoleResponse)(nil),                          // 123: kstash.v1.SSHPutRoleResponse
+	(*SignSSHKeyRequest)(nil),                           // 124: kstash.v1.SignSSHKeyRequest
+	(*SignSSHKeyResponse)(nil),                          // 125: kstash.v1.SignSSHKeyResponse
+	(*SystemGenerateAccessTokenRequest)(nil),            // 126: kstash.v1.SystemGenerateAccessTokenRequest
+	(*SystemGenerateAccessTokenResponse)(nil),           // 127: kstash.v1.SystemGenerateAccessTokenResponse
+	(*SystemGenerateGatekeeperTokenRequest)(nil),        // 128: kstash.v1.SystemGenerateGatekeeperTokenRequest
+	(*SystemGenerateGatekeeperTokenResponse)(nil),       // 129: kstash.v1.SystemGenerateGatekeeperTokenResponse
+	(*SystemInitializeRequest)(nil),                     // 130: kstash.v1.SystemInitializeRequest
+	(*SystemInitializeResponse)(nil),                    // 131: kstash.v1.SystemInitializeResponse
+	(*SystemListGatekeeperTokensRequest)(nil),           // 132: kstash.v1.SystemListGatekeeperTokensRequest
+	(*SystemListGatekeeperTokensResponse)(nil),          // 133: kstash.v1.SystemListGatekeeperTokensResponse
+	(*SystemPruneTokensRequest)(nil),                    // 134: kstash.v1.SystemPruneTokensRequest
+	(*SystemPruneTokensResponse)(nil),                   // 135: kstash.v1.SystemPruneTokensResponse
+	(*SystemRekeyItemsRequest)(nil),                     // 136: kstash.v1.SystemRekeyItemsRequest
+	(*SystemRekeyItemsResponse)(nil),                    // 137: kstash.v1.SystemRekeyItemsResponse
+	(*SystemRestoreRequest)(nil),                        // 138: kstash.v1.SystemRestoreRequest
+	(*SystemRestoreResponse)(nil),                       // 139: kstash.v1.SystemRestoreResponse
+	(*SystemRotateAccessKeyRequest)(nil),                // 140: kstash.v1.SystemRotateAccessKeyRequest
+	(*SystemRotateAccessKeyResponse)(nil),               // 141: kstash.v1.SystemRotateAccessKeyResponse
+	(*SystemRotateEncryptionKeyRequest)(nil),            // 142: kstash.v1.SystemRotateEncryptionKeyRequest
+	(*SystemRotateEncryptionKeyResponse)(nil),           // 143: kstash.v1.SystemRotateEncryptionKeyResponse
+	(*SystemRotateGatekeeperTokenRequest)(nil),          // 144: kstash.v1.SystemRotateGatekeeperTokenRequest
+	(*SystemRotateGatekeeperTokenResponse)(nil),         // 145: kstash.v1.SystemRotateGatekeeperTokenResponse
+	(*SystemRotateUnsealKeysRequest)(nil),               // 146: kstash.v1.SystemRotateUnsealKeysRequest
+	(*SystemRotateUnsealKeysResponse)(nil),              // 147: kstash.v1.SystemRotateUnsealKeysResponse
+	(*SystemRevokeGatekeeperTokenRequest)(nil),          // 148: kstash.v1.SystemRevokeGatekeeperTokenRequest
+	(*SystemRevokeGatekeeperTokenResponse)(nil),         // 149: kstash.v1.SystemRevokeGatekeeperTokenResponse
+	(*SystemRevokeGatekeeperTokenAccessorRequest)(nil),  // 150: kstash.v1.SystemRevokeGatekeeperTokenAccessorRequest
+	(*SystemRevokeGatekeeperTokenAccessorResponse)(nil), // 151: kstash.v1.SystemRevokeGatekeeperTokenAccessorResponse
+	(*SystemSealRequest)(nil),                           // 152: kstash.v1.SystemSealRequest
+	(*SystemSealResponse)(nil),                          // 153: kstash.v1.SystemSealResponse
+	(*SystemShredNamespaceRequest)(nil),                 // 154: kstash.v1.SystemShredNamespaceRequest
+	(*SystemShredNamespaceResponse)(nil),                // 155: kstash.v1.SystemShredNamespaceResponse
+	(*SystemSnapshotRequest)(nil),                       // 156: kstash.v1.SystemSnapshotRequest
+	(*SystemSnapshotResponse)(nil),                      // 157: kstash.v1.SystemSnapshotResponse
+	(*SystemStatusRequest)(nil),                         // 158: kstash.v1.SystemStatusRequest
+	(*SystemStatusResponse)(nil),                        // 159: kstash.v1.SystemStatusResponse
+	(*SystemUnsealRequest)(nil),                         // 160: kstash.v1.SystemUnsealRequest
+	(*SystemUnsealResponse)(nil),                        // 161: kstash.v1.SystemUnsealResponse
+	(*SystemUpgradeItemsRequest)(nil),                   // 162: kstash.v1.SystemUpgradeItemsRequest
+	(*SystemUpgradeItemsResponse)(nil),                  // 163: kstash.v1.SystemUpgradeItemsResponse
+	(*TransitCreateKeyRequest)(nil),                     // 164: kstash.v1.TransitCreateKeyRequest
+	(*TransitCreateKeyResponse)(nil),                    // 165: kstash.v1.TransitCreateKeyResponse
+	(*TransitDecryptRequest)(nil),                       // 166: kstash.v1.TransitDecryptRequest
+	(*TransitDecryptResponse)(nil),                      // 167: kstash.v1.TransitDecryptResponse
+	(*TransitDeleteKeyRequest)(nil),                     // 168: kstash.v1.TransitDeleteKeyRequest
+	(*TransitDeleteKeyResponse)(nil),                    // 169: kstash.v1.TransitDeleteKeyResponse
+	(*TransitEncryptRequest)(nil),                       // 170: kstash.v1.TransitEncryptRequest
+	(*TransitEncryptResponse)(nil),                      // 171: kstash.v1.TransitEncryptResponse
+	(*TransitGetKeyRequest)(nil),                        // 172: kstash.v1.TransitGetKeyRequest
+	(*TransitGetKeyResponse)(nil),                       // 173: kstash.v1.TransitGetKeyResponse
+	(*TransitHMACRequest)(nil),                          // 174: kstash.v1.TransitHMACRequest
+	(*TransitHMACResponse)(nil),                         // 175: kstash.v1.TransitHMACResponse
+	(*TransitRewrapRequest)(nil),                        // 176: kstash.v1.TransitRewrapRequest
+	(*TransitRewrapResponse)(nil),                       // 177: kstash.v1.TransitRewrapResponse
+	(*TransitRotateKeyRequest)(nil),                     // 178: kstash.v1.TransitRotateKeyRequest
+	(*TransitRotateKeyResponse)(nil),                    // 179: kstash.v1.TransitRotateKeyResponse
+	(*TransitSignRequest)(nil),                          // 180: kstash.v1.TransitSignRequest
+	(*TransitSignResponse)(nil),                         // 181: kstash.v1.TransitSignResponse
+	(*TransitVerifyRequest)(nil),                        // 182: kstash.v1.TransitVerifyRequest
+	(*TransitVerifyResponse)(nil),                       // 183: kstash.v1.TransitVerifyResponse
+	nil,                                                 // 184: kstash.v1.Item.MapEntry
+	nil,                                                 // 185: kstash.v1.ACLConditions.RequiredMetadataEntry
+	nil,                                                 // 186: kstash.v1.AccessToken.MetadataEntry
+	nil,                                                 // 187: kstash.v1.KVMetadata.LabelsEntry
+	nil,                                                 // 188: kstash.v1.Lease.DataEntry
+	nil,                                                 // 189: kstash.v1.SSHRole.ExtensionsEntry
+	nil,                                                 // 190: kstash.v1.SSHRole.CriticalOptionsEntry
+	nil,                                                 // 191: kstash.v1.KVPutMetadataRequest.LabelsEntry
+	nil,                                                 // 192: kstash.v1.SSHPutRoleRequest.ExtensionsEntry
+	nil,                                                 // 193: kstash.v1.SSHPutRoleRequest.CriticalOptionsEntry
+	nil,                                                 // 194: kstash.v1.SystemGenerateAccessTokenRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),                       // 195: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                                   // 196: google.protobuf.Any
+}
+var file_kstash_proto_depIdxs = []int32{
+	0,   // 0: kstash.v1.EncryptionKey.type:type_name -> kstash.v1.CipherType
+	195, // 1: kstash.v1.EncryptionKey.created:type_name -> google.protobuf.Timestamp
+	5,   // 2: kstash.v1.KeychainSnapshot.keys:type_name -> kstash.v1.EncryptionKey
+	195, // 3: kstash.v1.KeychainSnapshot.created:type_name -> google.protobuf.Timestamp
+	8,   // 4: kstash.v1.BackendItem.chunks:type_name -> kstash.v1.ChunkManifest
+	1,   // 5: kstash.v1.BackendItem.compression:type_name -> kstash.v1.Compression
+	184, // 6: kstash.v1.Item.map:type_name -> kstash.v1.Item.MapEntry
+	2,   // 7: kstash.v1.ACL.permissions:type_name -> kstash.v1.Permission
+	12,  // 8: kstash.v1.ACL.conditions:type_name -> kstash.v1.ACLConditions
+	13,  // 9: kstash.v1.ACLConditions.timeWindows:type_name -> kstash.v1.TimeWindow
+	185, // 10: kstash.v1.ACLConditions.requiredMetadata:type_name -> kstash.v1.ACLConditions.RequiredMetadataEntry
+	11,  // 11: kstash.v1.ACLRule.acl:type_name -> kstash.v1.ACL
+	14,  // 12: kstash.v1.ACLExplanation.matchedRule:type_name -> kstash.v1.ACLRule
+	14,  // 13: kstash.v1.ACLExplanation.rules:type_name -> kstash.v1.ACLRule
+	14,  // 14: kstash.v1.ACLExplanation.deny:type_name -> kstash.v1.ACLRule
+	2,   // 15: kstash.v1.ACLExplanation.effectivePermissions:type_name -> kstash.v1.Permission
+	186, // 16: kstash.v1.AccessToken.metadata:type_name -> kstash.v1.AccessToken.MetadataEntry
+	11,  // 17: kstash.v1.AccessToken.acls:type_name -> kstash.v1.ACL
+	11,  // 18: kstash.v1.Policy.acls:type_name -> kstash.v1.ACL
+	195, // 19: kstash.v1.SnapshotHeader.created:type_name -> google.protobuf.Timestamp
+	187, // 20: kstash.v1.KVMetadata.labels:type_name -> kstash.v1.KVMetadata.LabelsEntry
+	188, // 21: kstash.v1.Lease.data:type_name -> kstash.v1.Lease.DataEntry
+	3,   // 22: kstash.v1.PKIRole.keyType:type_name -> kstash.v1.PKIKeyType
+	4,   // 23: kstash.v1.SSHRole.certType:type_name -> kstash.v1.SSHCertificateType
+	189, // 24: kstash.v1.SSHRole.extensions:type_name -> kstash.v1.SSHRole.ExtensionsEntry
+	190, // 25: kstash.v1.SSHRole.criticalOptions:type_name -> kstash.v1.SSHRole.CriticalOptionsEntry
+	0,   // 26: kstash.v1.TransitKey.type:type_name -> kstash.v1.CipherType
+	32,  // 27: kstash.v1.TransitKey.versions:type_name -> kstash.v1.TransitKeyVersion
+	0,   // 28: kstash.v1.TransitKeyInfo.type:type_name -> kstash.v1.CipherType
+	34,  // 29: kstash.v1.TransitKeyInfo.publicKeys:type_name -> kstash.v1.TransitPublicKey
+	2,   // 30: kstash.v1.ACLExplainRequest.permission:type_name -> kstash.v1.Permission
+	15,  // 31: kstash.v1.ACLExplainResponse.explanation:type_name -> kstash.v1.ACLExplanation
+	16,  // 32: kstash.v1.AuthTokenLookupResponse.token:type_name -> kstash.v1.AccessToken
+	16,  // 33: kstash.v1.AuthTokenRenewResponse.token:type_name -> kstash.v1.AccessToken
+	24,  // 34: kstash.v1.DatabaseGetRoleResponse.role:type_name -> kstash.v1.DatabaseRole
+	55,  // 35: kstash.v1.KVListResponse.entries:type_name -> kstash.v1.KVListEntry
+	10,  // 36: kstash.v1.KVGetResponse.item:type_name -> kstash.v1.Item
+	10,  // 37: kstash.v1.KVPutRequest.item:type_name -> kstash.v1.Item
+	21,  // 38: kstash.v1.KVGetMetadataResponse.metadata:type_name -> kstash.v1.KVMetadata
+	191, // 39: kstash.v1.KVPutMetadataRequest.labels:type_name -> kstash.v1.KVPutMetadataRequest.LabelsEntry
+	21,  // 40: kstash.v1.KVPutMetadataResponse.metadata:type_name -> kstash.v1.KVMetadata
+	21,  // 41: kstash.v1.KVSearchResponse.results:type_name -> kstash.v1.KVMetadata
+	22,  // 42: kstash.v1.LeaseRenewResponse.lease:type_name -> kstash.v1.Lease
+	3,   // 43: kstash.v1.PKIGenerateCARequest.keyType:type_name -> kstash.v1.PKIKeyType
+	26,  // 44: kstash.v1.PKIGetRoleResponse.role:type_name -> kstash.v1.PKIRole
+	28,  // 45: kstash.v1.PKIIssueResponse.certificate:type_name -> kstash.v1.PKIIssuedCertificate
+	3,   // 46: kstash.v1.PKIPutRoleRequest.keyType:type_name -> kstash.v1.PKIKeyType
+	28,  // 47: kstash.v1.PKISignResponse.certificate:type_name -> kstash.v1.PKIIssuedCertificate
+	17,  // 48: kstash.v1.PolicyGetResponse.policy:type_name -> kstash.v1.Policy
+	17,  // 49: kstash.v1.PolicyPutRequest.policy:type_name -> kstash.v1.Policy
+	30,  // 50: kstash.v1.SSHGetRoleResponse.role:type_name -> kstash.v1.SSHRole
+	4,   // 51: kstash.v1.SSHPutRoleRequest.certType:type_name -> kstash.v1.SSHCertificateType
+	192, // 52: kstash.v1.SSHPutRoleRequest.extensions:type_name -> kstash.v1.SSHPutRoleRequest.ExtensionsEntry
+	193, // 53: kstash.v1.SSHPutRoleRequest.criticalOptions:type_name -> kstash.v1.SSHPutRoleRequest.CriticalOptionsEntry
+	194, // 54: kstash.v1.SystemGenerateAccessTokenRequest.metadata:type_name -> kstash.v1.SystemGenerateAccessTokenRequest.MetadataEntry
+	11,  // 55: kstash.v1.SystemGenerateAccessTokenRequest.acls:type_name -> kstash.v1.ACL
+	16,  // 56: kstash.v1.SystemGenerateAccessTokenResponse.token:type_name -> kstash.v1.AccessToken
+	18,  // 57: kstash.v1.SystemListGatekeeperTokensResponse.tokens:type_name -> kstash.v1.GatekeeperToken
+	195, // 58: kstash.v1.SystemStatusResponse.serverTimestamp:type_name -> google.protobuf.Timestamp
+	19,  // 59: kstash.v1.SystemStatusResponse.leader:type_name -> kstash.v1.LeaderInfo
+	0,   // 60: kstash.v1.TransitCreateKeyRequest.type:type_name -> kstash.v1.CipherType
+	33,  // 61: kstash.v1.TransitGetKeyResponse.key:type_name -> kstash.v1.TransitKeyInfo
+	196, // 62: kstash.v1.Item.MapEntry.value:type_name -> google.protobuf.Any
+	35,  // 63: kstash.v1.KStash.ACLExplain:input_type -> kstash.v1.ACLExplainRequest
+	37,  // 64: kstash.v1.KStash.AuthTokenLookup:input_type -> kstash.v1.AuthTokenLookupRequest
+	39,  // 65: kstash.v1.KStash.AuthTokenRenew:input_type -> kstash.v1.AuthTokenRenewRequest
+	41,  // 66: kstash.v1.KStash.AuthTokenRevoke:input_type -> kstash.v1.AuthTokenRevokeRequest
+	43,  // 67: kstash.v1.KStash.DatabaseConfigureConnection:input_type -> kstash.v1.DatabaseConfigureConnectionRequest
+	45,  // 68: kstash.v1.KStash.DatabaseDeleteConnection:input_type -> kstash.v1.DatabaseDeleteConnectionRequest
+	47,  // 69: kstash.v1.KStash.DatabaseDeleteRole:input_type -> kstash.v1.DatabaseDeleteRoleRequest
+	49,  // 70: kstash.v1.KStash.DatabaseGenerateCredentials:input_type -> kstash.v1.DatabaseGenerateCredentialsRequest
+	51,  // 71: kstash.v1.KStash.DatabaseGetRole:input_type -> kstash.v1.DatabaseGetRoleRequest
+	53,  // 72: kstash.v1.KStash.DatabasePutRole:input_type -> kstash.v1.DatabasePutRoleRequest
+	56,  // 73: kstash.v1.KStash.KVList:input_type -> kstash.v1.KVListRequest
+	58,  // 74: kstash.v1.KStash.KVGet:input_type -> kstash.v1.KVGetRequest
+	60,  // 75: kstash.v1.KStash.KVPut:input_type -> kstash.v1.KVPutRequest
+	62,  // 76: kstash.v1.KStash.KVDelete:input_type -> kstash.v1.KVDeleteRequest
+	64,  // 77: kstash.v1.KStash.KVGetMetadata:input_type -> kstash.v1.KVGetMetadataRequest
+	66,  // 78: kstash.v1.KStash.KVPutMetadata:input_type -> kstash.v1.KVPutMetadataRequest
+	68,  // 79: kstash.v1.KStash.KVSearch:input_type -> kstash.v1.KVSearchRequest
+	70,  // 80: kstash.v1.KStash.LeaseRenew:input_type -> kstash.v1.LeaseRenewRequest
+	72,  // 81: kstash.v1.KStash.LeaseRevoke:input_type -> kstash.v1.LeaseRevokeRequest
+	74,  // 82: kstash.v1.KStash.LeaseRevokePrefix:input_type -> kstash.v1.LeaseRevokePrefixRequest
+	76,  // 83: kstash.v1.KStash.NamespaceConfigureKey:input_type -> kstash.v1.NamespaceConfigureKeyRequest
+	78,  // 84: kstash.v1.KStash.NamespaceLock:input_type -> kstash.v1.NamespaceLockRequest
+	80,  // 85: kstash.v1.KStash.NamespaceStatus:input_type -> kstash.v1.NamespaceStatusRequest
+	82,  // 86: kstash.v1.KStash.NamespaceUnlock:input_type -> kstash.v1.NamespaceUnlockRequest
+	84,  // 87: kstash.v1.KStash.PKIDeleteRole:input_type -> kstash.v1.PKIDeleteRoleRequest
+	86,  // 88: kstash.v1.KStash.PKIGenerateCA:input_type -> kstash.v1.PKIGenerateCARequest
+	88,  // 89: kstash.v1.KStash.PKIGetCA:input_type -> kstash.v1.PKIGetCARequest
+	90,  // 90: kstash.v1.KStash.PKIGetCRL:input_type -> kstash.v1.PKIGetCRLRequest
+	92,  // 91: kstash.v1.KStash.PKIGetRole:input_type -> kstash.v1.PKIGetRoleRequest
+	94,  // 92: kstash.v1.KStash.PKIImportCA:input_type -> kstash.v1.PKIImportCARequest
+	96,  // 93: kstash.v1.KStash.PKIIssue:input_type -> kstash.v1.PKIIssueRequest
+	98,  // 94: kstash.v1.KStash.PKIPutRole:input_type -> kstash.v1.PKIPutRoleRequest
+	100, // 95: kstash.v1.KStash.PKIRevoke:input_type -> kstash.v1.PKIRevokeRequest
+	102, // 96: kstash.v1.KStash.PKISign:input_type -> kstash.v1.PKISignRequest
+	104, // 97: kstash.v1.KStash.PolicyDelete:input_type -> kstash.v1.PolicyDeleteRequest
+	106, // 98: kstash.v1.KStash.PolicyGet:input_type -> kstash.v1.PolicyGetRequest
+	108, // 99: kstash.v1.KStash.PolicyList:input_type -> kstash.v1.PolicyListRequest
+	110, // 100: kstash.v1.KStash.PolicyPut:input_type -> kstash.v1.PolicyPutRequest
+	112, // 101: kstash.v1.KStash.SSHDeleteRole:input_type -> kstash.v1.SSHDeleteRoleRequest
+	114, // 102: kstash.v1.KStash.SSHGenerateCA:input_type -> kstash.v1.SSHGenerateCARequest
+	116, // 103: kstash.v1.KStash.SSHGetCA:input_type -> kstash.v1.SSHGetCARequest
+	118, // 104: kstash.v1.KStash.SSHGetRole:input_type -> kstash.v1.SSHGetRoleRequest
+	120, // 105: kstash.v1.KStash.SSHImportCA:input_type -> kstash.v1.SSHImportCARequest
+	122, // 106: kstash.v1.KStash.SSHPutRole:input_type -> kstash.v1.SSHPutRoleRequest
+	124, // 107: kstash.v1.KStash.SignSSHKey:input_type -> kstash.v1.SignSSHKeyRequest
+	126, // 108: kstash.v1.KStash.SystemGenerateAccessToken:input_type -> kstash.v1.SystemGenerateAccessTokenRequest
+	128, // 109: kstash.v1.KStash.SystemGenerateGatekeeperToken:input_type -> kstash.v1.SystemGenerateGatekeeperTokenRequest
+	130, // 110: kstash.v1.KStash.SystemInitialize:input_type -> kstash.v1.SystemInitializeRequest
+	132, // 111: kstash.v1.KStash.SystemListGatekeeperTokens:input_type -> kstash.v1.SystemListGatekeeperTokensRequest
+	134, // 112: kstash.v1.KStash.SystemPruneTokens:input_type -> kstash.v1.SystemPruneTokensRequest
+	136, // 113: kstash.v1.KStash.SystemRekeyItems:input_type -> kstash.v1.SystemRekeyItemsRequest
+	138, // 114: kstash.v1.KStash.SystemRestore:input_type -> kstash.v1.SystemRestoreRequest
+	140, // 115: kstash.v1.KStash.SystemRotateAccessKey:input_type -> kstash.v1.SystemRotateAccessKeyRequest
+	142, // 116: kstash.v1.KStash.SystemRotateEncryptionKey:input_type -> kstash.v1.SystemRotateEncryptionKeyRequest
+	144, // 117: kstash.v1.KStash.SystemRotateGatekeeperToken:input_type -> kstash.v1.SystemRotateGatekeeperTokenRequest
+	146, // 118: kstash.v1.KStash.SystemRotateUnsealKeys:input_type -> kstash.v1.SystemRotateUnsealKeysRequest
+	148, // 119: kstash.v1.KStash.SystemRevokeGatekeeperToken:input_type -> kstash.v1.SystemRevokeGatekeeperTokenRequest
+	150, // 120: kstash.v1.KStash.SystemRevokeGatekeeperTokenAccessor:input_type -> kstash.v1.SystemRevokeGatekeeperTokenAccessorRequest
+	152, // 121: kstash.v1.KStash.SystemSeal:input_type -> kstash.v1.SystemSealRequest
+	154, // 122: kstash.v1.KStash.SystemShredNamespace:input_type -> kstash.v1.SystemShredNamespaceRequest
+	156, // 123: kstash.v1.KStash.SystemSnapshot:input_type -> kstash.v1.SystemSnapshotRequest
+	158, // 124: kstash.v1.KStash.SystemStatus:input_type -> kstash.v1.SystemStatusRequest
+	160, // 125: kstash.v1.KStash.SystemUnseal:input_type -> kstash.v1.SystemUnsealRequest
+	162, // 126: kstash.v1.KStash.SystemUpgradeItems:input_type -> kstash.v1.SystemUpgradeItemsRequest
+	164, // 127: kstash.v1.KStash.TransitCreateKey:input_type -> kstash.v1.TransitCreateKeyRequest
+	166, // 128: kstash.v1.KStash.TransitDecrypt:input_type -> kstash.v1.TransitDecryptRequest
+	168, // 129: kstash.v1.KStash.TransitDeleteKey:input_type -> kstash.v1.TransitDeleteKeyRequest
+	170, // 130: kstash.v1.KStash.TransitEncrypt:input_type -> kstash.v1.TransitEncryptRequest
+	172, // 131: kstash.v1.KStash.TransitGetKey:input_type -> kstash.v1.TransitGetKeyRequest
+	174, // 132: kstash.v1.KStash.TransitHMAC:input_type -> kstash.v1.TransitHMACRequest
+	176, // 133: kstash.v1.KStash.TransitRewrap:input_type -> kstash.v1.TransitRewrapRequest
+	178, // 134: kstash.v1.KStash.TransitRotateKey:input_type -> kstash.v1.TransitRotateKeyRequest
+	180, // 135: kstash.v1.KStash.TransitSign:input_type -> kstash.v1.TransitSignRequest
+	182, // 136: kstash.v1.KStash.TransitVerify:input_type -> kstash.v1.TransitVerifyRequest
+	36,  // 137: kstash.v1.KStash.ACLExplain:output_type -> kstash.v1.ACLExplainResponse
+	38,  // 138: kstash.v1.KStash.AuthTokenLookup:output_type -> kstash.v1.AuthTokenLookupResponse
+	40,  // 139: kstash.v1.KStash.AuthTokenRenew:output_type -> kstash.v1.AuthTokenRenewResponse
+	42,  // 140: kstash.v1.KStash.AuthTokenRevoke:output_type -> kstash.v1.AuthTokenRevokeResponse
+	44,  // 141: kstash.v1.KStash.DatabaseConfigureConnection:output_type -> kstash.v1.DatabaseConfigureConnectionResponse
+	46,  // 142: kstash.v1.KStash.DatabaseDeleteConnection:output_type -> kstash.v1.DatabaseDeleteConnectionResponse
+	48,  // 143: kstash.v1.KStash.DatabaseDeleteRole:output_type -> kstash.v1.DatabaseDeleteRoleResponse
+	50,  // 144: kstash.v1.KStash.DatabaseGenerateCredentials:output_type -> kstash.v1.DatabaseGenerateCredentialsResponse
+	52,  // 145: kstash.v1.KStash.DatabaseGetRole:output_type -> kstash.v1.DatabaseGetRoleResponse
+	54,  // 146: kstash.v1.KStash.DatabasePutRole:output_type -> kstash.v1.DatabasePutRoleResponse
+	57,  // 147: kstash.v1.KStash.KVList:output_type -> kstash.v1.KVListResponse
+	59,  // 148: kstash.v1.KStash.KVGet:output_type -> kstash.v1.KVGetResponse
+	61,  // 149: kstash.v1.KStash.KVPut:output_type -> kstash.v1.KVPutResponse
+	63,  // 150: kstash.v1.KStash.KVDelete:output_type -> kstash.v1.KVDeleteResponse
+	65,  // 151: kstash.v1.KStash.KVGetMetadata:output_type -> kstash.v1.KVGetMetadataResponse
+	67,  // 152: kstash.v1.KStash.KVPutMetadata:output_type -> kstash.v1.KVPutMetadataResponse
+	69,  // 153: kstash.v1.KStash.KVSearch:output_type -> kstash.v1.KVSearchResponse
+	71,  // 154: kstash.v1.KStash.LeaseRenew:output_type -> kstash.v1.LeaseRenewResponse
+	73,  // 155: kstash.v1.KStash.LeaseRevoke:output_type -> kstash.v1.LeaseRevokeResponse
+	75,  // 156: kstash.v1.KStash.LeaseRevokePrefix:output_type -> kstash.v1.LeaseRevokePrefixResponse
+	77,  // 157: kstash.v1.KStash.NamespaceConfigureKey:output_type -> kstash.v1.NamespaceConfigureKeyResponse
+	79,  // 158: kstash.v1.KStash.NamespaceLock:output_type -> kstash.v1.NamespaceLockResponse
+	81,  // 159: kstash.v1.KStash.NamespaceStatus:output_type -> kstash.v1.NamespaceStatusResponse
+	83,  // 160: kstash.v1.KStash.NamespaceUnlock:output_type -> kstash.v1.NamespaceUnlockResponse
+	85,  // 161: kstash.v1.KStash.PKIDeleteRole:output_type -> kstash.v1.PKIDeleteRoleResponse
+	87,  // 162: kstash.v1.KStash.PKIGenerateCA:output_type -> kstash.v1.PKIGenerateCAResponse
+	89,  // 163: kstash.v1.KStash.PKIGetCA:output_type -> kstash.v1.PKIGetCAResponse
+	91,  // 164: kstash.v1.KStash.PKIGetCRL:output_type -> kstash.v1.PKIGetCRLResponse
+	93,  // 165: kstash.v1.KStash.PKIGetRole:output_type -> kstash.v1.PKIGetRoleResponse
+	95,  // 166: kstash.v1.KStash.PKIImportCA:output_type -> kstash.v1.PKIImportCAResponse
+	97,  // 167: kstash.v1.KStash.PKIIssue:output_type -> kstash.v1.PKIIssueResponse
+	99,  // 168: kstash.v1.KStash.PKIPutRole:output_type -> kstash.v1.PKIPutRoleResponse
+	101, // 169: kstash.v1.KStash.PKIRevoke:output_type -> kstash.v1.PKIRevokeResponse
+	103, // 170: kstash.v1.KStash.PKISign:output_type -> kstash.v1.PKISignResponse
+	105, // 171: kstash.v1.KStash.PolicyDelete:output_type -> kstash.v1.PolicyDeleteResponse
+	107, // 172: kstash.v1.KStash.PolicyGet:output_type -> kstash.v1.PolicyGetResponse
+	109, // 173: kstash.v1.KStash.PolicyList:output_type -> kstash.v1.PolicyListResponse
+	111, // 174: kstash.v1.KStash.PolicyPut:output_type -> kstash.v1.PolicyPutResponse
+	113, // 175: kstash.v1.KStash.SSHDeleteRole:output_type -> kstash.v1.SSHDeleteRoleResponse
+	115, // 176: kstash.v1.KStash.SSHGenerateCA:output_type -> kstash.v1.SSHGenerateCAResponse
+	117, // 177: kstash.v1.KStash.SSHGetCA:output_type -> kstash.v1.SSHGetCAResponse
+	119, // 178: kstash.v1.KStash.SSHGetRole:output_type -> kstash.v1.SSHGetRoleResponse
+	121, // 179: kstash.v1.KStash.SSHImportCA:output_type -> kstash.v1.SSHImportCAResponse
+	123, // 180: kstash.v1.KStash.SSHPutRole:output_type -> kstash.v1.SSHPutRoleResponse
+	125, // 181: kstash.v1.KStash.SignSSHKey:output_type -> kstash.v1.SignSSHKeyResponse
+	127, // 182: kstash.v1.KStash.SystemGenerateAccessToken:output_type -> kstash.v1.SystemGenerateAccessTokenResponse
+	129, // 183: kstash.v1.KStash.SystemGenerateGatekeeperToken:output_type -> kstash.v1.SystemGenerateGatekeeperTokenResponse
+	131, // 184: kstash.v1.KStash.SystemInitialize:output_type -> kstash.v1.SystemInitializeResponse
+	133, // 185: kstash.v1.KStash.SystemListGatekeeperTokens:output_type -> kstash.v1.SystemListGatekeeperTokensResponse
+	135, // 186: kstash.v1.KStash.SystemPruneTokens:output_type -> kstash.v1.SystemPruneTokensResponse
+	137, // 187: kstash.v1.KStash.SystemRekeyItems:output_type -> kstash.v1.SystemRekeyItemsResponse
+	139, // 188: kstash.v1.KStash.SystemRestore:output_type -> kstash.v1.SystemRestoreResponse
+	141, // 189: kstash.v1.KStash.SystemRotateAccessKey:output_type -> kstash.v1.SystemRotateAccessKeyResponse
+	143, // 190: kstash.v1.KStash.SystemRotateEncryptionKey:output_type -> kstash.v1.SystemRotateEncryptionKeyResponse
+	145, // 191: kstash.v1.KStash.SystemRotateGatekeeperToken:output_type -> kstash.v1.SystemRotateGatekeeperTokenResponse
+	147, // 192: kstash.v1.KStash.SystemRotateUnsealKeys:output_type -> kstash.v1.SystemRotateUnsealKeysResponse
+	149, // 193: kstash.v1.KStash.SystemRevokeGatekeeperToken:output_type -> kstash.v1.SystemRevokeGatekeeperTokenResponse
+	151, // 194: kstash.v1.KStash.SystemRevokeGatekeeperTokenAccessor:output_type -> kstash.v1.SystemRevokeGatekeeperTokenAccessorResponse
+	153, // 195: kstash.v1.KStash.SystemSeal:output_type -> kstash.v1.SystemSealResponse
+	155, // 196: kstash.v1.KStash.SystemShredNamespace:output_type -> kstash.v1.SystemShredNamespaceResponse
+	157, // 197: kstash.v1.KStash.SystemSnapshot:output_type -> kstash.v1.SystemSnapshotResponse
+	159, // 198: kstash.v1.KStash.SystemStatus:output_type -> kstash.v1.SystemStatusResponse
+	161, // 199: kstash.v1.KStash.SystemUnseal:output_type -> kstash.v1.SystemUnsealResponse
+	163, // 200: kstash.v1.KStash.SystemUpgradeItems:output_type -> kstash.v1.SystemUpgradeItemsResponse
+	165, // 201: kstash.v1.KStash.TransitCreateKey:output_type -> kstash.v1.TransitCreateKeyResponse
+	167, // 202: kstash.v1.KStash.TransitDecrypt:output_type -> kstash.v1.TransitDecryptResponse
+	169, // 203: kstash.v1.KStash.TransitDeleteKey:output_type -> kstash.v1.TransitDeleteKeyResponse
+	171, // 204: kstash.v1.KStash.TransitEncrypt:output_type -> kstash.v1.TransitEncryptResponse
+	173, // 205: kstash.v1.KStash.TransitGetKey:output_type -> kstash.v1.TransitGetKeyResponse
+	175, // 206: kstash.v1.KStash.TransitHMAC:output_type -> kstash.v1.TransitHMACResponse
+	177, // 207: kstash.v1.KStash.TransitRewrap:output_type -> kstash.v1.TransitRewrapResponse
+	179, // 208: kstash.v1.KStash.TransitRotateKey:output_type -> kstash.v1.TransitRotateKeyResponse
+	181, // 209: kstash.v1.KStash.TransitSign:output_type -> kstash.v1.TransitSignResponse
+	183, // 210: kstash.v1.KStash.TransitVerify:output_type -> kstash.v1.TransitVerifyResponse
+	137, // [137:211] is the sub-list for method output_type
+	63,  // [63:137] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
+}
+
+func init() { file_kstash_proto_init() }
+func file_kstash_proto_init() {
+	if File_kstash_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kstash_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptionKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeychainSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatekeeperToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKICA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKICertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIIssuedCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHCA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitKeyVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitPublicKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLExplainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLExplainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenLookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenLookupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRenewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRenewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseConfigureConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseConfigureConnectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseDeleteConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseDeleteConnectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseDeleteRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseDeleteRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseGenerateCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseGenerateCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseGetRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseGetRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabasePutRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabasePutRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVListEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVPutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVPutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVGetMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVGetMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVPutMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVPutMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRenewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRenewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRevokePrefixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseRevokePrefixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceConfigureKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceConfigureKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceLockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceUnlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceUnlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIDeleteRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIDeleteRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGenerateCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGenerateCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGetCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGetCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGetCRLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGetCRLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGetRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIGetRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIImportCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIImportCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIIssueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIIssueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIPutRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIPutRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIRevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKIRevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKISignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKISignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyPutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyPutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHDeleteRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHDeleteRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHGenerateCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHGenerateCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHGetCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHGetCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHGetRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHGetRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHImportCARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHImportCAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHPutRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHPutRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignSSHKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignSSHKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGenerateAccessTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGenerateAccessTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGenerateGatekeeperTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGenerateGatekeeperTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemInitializeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemInitializeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListGatekeeperTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListGatekeeperTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemPruneTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemPruneTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRekeyItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRekeyItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRestoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kstash_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateAccessKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3015,8 +14239,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeychainSnapshot); i {
+		file_kstash_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateAccessKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3027,8 +14251,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendItem); i {
+		file_kstash_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateEncryptionKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3039,8 +14263,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Item); i {
+		file_kstash_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateEncryptionKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3051,8 +14275,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ACL); i {
+		file_kstash_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateGatekeeperTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3063,8 +14287,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessToken); i {
+		file_kstash_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateGatekeeperTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3075,8 +14299,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthTokenLookupRequest); i {
+		file_kstash_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateUnsealKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3087,8 +14311,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthTokenLookupResponse); i {
+		file_kstash_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRotateUnsealKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3099,8 +14323,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthTokenRenewRequest); i {
+		file_kstash_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRevokeGatekeeperTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3111,8 +14335,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthTokenRenewResponse); i {
+		file_kstash_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRevokeGatekeeperTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3123,8 +14347,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthTokenRevokeRequest); i {
+		file_kstash_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRevokeGatekeeperTokenAccessorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3135,8 +14359,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthTokenRevokeResponse); i {
+		file_kstash_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRevokeGatekeeperTokenAccessorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3147,8 +14371,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVListRequest); i {
+		file_kstash_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSealRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3159,8 +14383,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVListResponse); i {
+		file_kstash_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSealResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3171,8 +14395,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVGetRequest); i {
+		file_kstash_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemShredNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3183,8 +14407,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVGetResponse); i {
+		file_kstash_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemShredNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3195,8 +14419,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVPutRequest); i {
+		file_kstash_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3207,8 +14431,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVPutResponse); i {
+		file_kstash_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3219,8 +14443,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVDeleteRequest); i {
+		file_kstash_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3231,8 +14455,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVDeleteResponse); i {
+		file_kstash_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3243,8 +14467,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGenerateAccessTokenRequest); i {
+		file_kstash_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemUnsealRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3255,8 +14479,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGenerateAccessTokenResponse); i {
+		file_kstash_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemUnsealResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3267,8 +14491,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGenerateGatekeeperTokenRequest); i {
+		file_kstash_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemUpgradeItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3279,8 +14503,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGenerateGatekeeperTokenResponse); i {
+		file_kstash_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemUpgradeItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3291,8 +14515,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemInitializeRequest); i {
+		file_kstash_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitCreateKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3303,8 +14527,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemInitializeResponse); i {
+		file_kstash_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitCreateKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3315,8 +14539,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemPruneTokensRequest); i {
+		file_kstash_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitDecryptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3327,8 +14551,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemPruneTokensResponse); i {
+		file_kstash_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitDecryptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3339,8 +14563,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateAccessKeyRequest); i {
+		file_kstash_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitDeleteKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3351,8 +14575,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateAccessKeyResponse); i {
+		file_kstash_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitDeleteKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3363,8 +14587,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateEncryptionKeyRequest); i {
+		file_kstash_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitEncryptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3375,8 +14599,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateEncryptionKeyResponse); i {
+		file_kstash_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitEncryptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3387,8 +14611,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateGatekeeperTokenRequest); i {
+		file_kstash_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitGetKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3399,8 +14623,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateGatekeeperTokenResponse); i {
+		file_kstash_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitGetKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3411,8 +14635,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateUnsealKeysRequest); i {
+		file_kstash_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitHMACRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3423,8 +14647,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRotateUnsealKeysResponse); i {
+		file_kstash_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitHMACResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3435,8 +14659,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRevokeGatekeeperTokenRequest); i {
+		file_kstash_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitRewrapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3447,8 +14671,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemRevokeGatekeeperTokenResponse); i {
+		file_kstash_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitRewrapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3459,8 +14683,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemSealRequest); i {
+		file_kstash_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitRotateKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3471,8 +14695,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemSealResponse); i {
+		file_kstash_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitRotateKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3483,8 +14707,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStatusRequest); i {
+		file_kstash_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3495,8 +14719,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStatusResponse); i {
+		file_kstash_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitSignResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3507,8 +14731,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemUnsealRequest); i {
+		file_kstash_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitVerifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3519,8 +14743,8 @@ func file_kstash_proto_init() {
 				return nil
 			}
 		}
-		file_kstash_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemUnsealResponse); i {
+		file_kstash_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitVerifyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,8 +14761,8 @@ func file_kstash_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kstash_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   47,
+			NumEnums:      5,
+			NumMessages:   190,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -18,25 +18,80 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type KStashClient interface {
+	ACLExplain(ctx context.Context, in *ACLExplainRequest, opts ...grpc.CallOption) (*ACLExplainResponse, error)
 	AuthTokenLookup(ctx context.Context, in *AuthTokenLookupRequest, opts ...grpc.CallOption) (*AuthTokenLookupResponse, error)
 	AuthTokenRenew(ctx context.Context, in *AuthTokenRenewRequest, opts ...grpc.CallOption) (*AuthTokenRenewResponse, error)
 	AuthTokenRevoke(ctx context.Context, in *AuthTokenRevokeRequest, opts ...grpc.CallOption) (*AuthTokenRevokeResponse, error)
+	DatabaseConfigureConnection(ctx context.Context, in *DatabaseConfigureConnectionRequest, opts ...grpc.CallOption) (*DatabaseConfigureConnectionResponse, error)
+	DatabaseDeleteConnection(ctx context.Context, in *DatabaseDeleteConnectionRequest, opts ...grpc.CallOption) (*DatabaseDeleteConnectionResponse, error)
+	DatabaseDeleteRole(ctx context.Context, in *DatabaseDeleteRoleRequest, opts ...grpc.CallOption) (*DatabaseDeleteRoleResponse, error)
+	DatabaseGenerateCredentials(ctx context.Context, in *DatabaseGenerateCredentialsRequest, opts ...grpc.CallOption) (*DatabaseGenerateCredentialsResponse, error)
+	DatabaseGetRole(ctx context.Context, in *DatabaseGetRoleRequest, opts ...grpc.CallOption) (*DatabaseGetRoleResponse, error)
+	DatabasePutRole(ctx context.Context, in *DatabasePutRoleRequest, opts ...grpc.CallOption) (*DatabasePutRoleResponse, error)
 	KVList(ctx context.Context, in *KVListRequest, opts ...grpc.CallOption) (*KVListResponse, error)
 	KVGet(ctx context.Context, in *KVGetRequest, opts ...grpc.CallOption) (*KVGetResponse, error)
 	KVPut(ctx context.Context, in *KVPutRequest, opts ...grpc.CallOption) (*KVPutResponse, error)
 	KVDelete(ctx context.Context, in *KVDeleteRequest, opts ...grpc.CallOption) (*KVDeleteResponse, error)
+	KVGetMetadata(ctx context.Context, in *KVGetMetadataRequest, opts ...grpc.CallOption) (*KVGetMetadataResponse, error)
+	KVPutMetadata(ctx context.Context, in *KVPutMetadataRequest, opts ...grpc.CallOption) (*KVPutMetadataResponse, error)
+	KVSearch(ctx context.Context, in *KVSearchRequest, opts ...grpc.CallOption) (*KVSearchResponse, error)
+	LeaseRenew(ctx context.Context, in *LeaseRenewRequest, opts ...grpc.CallOption) (*LeaseRenewResponse, error)
+	LeaseRevoke(ctx context.Context, in *LeaseRevokeRequest, opts ...grpc.CallOption) (*LeaseRevokeResponse, error)
+	LeaseRevokePrefix(ctx context.Context, in *LeaseRevokePrefixRequest, opts ...grpc.CallOption) (*LeaseRevokePrefixResponse, error)
+	NamespaceConfigureKey(ctx context.Context, in *NamespaceConfigureKeyRequest, opts ...grpc.CallOption) (*NamespaceConfigureKeyResponse, error)
+	NamespaceLock(ctx context.Context, in *NamespaceLockRequest, opts ...grpc.CallOption) (*NamespaceLockResponse, error)
+	NamespaceStatus(ctx context.Context, in *NamespaceStatusRequest, opts ...grpc.CallOption) (*NamespaceStatusResponse, error)
+	NamespaceUnlock(ctx context.Context, in *NamespaceUnlockRequest, opts ...grpc.CallOption) (*NamespaceUnlockResponse, error)
+	PKIDeleteRole(ctx context.Context, in *PKIDeleteRoleRequest, opts ...grpc.CallOption) (*PKIDeleteRoleResponse, error)
+	PKIGenerateCA(ctx context.Context, in *PKIGenerateCARequest, opts ...grpc.CallOption) (*PKIGenerateCAResponse, error)
+	PKIGetCA(ctx context.Context, in *PKIGetCARequest, opts ...grpc.CallOption) (*PKIGetCAResponse, error)
+	PKIGetCRL(ctx context.Context, in *PKIGetCRLRequest, opts ...grpc.CallOption) (*PKIGetCRLResponse, error)
+	PKIGetRole(ctx context.Context, in *PKIGetRoleRequest, opts ...grpc.CallOption) (*PKIGetRoleResponse, error)
+	PKIImportCA(ctx context.Context, in *PKIImportCARequest, opts ...grpc.CallOption) (*PKIImportCAResponse, error)
+	PKIIssue(ctx context.Context, in *PKIIssueRequest, opts ...grpc.CallOption) (*PKIIssueResponse, error)
+	PKIPutRole(ctx context.Context, in *PKIPutRoleRequest, opts ...grpc.CallOption) (*PKIPutRoleResponse, error)
+	PKIRevoke(ctx context.Context, in *PKIRevokeRequest, opts ...grpc.CallOption) (*PKIRevokeResponse, error)
+	PKISign(ctx context.Context, in *PKISignRequest, opts ...grpc.CallOption) (*PKISignResponse, error)
+	PolicyDelete(ctx context.Context, in *PolicyDeleteRequest, opts ...grpc.CallOption) (*PolicyDeleteResponse, error)
+	PolicyGet(ctx context.Context, in *PolicyGetRequest, opts ...grpc.CallOption) (*PolicyGetResponse, error)
+	PolicyList(ctx context.Context, in *PolicyListRequest, opts ...grpc.CallOption) (*PolicyListResponse, error)
+	PolicyPut(ctx context.Context, in *PolicyPutRequest, opts ...grpc.CallOption) (*PolicyPutResponse, error)
+	SSHDeleteRole(ctx context.Context, in *SSHDeleteRoleRequest, opts ...grpc.CallOption) (*SSHDeleteRoleResponse, error)
+	SSHGenerateCA(ctx context.Context, in *SSHGenerateCARequest, opts ...grpc.CallOption) (*SSHGenerateCAResponse, error)
+	SSHGetCA(ctx context.Context, in *SSHGetCARequest, opts ...grpc.CallOption) (*SSHGetCAResponse, error)
+	SSHGetRole(ctx context.Context, in *SSHGetRoleRequest, opts ...grpc.CallOption) (*SSHGetRoleResponse, error)
+	SSHImportCA(ctx context.Context, in *SSHImportCARequest, opts ...grpc.CallOption) (*SSHImportCAResponse, error)
+	SSHPutRole(ctx context.Context, in *SSHPutRoleRequest, opts ...grpc.CallOption) (*SSHPutRoleResponse, error)
+	SignSSHKey(ctx context.Context, in *SignSSHKeyRequest, opts ...grpc.CallOption) (*SignSSHKeyResponse, error)
 	SystemGenerateAccessToken(ctx context.Context, in *SystemGenerateAccessTokenRequest, opts ...grpc.CallOption) (*SystemGenerateAccessTokenResponse, error)
 	SystemGenerateGatekeeperToken(ctx context.Context, in *SystemGenerateGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemGenerateGatekeeperTokenResponse, error)
 	SystemInitialize(ctx context.Context, in *SystemInitializeRequest, opts ...grpc.CallOption) (*SystemInitializeResponse, error)
+	SystemListGatekeeperTokens(ctx context.Context, in *SystemListGatekeeperTokensRequest, opts ...grpc.CallOption) (*SystemListGatekeeperTokensResponse, error)
 	SystemPruneTokens(ctx context.Context, in *SystemPruneTokensRequest, opts ...grpc.CallOption) (*SystemPruneTokensResponse, error)
+	SystemRekeyItems(ctx context.Context, in *SystemRekeyItemsRequest, opts ...grpc.CallOption) (*SystemRekeyItemsResponse, error)
+	SystemRestore(ctx context.Context, opts ...grpc.CallOption) (KStash_SystemRestoreClient, error)
 	SystemRotateAccessKey(ctx context.Context, in *SystemRotateAccessKeyRequest, opts ...grpc.CallOption) (*SystemRotateAccessKeyResponse, error)
 	SystemRotateEncryptionKey(ctx context.Context, in *SystemRotateEncryptionKeyRequest, opts ...grpc.CallOption) (*SystemRotateEncryptionKeyResponse, error)
 	SystemRotateGatekeeperToken(ctx context.Context, in *SystemRotateGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemRotateGatekeeperTokenResponse, error)
 	SystemRotateUnsealKeys(ctx context.Context, in *SystemRotateUnsealKeysRequest, opts ...grpc.CallOption) (*SystemRotateUnsealKeysResponse, error)
 	SystemRevokeGatekeeperToken(ctx context.Context, in *SystemRevokeGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemRevokeGatekeeperTokenResponse, error)
+	SystemRevokeGatekeeperTokenAccessor(ctx context.Context, in *SystemRevokeGatekeeperTokenAccessorRequest, opts ...grpc.CallOption) (*SystemRevokeGatekeeperTokenAccessorResponse, error)
 	SystemSeal(ctx context.Context, in *SystemSealRequest, opts ...grpc.CallOption) (*SystemSealResponse, error)
+	SystemShredNamespace(ctx context.Context, in *SystemShredNamespaceRequest, opts ...grpc.CallOption) (*SystemShredNamespaceResponse, error)
+	SystemSnapshot(ctx context.Context, in *SystemSnapshotRequest, opts ...grpc.CallOption) (KStash_SystemSnapshotClient, error)
 	SystemStatus(ctx context.Context, in *SystemStatusRequest, opts ...grpc.CallOption) (*SystemStatusResponse, error)
 	SystemUnseal(ctx context.Context, in *SystemUnsealRequest, opts ...grpc.CallOption) (*SystemUnsealResponse, error)
+	SystemUpgradeItems(ctx context.Context, in *SystemUpgradeItemsRequest, opts ...grpc.CallOption) (*SystemUpgradeItemsResponse, error)
+	TransitCreateKey(ctx context.Context, in *TransitCreateKeyRequest, opts ...grpc.CallOption) (*TransitCreateKeyResponse, error)
+	TransitDecrypt(ctx context.Context, in *TransitDecryptRequest, opts ...grpc.CallOption) (*TransitDecryptResponse, error)
+	TransitDeleteKey(ctx context.Context, in *TransitDeleteKeyRequest, opts ...grpc.CallOption) (*TransitDeleteKeyResponse, error)
+	TransitEncrypt(ctx context.Context, in *TransitEncryptRequest, opts ...grpc.CallOption) (*TransitEncryptResponse, error)
+	TransitGetKey(ctx context.Context, in *TransitGetKeyRequest, opts ...grpc.CallOption) (*TransitGetKeyResponse, error)
+	TransitHMAC(ctx context.Context, in *TransitHMACRequest, opts ...grpc.CallOption) (*TransitHMACResponse, error)
+	TransitRewrap(ctx context.Context, in *TransitRewrapRequest, opts ...grpc.CallOption) (*TransitRewrapResponse, error)
+	TransitRotateKey(ctx context.Context, in *TransitRotateKeyRequest, opts ...grpc.CallOption) (*TransitRotateKeyResponse, error)
+	TransitSign(ctx context.Context, in *TransitSignRequest, opts ...grpc.CallOption) (*TransitSignResponse, error)
+	TransitVerify(ctx context.Context, in *TransitVerifyRequest, opts ...grpc.CallOption) (*TransitVerifyResponse, error)
 }
 
 type kStashClient struct {
@@ -47,6 +102,15 @@ func NewKStashClient(cc grpc.ClientConnInterface) KStashClient {
 	return &kStashClient{cc}
 }
 
+func (c *kStashClient) ACLExplain(ctx context.Context, in *ACLExplainRequest, opts ...grpc.CallOption) (*ACLExplainResponse, error) {
+	out := new(ACLExplainResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/ACLExplain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kStashClient) AuthTokenLookup(ctx context.Context, in *AuthTokenLookupRequest, opts ...grpc.CallOption) (*AuthTokenLookupResponse, error) {
 	out := new(AuthTokenLookupResponse)
 	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/AuthTokenLookup", in, out, opts...)
@@ -74,6 +138,60 @@ func (c *kStashClient) AuthTokenRevoke(ctx context.Context, in *AuthTokenRevokeR
 	return out, nil
 }
 
+func (c *kStashClient) DatabaseConfigureConnection(ctx context.Context, in *DatabaseConfigureConnectionRequest, opts ...grpc.CallOption) (*DatabaseConfigureConnectionResponse, error) {
+	out := new(DatabaseConfigureConnectionResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/DatabaseConfigureConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) DatabaseDeleteConnection(ctx context.Context, in *DatabaseDeleteConnectionRequest, opts ...grpc.CallOption) (*DatabaseDeleteConnectionResponse, error) {
+	out := new(DatabaseDeleteConnectionResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/DatabaseDeleteConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) DatabaseDeleteRole(ctx context.Context, in *DatabaseDeleteRoleRequest, opts ...grpc.CallOption) (*DatabaseDeleteRoleResponse, error) {
+	out := new(DatabaseDeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/DatabaseDeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) DatabaseGenerateCredentials(ctx context.Context, in *DatabaseGenerateCredentialsRequest, opts ...grpc.CallOption) (*DatabaseGenerateCredentialsResponse, error) {
+	out := new(DatabaseGenerateCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/DatabaseGenerateCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) DatabaseGetRole(ctx context.Context, in *DatabaseGetRoleRequest, opts ...grpc.CallOption) (*DatabaseGetRoleResponse, error) {
+	out := new(DatabaseGetRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/DatabaseGetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) DatabasePutRole(ctx context.Context, in *DatabasePutRoleRequest, opts ...grpc.CallOption) (*DatabasePutRoleResponse, error) {
+	out := new(DatabasePutRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/DatabasePutRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kStashClient) KVList(ctx context.Context, in *KVListRequest, opts ...grpc.CallOption) (*KVListResponse, error) {
 	out := new(KVListResponse)
 	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/KVList", in, out, opts...)
@@ -110,552 +228,2253 @@ func (c *kStashClient) KVDelete(ctx context.Context, in *KVDeleteRequest, opts .
 	return out, nil
 }
 
-func (c *kStashClient) SystemGenerateAccessToken(ctx context.Context, in *SystemGenerateAccessTokenRequest, opts ...grpc.CallOption) (*SystemGenerateAccessTokenResponse, error) {
-	out := new(SystemGenerateAccessTokenResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemGenerateAccessToken", in, out, opts...)
+func (c *kStashClient) KVGetMetadata(ctx context.Context, in *KVGetMetadataRequest, opts ...grpc.CallOption) (*KVGetMetadataResponse, error) {
+	out := new(KVGetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/KVGetMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemGenerateGatekeeperToken(ctx context.Context, in *SystemGenerateGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemGenerateGatekeeperTokenResponse, error) {
-	out := new(SystemGenerateGatekeeperTokenResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemGenerateGatekeeperToken", in, out, opts...)
+func (c *kStashClient) KVPutMetadata(ctx context.Context, in *KVPutMetadataRequest, opts ...grpc.CallOption) (*KVPutMetadataResponse, error) {
+	out := new(KVPutMetadataResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/KVPutMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemInitialize(ctx context.Context, in *SystemInitializeRequest, opts ...grpc.CallOption) (*SystemInitializeResponse, error) {
-	out := new(SystemInitializeResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemInitialize", in, out, opts...)
+func (c *kStashClient) KVSearch(ctx context.Context, in *KVSearchRequest, opts ...grpc.CallOption) (*KVSearchResponse, error) {
+	out := new(KVSearchResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/KVSearch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemPruneTokens(ctx context.Context, in *SystemPruneTokensRequest, opts ...grpc.CallOption) (*SystemPruneTokensResponse, error) {
-	out := new(SystemPruneTokensResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemPruneTokens", in, out, opts...)
+func (c *kStashClient) LeaseRenew(ctx context.Context, in *LeaseRenewRequest, opts ...grpc.CallOption) (*LeaseRenewResponse, error) {
+	out := new(LeaseRenewResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/LeaseRenew", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemRotateAccessKey(ctx context.Context, in *SystemRotateAccessKeyRequest, opts ...grpc.CallOption) (*SystemRotateAccessKeyResponse, error) {
-	out := new(SystemRotateAccessKeyResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateAccessKey", in, out, opts...)
+func (c *kStashClient) LeaseRevoke(ctx context.Context, in *LeaseRevokeRequest, opts ...grpc.CallOption) (*LeaseRevokeResponse, error) {
+	out := new(LeaseRevokeResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/LeaseRevoke", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemRotateEncryptionKey(ctx context.Context, in *SystemRotateEncryptionKeyRequest, opts ...grpc.CallOption) (*SystemRotateEncryptionKeyResponse, error) {
-	out := new(SystemRotateEncryptionKeyResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateEncryptionKey", in, out, opts...)
+func (c *kStashClient) LeaseRevokePrefix(ctx context.Context, in *LeaseRevokePrefixRequest, opts ...grpc.CallOption) (*LeaseRevokePrefixResponse, error) {
+	out := new(LeaseRevokePrefixResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/LeaseRevokePrefix", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemRotateGatekeeperToken(ctx context.Context, in *SystemRotateGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemRotateGatekeeperTokenResponse, error) {
-	out := new(SystemRotateGatekeeperTokenResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateGatekeeperToken", in, out, opts...)
+func (c *kStashClient) NamespaceConfigureKey(ctx context.Context, in *NamespaceConfigureKeyRequest, opts ...grpc.CallOption) (*NamespaceConfigureKeyResponse, error) {
+	out := new(NamespaceConfigureKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/NamespaceConfigureKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemRotateUnsealKeys(ctx context.Context, in *SystemRotateUnsealKeysRequest, opts ...grpc.CallOption) (*SystemRotateUnsealKeysResponse, error) {
-	out := new(SystemRotateUnsealKeysResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateUnsealKeys", in, out, opts...)
+func (c *kStashClient) NamespaceLock(ctx context.Context, in *NamespaceLockRequest, opts ...grpc.CallOption) (*NamespaceLockResponse, error) {
+	out := new(NamespaceLockResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/NamespaceLock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemRevokeGatekeeperToken(ctx context.Context, in *SystemRevokeGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemRevokeGatekeeperTokenResponse, error) {
-	out := new(SystemRevokeGatekeeperTokenResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRevokeGatekeeperToken", in, out, opts...)
+func (c *kStashClient) NamespaceStatus(ctx context.Context, in *NamespaceStatusRequest, opts ...grpc.CallOption) (*NamespaceStatusResponse, error) {
+	out := new(NamespaceStatusResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/NamespaceStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemSeal(ctx context.Context, in *SystemSealRequest, opts ...grpc.CallOption) (*SystemSealResponse, error) {
-	out := new(SystemSealResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemSeal", in, out, opts...)
+func (c *kStashClient) NamespaceUnlock(ctx context.Context, in *NamespaceUnlockRequest, opts ...grpc.CallOption) (*NamespaceUnlockResponse, error) {
+	out := new(NamespaceUnlockResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/NamespaceUnlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemStatus(ctx context.Context, in *SystemStatusRequest, opts ...grpc.CallOption) (*SystemStatusResponse, error) {
-	out := new(SystemStatusResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemStatus", in, out, opts...)
+func (c *kStashClient) PKIDeleteRole(ctx context.Context, in *PKIDeleteRoleRequest, opts ...grpc.CallOption) (*PKIDeleteRoleResponse, error) {
+	out := new(PKIDeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIDeleteRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kStashClient) SystemUnseal(ctx context.Context, in *SystemUnsealRequest, opts ...grpc.CallOption) (*SystemUnsealResponse, error) {
-	out := new(SystemUnsealResponse)
-	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemUnseal", in, out, opts...)
+func (c *kStashClient) PKIGenerateCA(ctx context.Context, in *PKIGenerateCARequest, opts ...grpc.CallOption) (*PKIGenerateCAResponse, error) {
+	out := new(PKIGenerateCAResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIGenerateCA", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// KStashServer is the server API for KStash service.
-// All implementations must embed UnimplementedKStashServer
-// for forward compatibility
-type KStashServer interface {
-	AuthTokenLookup(context.Context, *AuthTokenLookupRequest) (*AuthTokenLookupResponse, error)
-	AuthTokenRenew(context.Context, *AuthTokenRenewRequest) (*AuthTokenRenewResponse, error)
-	AuthTokenRevoke(context.Context, *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error)
-	KVList(context.Context, *KVListRequest) (*KVListResponse, error)
-	KVGet(context.Context, *KVGetRequest) (*KVGetResponse, error)
-	KVPut(context.Context, *KVPutRequest) (*KVPutResponse, error)
-	KVDelete(context.Context, *KVDeleteRequest) (*KVDeleteResponse, error)
-	SystemGenerateAccessToken(context.Context, *SystemGenerateAccessTokenRequest) (*SystemGenerateAccessTokenResponse, error)
-	SystemGenerateGatekeeperToken(context.Context, *SystemGenerateGatekeeperTokenRequest) (*SystemGenerateGatekeeperTokenResponse, error)
-	SystemInitialize(context.Context, *SystemInitializeRequest) (*SystemInitializeResponse, error)
-	SystemPruneTokens(context.Context, *SystemPruneTokensRequest) (*SystemPruneTokensResponse, error)
-	SystemRotateAccessKey(context.Context, *SystemRotateAccessKeyRequest) (*SystemRotateAccessKeyResponse, error)
-	SystemRotateEncryptionKey(context.Context, *SystemRotateEncryptionKeyRequest) (*SystemRotateEncryptionKeyResponse, error)
-	SystemRotateGatekeeperToken(context.Context, *SystemRotateGatekeeperTokenRequest) (*SystemRotateGatekeeperTokenResponse, error)
-	SystemRotateUnsealKeys(context.Context, *SystemRotateUnsealKeysRequest) (*SystemRotateUnsealKeysResponse, error)
-	SystemRevokeGatekeeperToken(context.Context, *SystemRevokeGatekeeperTokenRequest) (*SystemRevokeGatekeeperTokenResponse, error)
-	SystemSeal(context.Context, *SystemSealRequest) (*SystemSealResponse, error)
-	SystemStatus(context.Context, *SystemStatusRequest) (*SystemStatusResponse, error)
-	SystemUnseal(context.Context, *SystemUnsealRequest) (*SystemUnsealResponse, error)
-	mustEmbedUnimplementedKStashServer()
+func (c *kStashClient) PKIGetCA(ctx context.Context, in *PKIGetCARequest, opts ...grpc.CallOption) (*PKIGetCAResponse, error) {
+	out := new(PKIGetCAResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIGetCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedKStashServer must be embedded to have forward compatible implementations.
-type UnimplementedKStashServer struct {
+func (c *kStashClient) PKIGetCRL(ctx context.Context, in *PKIGetCRLRequest, opts ...grpc.CallOption) (*PKIGetCRLResponse, error) {
+	out := new(PKIGetCRLResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIGetCRL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedKStashServer) AuthTokenLookup(context.Context, *AuthTokenLookupRequest) (*AuthTokenLookupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenLookup not implemented")
-}
-func (UnimplementedKStashServer) AuthTokenRenew(context.Context, *AuthTokenRenewRequest) (*AuthTokenRenewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenRenew not implemented")
+func (c *kStashClient) PKIGetRole(ctx context.Context, in *PKIGetRoleRequest, opts ...grpc.CallOption) (*PKIGetRoleResponse, error) {
+	out := new(PKIGetRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIGetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) AuthTokenRevoke(context.Context, *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenRevoke not implemented")
+
+func (c *kStashClient) PKIImportCA(ctx context.Context, in *PKIImportCARequest, opts ...grpc.CallOption) (*PKIImportCAResponse, error) {
+	out := new(PKIImportCAResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIImportCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) KVList(context.Context, *KVListRequest) (*KVListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KVList not implemented")
+
+func (c *kStashClient) PKIIssue(ctx context.Context, in *PKIIssueRequest, opts ...grpc.CallOption) (*PKIIssueResponse, error) {
+	out := new(PKIIssueResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIIssue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) KVGet(context.Context, *KVGetRequest) (*KVGetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KVGet not implemented")
+
+func (c *kStashClient) PKIPutRole(ctx context.Context, in *PKIPutRoleRequest, opts ...grpc.CallOption) (*PKIPutRoleResponse, error) {
+	out := new(PKIPutRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIPutRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) KVPut(context.Context, *KVPutRequest) (*KVPutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KVPut not implemented")
+
+func (c *kStashClient) PKIRevoke(ctx context.Context, in *PKIRevokeRequest, opts ...grpc.CallOption) (*PKIRevokeResponse, error) {
+	out := new(PKIRevokeResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKIRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) KVDelete(context.Context, *KVDeleteRequest) (*KVDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KVDelete not implemented")
+
+func (c *kStashClient) PKISign(ctx context.Context, in *PKISignRequest, opts ...grpc.CallOption) (*PKISignResponse, error) {
+	out := new(PKISignResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PKISign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemGenerateAccessToken(context.Context, *SystemGenerateAccessTokenRequest) (*SystemGenerateAccessTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemGenerateAccessToken not implemented")
+
+func (c *kStashClient) PolicyDelete(ctx context.Context, in *PolicyDeleteRequest, opts ...grpc.CallOption) (*PolicyDeleteResponse, error) {
+	out := new(PolicyDeleteResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PolicyDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemGenerateGatekeeperToken(context.Context, *SystemGenerateGatekeeperTokenRequest) (*SystemGenerateGatekeeperTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemGenerateGatekeeperToken not implemented")
+
+func (c *kStashClient) PolicyGet(ctx context.Context, in *PolicyGetRequest, opts ...grpc.CallOption) (*PolicyGetResponse, error) {
+	out := new(PolicyGetResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PolicyGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemInitialize(context.Context, *SystemInitializeRequest) (*SystemInitializeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemInitialize not implemented")
+
+func (c *kStashClient) PolicyList(ctx context.Context, in *PolicyListRequest, opts ...grpc.CallOption) (*PolicyListResponse, error) {
+	out := new(PolicyListResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PolicyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemPruneTokens(context.Context, *SystemPruneTokensRequest) (*SystemPruneTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemPruneTokens not implemented")
+
+func (c *kStashClient) PolicyPut(ctx context.Context, in *PolicyPutRequest, opts ...grpc.CallOption) (*PolicyPutResponse, error) {
+	out := new(PolicyPutResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/PolicyPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemRotateAccessKey(context.Context, *SystemRotateAccessKeyRequest) (*SystemRotateAccessKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateAccessKey not implemented")
+
+func (c *kStashClient) SSHDeleteRole(ctx context.Context, in *SSHDeleteRoleRequest, opts ...grpc.CallOption) (*SSHDeleteRoleResponse, error) {
+	out := new(SSHDeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SSHDeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemRotateEncryptionKey(context.Context, *SystemRotateEncryptionKeyRequest) (*SystemRotateEncryptionKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateEncryptionKey not implemented")
+
+func (c *kStashClient) SSHGenerateCA(ctx context.Context, in *SSHGenerateCARequest, opts ...grpc.CallOption) (*SSHGenerateCAResponse, error) {
+	out := new(SSHGenerateCAResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SSHGenerateCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemRotateGatekeeperToken(context.Context, *SystemRotateGatekeeperTokenRequest) (*SystemRotateGatekeeperTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateGatekeeperToken not implemented")
+
+func (c *kStashClient) SSHGetCA(ctx context.Context, in *SSHGetCARequest, opts ...grpc.CallOption) (*SSHGetCAResponse, error) {
+	out := new(SSHGetCAResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SSHGetCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemRotateUnsealKeys(context.Context, *SystemRotateUnsealKeysRequest) (*SystemRotateUnsealKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateUnsealKeys not implemented")
+
+func (c *kStashClient) SSHGetRole(ctx context.Context, in *SSHGetRoleRequest, opts ...grpc.CallOption) (*SSHGetRoleResponse, error) {
+	out := new(SSHGetRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SSHGetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemRevokeGatekeeperToken(context.Context, *SystemRevokeGatekeeperTokenRequest) (*SystemRevokeGatekeeperTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemRevokeGatekeeperToken not implemented")
+
+func (c *kStashClient) SSHImportCA(ctx context.Context, in *SSHImportCARequest, opts ...grpc.CallOption) (*SSHImportCAResponse, error) {
+	out := new(SSHImportCAResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SSHImportCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemSeal(context.Context, *SystemSealRequest) (*SystemSealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemSeal not implemented")
+
+func (c *kStashClient) SSHPutRole(ctx context.Context, in *SSHPutRoleRequest, opts ...grpc.CallOption) (*SSHPutRoleResponse, error) {
+	out := new(SSHPutRoleResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SSHPutRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemStatus(context.Context, *SystemStatusRequest) (*SystemStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemStatus not implemented")
+
+func (c *kStashClient) SignSSHKey(ctx context.Context, in *SignSSHKeyRequest, opts ...grpc.CallOption) (*SignSSHKeyResponse, error) {
+	out := new(SignSSHKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SignSSHKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) SystemUnseal(context.Context, *SystemUnsealRequest) (*SystemUnsealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemUnseal not implemented")
+
+func (c *kStashClient) SystemGenerateAccessToken(ctx context.Context, in *SystemGenerateAccessTokenRequest, opts ...grpc.CallOption) (*SystemGenerateAccessTokenResponse, error) {
+	out := new(SystemGenerateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemGenerateAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKStashServer) mustEmbedUnimplementedKStashServer() {}
 
-// UnsafeKStashServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to KStashServer will
-// result in compilation errors.
-type UnsafeKStashServer interface {
-	mustEmbedUnimplementedKStashServer()
+func (c *kStashClient) SystemGenerateGatekeeperToken(ctx context.Context, in *SystemGenerateGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemGenerateGatekeeperTokenResponse, error) {
+	out := new(SystemGenerateGatekeeperTokenResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemGenerateGatekeeperToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemInitialize(ctx context.Context, in *SystemInitializeRequest, opts ...grpc.CallOption) (*SystemInitializeResponse, error) {
+	out := new(SystemInitializeResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemInitialize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemListGatekeeperTokens(ctx context.Context, in *SystemListGatekeeperTokensRequest, opts ...grpc.CallOption) (*SystemListGatekeeperTokensResponse, error) {
+	out := new(SystemListGatekeeperTokensResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemListGatekeeperTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemPruneTokens(ctx context.Context, in *SystemPruneTokensRequest, opts ...grpc.CallOption) (*SystemPruneTokensResponse, error) {
+	out := new(SystemPruneTokensResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemPruneTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRekeyItems(ctx context.Context, in *SystemRekeyItemsRequest, opts ...grpc.CallOption) (*SystemRekeyItemsResponse, error) {
+	out := new(SystemRekeyItemsResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRekeyItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRestore(ctx context.Context, opts ...grpc.CallOption) (KStash_SystemRestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KStash_ServiceDesc.Streams[0], "/kstash.v1.KStash/SystemRestore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kStashSystemRestoreClient{stream}
+	return x, nil
+}
+
+type KStash_SystemRestoreClient interface {
+	Send(*SystemRestoreRequest) error
+	CloseAndRecv() (*SystemRestoreResponse, error)
+	grpc.ClientStream
+}
+
+type kStashSystemRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *kStashSystemRestoreClient) Send(m *SystemRestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *kStashSystemRestoreClient) CloseAndRecv() (*SystemRestoreResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SystemRestoreResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kStashClient) SystemRotateAccessKey(ctx context.Context, in *SystemRotateAccessKeyRequest, opts ...grpc.CallOption) (*SystemRotateAccessKeyResponse, error) {
+	out := new(SystemRotateAccessKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateAccessKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRotateEncryptionKey(ctx context.Context, in *SystemRotateEncryptionKeyRequest, opts ...grpc.CallOption) (*SystemRotateEncryptionKeyResponse, error) {
+	out := new(SystemRotateEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateEncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRotateGatekeeperToken(ctx context.Context, in *SystemRotateGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemRotateGatekeeperTokenResponse, error) {
+	out := new(SystemRotateGatekeeperTokenResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateGatekeeperToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRotateUnsealKeys(ctx context.Context, in *SystemRotateUnsealKeysRequest, opts ...grpc.CallOption) (*SystemRotateUnsealKeysResponse, error) {
+	out := new(SystemRotateUnsealKeysResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRotateUnsealKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRevokeGatekeeperToken(ctx context.Context, in *SystemRevokeGatekeeperTokenRequest, opts ...grpc.CallOption) (*SystemRevokeGatekeeperTokenResponse, error) {
+	out := new(SystemRevokeGatekeeperTokenResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRevokeGatekeeperToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemRevokeGatekeeperTokenAccessor(ctx context.Context, in *SystemRevokeGatekeeperTokenAccessorRequest, opts ...grpc.CallOption) (*SystemRevokeGatekeeperTokenAccessorResponse, error) {
+	out := new(SystemRevokeGatekeeperTokenAccessorResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemRevokeGatekeeperTokenAccessor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemSeal(ctx context.Context, in *SystemSealRequest, opts ...grpc.CallOption) (*SystemSealResponse, error) {
+	out := new(SystemSealResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemSeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemShredNamespace(ctx context.Context, in *SystemShredNamespaceRequest, opts ...grpc.CallOption) (*SystemShredNamespaceResponse, error) {
+	out := new(SystemShredNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemShredNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemSnapshot(ctx context.Context, in *SystemSnapshotRequest, opts ...grpc.CallOption) (KStash_SystemSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KStash_ServiceDesc.Streams[1], "/kstash.v1.KStash/SystemSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kStashSystemSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KStash_SystemSnapshotClient interface {
+	Recv() (*SystemSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type kStashSystemSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *kStashSystemSnapshotClient) Recv() (*SystemSnapshotResponse, error) {
+	m := new(SystemSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kStashClient) SystemStatus(ctx context.Context, in *SystemStatusRequest, opts ...grpc.CallOption) (*SystemStatusResponse, error) {
+	out := new(SystemStatusResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemUnseal(ctx context.Context, in *SystemUnsealRequest, opts ...grpc.CallOption) (*SystemUnsealResponse, error) {
+	out := new(SystemUnsealResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemUnseal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) SystemUpgradeItems(ctx context.Context, in *SystemUpgradeItemsRequest, opts ...grpc.CallOption) (*SystemUpgradeItemsResponse, error) {
+	out := new(SystemUpgradeItemsResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/SystemUpgradeItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitCreateKey(ctx context.Context, in *TransitCreateKeyRequest, opts ...grpc.CallOption) (*TransitCreateKeyResponse, error) {
+	out := new(TransitCreateKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitCreateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitDecrypt(ctx context.Context, in *TransitDecryptRequest, opts ...grpc.CallOption) (*TransitDecryptResponse, error) {
+	out := new(TransitDecryptResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitDecrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitDeleteKey(ctx context.Context, in *TransitDeleteKeyRequest, opts ...grpc.CallOption) (*TransitDeleteKeyResponse, error) {
+	out := new(TransitDeleteKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitDeleteKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitEncrypt(ctx context.Context, in *TransitEncryptRequest, opts ...grpc.CallOption) (*TransitEncryptResponse, error) {
+	out := new(TransitEncryptResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitEncrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitGetKey(ctx context.Context, in *TransitGetKeyRequest, opts ...grpc.CallOption) (*TransitGetKeyResponse, error) {
+	out := new(TransitGetKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitGetKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitHMAC(ctx context.Context, in *TransitHMACRequest, opts ...grpc.CallOption) (*TransitHMACResponse, error) {
+	out := new(TransitHMACResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitHMAC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitRewrap(ctx context.Context, in *TransitRewrapRequest, opts ...grpc.CallOption) (*TransitRewrapResponse, error) {
+	out := new(TransitRewrapResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitRewrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitRotateKey(ctx context.Context, in *TransitRotateKeyRequest, opts ...grpc.CallOption) (*TransitRotateKeyResponse, error) {
+	out := new(TransitRotateKeyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitRotateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitSign(ctx context.Context, in *TransitSignRequest, opts ...grpc.CallOption) (*TransitSignResponse, error) {
+	out := new(TransitSignResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitSign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kStashClient) TransitVerify(ctx context.Context, in *TransitVerifyRequest, opts ...grpc.CallOption) (*TransitVerifyResponse, error) {
+	out := new(TransitVerifyResponse)
+	err := c.cc.Invoke(ctx, "/kstash.v1.KStash/TransitVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KStashServer is the server API for KStash service.
+// All implementations must embed UnimplementedKStashServer
+// for forward compatibility
+type KStashServer interface {
+	ACLExplain(context.Context, *ACLExplainRequest) (*ACLExplainResponse, error)
+	AuthTokenLookup(context.Context, *AuthTokenLookupRequest) (*AuthTokenLookupResponse, error)
+	AuthTokenRenew(context.Context, *AuthTokenRenewRequest) (*AuthTokenRenewResponse, error)
+	AuthTokenRevoke(context.Context, *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error)
+	DatabaseConfigureConnection(context.Context, *DatabaseConfigureConnectionRequest) (*DatabaseConfigureConnectionResponse, error)
+	DatabaseDeleteConnection(context.Context, *DatabaseDeleteConnectionRequest) (*DatabaseDeleteConnectionResponse, error)
+	DatabaseDeleteRole(context.Context, *DatabaseDeleteRoleRequest) (*DatabaseDeleteRoleResponse, error)
+	DatabaseGenerateCredentials(context.Context, *DatabaseGenerateCredentialsRequest) (*DatabaseGenerateCredentialsResponse, error)
+	DatabaseGetRole(context.Context, *DatabaseGetRoleRequest) (*DatabaseGetRoleResponse, error)
+	DatabasePutRole(context.Context, *DatabasePutRoleRequest) (*DatabasePutRoleResponse, error)
+	KVList(context.Context, *KVListRequest) (*KVListResponse, error)
+	KVGet(context.Context, *KVGetRequest) (*KVGetResponse, error)
+	KVPut(context.Context, *KVPutRequest) (*KVPutResponse, error)
+	KVDelete(context.Context, *KVDeleteRequest) (*KVDeleteResponse, error)
+	KVGetMetadata(context.Context, *KVGetMetadataRequest) (*KVGetMetadataResponse, error)
+	KVPutMetadata(context.Context, *KVPutMetadataRequest) (*KVPutMetadataResponse, error)
+	KVSearch(context.Context, *KVSearchRequest) (*KVSearchResponse, error)
+	LeaseRenew(context.Context, *LeaseRenewRequest) (*LeaseRenewResponse, error)
+	LeaseRevoke(context.Context, *LeaseRevokeRequest) (*LeaseRevokeResponse, error)
+	LeaseRevokePrefix(context.Context, *LeaseRevokePrefixRequest) (*LeaseRevokePrefixResponse, error)
+	NamespaceConfigureKey(context.Context, *NamespaceConfigureKeyRequest) (*NamespaceConfigureKeyResponse, error)
+	NamespaceLock(context.Context, *NamespaceLockRequest) (*NamespaceLockResponse, error)
+	NamespaceStatus(context.Context, *NamespaceStatusRequest) (*NamespaceStatusResponse, error)
+	NamespaceUnlock(context.Context, *NamespaceUnlockRequest) (*NamespaceUnlockResponse, error)
+	PKIDeleteRole(context.Context, *PKIDeleteRoleRequest) (*PKIDeleteRoleResponse, error)
+	PKIGenerateCA(context.Context, *PKIGenerateCARequest) (*PKIGenerateCAResponse, error)
+	PKIGetCA(context.Context, *PKIGetCARequest) (*PKIGetCAResponse, error)
+	PKIGetCRL(context.Context, *PKIGetCRLRequest) (*PKIGetCRLResponse, error)
+	PKIGetRole(context.Context, *PKIGetRoleRequest) (*PKIGetRoleResponse, error)
+	PKIImportCA(context.Context, *PKIImportCARequest) (*PKIImportCAResponse, error)
+	PKIIssue(context.Context, *PKIIssueRequest) (*PKIIssueResponse, error)
+	PKIPutRole(context.Context, *PKIPutRoleRequest) (*PKIPutRoleResponse, error)
+	PKIRevoke(context.Context, *PKIRevokeRequest) (*PKIRevokeResponse, error)
+	PKISign(context.Context, *PKISignRequest) (*PKISignResponse, error)
+	PolicyDelete(context.Context, *PolicyDeleteRequest) (*PolicyDeleteResponse, error)
+	PolicyGet(context.Context, *PolicyGetRequest) (*PolicyGetResponse, error)
+	PolicyList(context.Context, *PolicyListRequest) (*PolicyListResponse, error)
+	PolicyPut(context.Context, *PolicyPutRequest) (*PolicyPutResponse, error)
+	SSHDeleteRole(context.Context, *SSHDeleteRoleRequest) (*SSHDeleteRoleResponse, error)
+	SSHGenerateCA(context.Context, *SSHGenerateCARequest) (*SSHGenerateCAResponse, error)
+	SSHGetCA(context.Context, *SSHGetCARequest) (*SSHGetCAResponse, error)
+	SSHGetRole(context.Context, *SSHGetRoleRequest) (*SSHGetRoleResponse, error)
+	SSHImportCA(context.Context, *SSHImportCARequest) (*SSHImportCAResponse, error)
+	SSHPutRole(context.Context, *SSHPutRoleRequest) (*SSHPutRoleResponse, error)
+	SignSSHKey(context.Context, *SignSSHKeyRequest) (*SignSSHKeyResponse, error)
+	SystemGenerateAccessToken(context.Context, *SystemGenerateAccessTokenRequest) (*SystemGenerateAccessTokenResponse, error)
+	SystemGenerateGatekeeperToken(context.Context, *SystemGenerateGatekeeperTokenRequest) (*SystemGenerateGatekeeperTokenResponse, error)
+	SystemInitialize(context.Context, *SystemInitializeRequest) (*SystemInitializeResponse, error)
+	SystemListGatekeeperTokens(context.Context, *SystemListGatekeeperTokensRequest) (*SystemListGatekeeperTokensResponse, error)
+	SystemPruneTokens(context.Context, *SystemPruneTokensRequest) (*SystemPruneTokensResponse, error)
+	SystemRekeyItems(context.Context, *SystemRekeyItemsRequest) (*SystemRekeyItemsResponse, error)
+	SystemRestore(KStash_SystemRestoreServer) error
+	SystemRotateAccessKey(context.Context, *SystemRotateAccessKeyRequest) (*SystemRotateAccessKeyResponse, error)
+	SystemRotateEncryptionKey(context.Context, *SystemRotateEncryptionKeyRequest) (*SystemRotateEncryptionKeyResponse, error)
+	SystemRotateGatekeeperToken(context.Context, *SystemRotateGatekeeperTokenRequest) (*SystemRotateGatekeeperTokenResponse, error)
+	SystemRotateUnsealKeys(context.Context, *SystemRotateUnsealKeysRequest) (*SystemRotateUnsealKeysResponse, error)
+	SystemRevokeGatekeeperToken(context.Context, *SystemRevokeGatekeeperTokenRequest) (*SystemRevokeGatekeeperTokenResponse, error)
+	SystemRevokeGatekeeperTokenAccessor(context.Context, *SystemRevokeGatekeeperTokenAccessorRequest) (*SystemRevokeGatekeeperTokenAccessorResponse, error)
+	SystemSeal(context.Context, *SystemSealRequest) (*SystemSealResponse, error)
+	SystemShredNamespace(context.Context, *SystemShredNamespaceRequest) (*SystemShredNamespaceResponse, error)
+	SystemSnapshot(*SystemSnapshotRequest, KStash_SystemSnapshotServer) error
+	SystemStatus(context.Context, *SystemStatusRequest) (*SystemStatusResponse, error)
+	SystemUnseal(context.Context, *SystemUnsealRequest) (*SystemUnsealResponse, error)
+	SystemUpgradeItems(context.Context, *SystemUpgradeItemsRequest) (*SystemUpgradeItemsResponse, error)
+	TransitCreateKey(context.Context, *TransitCreateKeyRequest) (*TransitCreateKeyResponse, error)
+	TransitDecrypt(context.Context, *TransitDecryptRequest) (*TransitDecryptResponse, error)
+	TransitDeleteKey(context.Context, *TransitDeleteKeyRequest) (*TransitDeleteKeyResponse, error)
+	TransitEncrypt(context.Context, *TransitEncryptRequest) (*TransitEncryptResponse, error)
+	TransitGetKey(context.Context, *TransitGetKeyRequest) (*TransitGetKeyResponse, error)
+	TransitHMAC(context.Context, *TransitHMACRequest) (*TransitHMACResponse, error)
+	TransitRewrap(context.Context, *TransitRewrapRequest) (*TransitRewrapResponse, error)
+	TransitRotateKey(context.Context, *TransitRotateKeyRequest) (*TransitRotateKeyResponse, error)
+	TransitSign(context.Context, *TransitSignRequest) (*TransitSignResponse, error)
+	TransitVerify(context.Context, *TransitVerifyRequest) (*TransitVerifyResponse, error)
+	mustEmbedUnimplementedKStashServer()
+}
+
+// UnimplementedKStashServer must be embedded to have forward compatible implementations.
+type UnimplementedKStashServer struct {
+}
+
+func (UnimplementedKStashServer) ACLExplain(context.Context, *ACLExplainRequest) (*ACLExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACLExplain not implemented")
+}
+func (UnimplementedKStashServer) AuthTokenLookup(context.Context, *AuthTokenLookupRequest) (*AuthTokenLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenLookup not implemented")
+}
+func (UnimplementedKStashServer) AuthTokenRenew(context.Context, *AuthTokenRenewRequest) (*AuthTokenRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenRenew not implemented")
+}
+func (UnimplementedKStashServer) AuthTokenRevoke(context.Context, *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenRevoke not implemented")
+}
+func (UnimplementedKStashServer) DatabaseConfigureConnection(context.Context, *DatabaseConfigureConnectionRequest) (*DatabaseConfigureConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseConfigureConnection not implemented")
+}
+func (UnimplementedKStashServer) DatabaseDeleteConnection(context.Context, *DatabaseDeleteConnectionRequest) (*DatabaseDeleteConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseDeleteConnection not implemented")
+}
+func (UnimplementedKStashServer) DatabaseDeleteRole(context.Context, *DatabaseDeleteRoleRequest) (*DatabaseDeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseDeleteRole not implemented")
+}
+func (UnimplementedKStashServer) DatabaseGenerateCredentials(context.Context, *DatabaseGenerateCredentialsRequest) (*DatabaseGenerateCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseGenerateCredentials not implemented")
+}
+func (UnimplementedKStashServer) DatabaseGetRole(context.Context, *DatabaseGetRoleRequest) (*DatabaseGetRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseGetRole not implemented")
+}
+func (UnimplementedKStashServer) DatabasePutRole(context.Context, *DatabasePutRoleRequest) (*DatabasePutRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabasePutRole not implemented")
+}
+func (UnimplementedKStashServer) KVList(context.Context, *KVListRequest) (*KVListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVList not implemented")
+}
+func (UnimplementedKStashServer) KVGet(context.Context, *KVGetRequest) (*KVGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVGet not implemented")
+}
+func (UnimplementedKStashServer) KVPut(context.Context, *KVPutRequest) (*KVPutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVPut not implemented")
+}
+func (UnimplementedKStashServer) KVDelete(context.Context, *KVDeleteRequest) (*KVDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVDelete not implemented")
+}
+func (UnimplementedKStashServer) KVGetMetadata(context.Context, *KVGetMetadataRequest) (*KVGetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVGetMetadata not implemented")
+}
+func (UnimplementedKStashServer) KVPutMetadata(context.Context, *KVPutMetadataRequest) (*KVPutMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVPutMetadata not implemented")
+}
+func (UnimplementedKStashServer) KVSearch(context.Context, *KVSearchRequest) (*KVSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KVSearch not implemented")
+}
+func (UnimplementedKStashServer) LeaseRenew(context.Context, *LeaseRenewRequest) (*LeaseRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseRenew not implemented")
+}
+func (UnimplementedKStashServer) LeaseRevoke(context.Context, *LeaseRevokeRequest) (*LeaseRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseRevoke not implemented")
+}
+func (UnimplementedKStashServer) LeaseRevokePrefix(context.Context, *LeaseRevokePrefixRequest) (*LeaseRevokePrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseRevokePrefix not implemented")
+}
+func (UnimplementedKStashServer) NamespaceConfigureKey(context.Context, *NamespaceConfigureKeyRequest) (*NamespaceConfigureKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceConfigureKey not implemented")
+}
+func (UnimplementedKStashServer) NamespaceLock(context.Context, *NamespaceLockRequest) (*NamespaceLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceLock not implemented")
+}
+func (UnimplementedKStashServer) NamespaceStatus(context.Context, *NamespaceStatusRequest) (*NamespaceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceStatus not implemented")
+}
+func (UnimplementedKStashServer) NamespaceUnlock(context.Context, *NamespaceUnlockRequest) (*NamespaceUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceUnlock not implemented")
+}
+func (UnimplementedKStashServer) PKIDeleteRole(context.Context, *PKIDeleteRoleRequest) (*PKIDeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIDeleteRole not implemented")
+}
+func (UnimplementedKStashServer) PKIGenerateCA(context.Context, *PKIGenerateCARequest) (*PKIGenerateCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIGenerateCA not implemented")
+}
+func (UnimplementedKStashServer) PKIGetCA(context.Context, *PKIGetCARequest) (*PKIGetCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIGetCA not implemented")
+}
+func (UnimplementedKStashServer) PKIGetCRL(context.Context, *PKIGetCRLRequest) (*PKIGetCRLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIGetCRL not implemented")
+}
+func (UnimplementedKStashServer) PKIGetRole(context.Context, *PKIGetRoleRequest) (*PKIGetRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIGetRole not implemented")
+}
+func (UnimplementedKStashServer) PKIImportCA(context.Context, *PKIImportCARequest) (*PKIImportCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIImportCA not implemented")
+}
+func (UnimplementedKStashServer) PKIIssue(context.Context, *PKIIssueRequest) (*PKIIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIIssue not implemented")
+}
+func (UnimplementedKStashServer) PKIPutRole(context.Context, *PKIPutRoleRequest) (*PKIPutRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIPutRole not implemented")
+}
+func (UnimplementedKStashServer) PKIRevoke(context.Context, *PKIRevokeRequest) (*PKIRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKIRevoke not implemented")
+}
+func (UnimplementedKStashServer) PKISign(context.Context, *PKISignRequest) (*PKISignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKISign not implemented")
+}
+func (UnimplementedKStashServer) PolicyDelete(context.Context, *PolicyDeleteRequest) (*PolicyDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyDelete not implemented")
+}
+func (UnimplementedKStashServer) PolicyGet(context.Context, *PolicyGetRequest) (*PolicyGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyGet not implemented")
+}
+func (UnimplementedKStashServer) PolicyList(context.Context, *PolicyListRequest) (*PolicyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyList not implemented")
+}
+func (UnimplementedKStashServer) PolicyPut(context.Context, *PolicyPutRequest) (*PolicyPutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyPut not implemented")
+}
+func (UnimplementedKStashServer) SSHDeleteRole(context.Context, *SSHDeleteRoleRequest) (*SSHDeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHDeleteRole not implemented")
+}
+func (UnimplementedKStashServer) SSHGenerateCA(context.Context, *SSHGenerateCARequest) (*SSHGenerateCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHGenerateCA not implemented")
+}
+func (UnimplementedKStashServer) SSHGetCA(context.Context, *SSHGetCARequest) (*SSHGetCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHGetCA not implemented")
+}
+func (UnimplementedKStashServer) SSHGetRole(context.Context, *SSHGetRoleRequest) (*SSHGetRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHGetRole not implemented")
+}
+func (UnimplementedKStashServer) SSHImportCA(context.Context, *SSHImportCARequest) (*SSHImportCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHImportCA not implemented")
+}
+func (UnimplementedKStashServer) SSHPutRole(context.Context, *SSHPutRoleRequest) (*SSHPutRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHPutRole not implemented")
+}
+func (UnimplementedKStashServer) SignSSHKey(context.Context, *SignSSHKeyRequest) (*SignSSHKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSSHKey not implemented")
+}
+func (UnimplementedKStashServer) SystemGenerateAccessToken(context.Context, *SystemGenerateAccessTokenRequest) (*SystemGenerateAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGenerateAccessToken not implemented")
+}
+func (UnimplementedKStashServer) SystemGenerateGatekeeperToken(context.Context, *SystemGenerateGatekeeperTokenRequest) (*SystemGenerateGatekeeperTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGenerateGatekeeperToken not implemented")
+}
+func (UnimplementedKStashServer) SystemInitialize(context.Context, *SystemInitializeRequest) (*SystemInitializeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemInitialize not implemented")
+}
+func (UnimplementedKStashServer) SystemListGatekeeperTokens(context.Context, *SystemListGatekeeperTokensRequest) (*SystemListGatekeeperTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemListGatekeeperTokens not implemented")
+}
+func (UnimplementedKStashServer) SystemPruneTokens(context.Context, *SystemPruneTokensRequest) (*SystemPruneTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemPruneTokens not implemented")
+}
+func (UnimplementedKStashServer) SystemRekeyItems(context.Context, *SystemRekeyItemsRequest) (*SystemRekeyItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRekeyItems not implemented")
+}
+func (UnimplementedKStashServer) SystemRestore(KStash_SystemRestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemRestore not implemented")
+}
+func (UnimplementedKStashServer) SystemRotateAccessKey(context.Context, *SystemRotateAccessKeyRequest) (*SystemRotateAccessKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateAccessKey not implemented")
+}
+func (UnimplementedKStashServer) SystemRotateEncryptionKey(context.Context, *SystemRotateEncryptionKeyRequest) (*SystemRotateEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateEncryptionKey not implemented")
+}
+func (UnimplementedKStashServer) SystemRotateGatekeeperToken(context.Context, *SystemRotateGatekeeperTokenRequest) (*SystemRotateGatekeeperTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateGatekeeperToken not implemented")
+}
+func (UnimplementedKStashServer) SystemRotateUnsealKeys(context.Context, *SystemRotateUnsealKeysRequest) (*SystemRotateUnsealKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRotateUnsealKeys not implemented")
+}
+func (UnimplementedKStashServer) SystemRevokeGatekeeperToken(context.Context, *SystemRevokeGatekeeperTokenRequest) (*SystemRevokeGatekeeperTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRevokeGatekeeperToken not implemented")
+}
+func (UnimplementedKStashServer) SystemRevokeGatekeeperTokenAccessor(context.Context, *SystemRevokeGatekeeperTokenAccessorRequest) (*SystemRevokeGatekeeperTokenAccessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRevokeGatekeeperTokenAccessor not implemented")
+}
+func (UnimplementedKStashServer) SystemSeal(context.Context, *SystemSealRequest) (*SystemSealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSeal not implemented")
+}
+func (UnimplementedKStashServer) SystemShredNamespace(context.Context, *SystemShredNamespaceRequest) (*SystemShredNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemShredNamespace not implemented")
+}
+func (UnimplementedKStashServer) SystemSnapshot(*SystemSnapshotRequest, KStash_SystemSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemSnapshot not implemented")
+}
+func (UnimplementedKStashServer) SystemStatus(context.Context, *SystemStatusRequest) (*SystemStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemStatus not implemented")
+}
+func (UnimplementedKStashServer) SystemUnseal(context.Context, *SystemUnsealRequest) (*SystemUnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemUnseal not implemented")
+}
+func (UnimplementedKStashServer) SystemUpgradeItems(context.Context, *SystemUpgradeItemsRequest) (*SystemUpgradeItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemUpgradeItems not implemented")
+}
+func (UnimplementedKStashServer) TransitCreateKey(context.Context, *TransitCreateKeyRequest) (*TransitCreateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitCreateKey not implemented")
+}
+func (UnimplementedKStashServer) TransitDecrypt(context.Context, *TransitDecryptRequest) (*TransitDecryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitDecrypt not implemented")
+}
+func (UnimplementedKStashServer) TransitDeleteKey(context.Context, *TransitDeleteKeyRequest) (*TransitDeleteKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitDeleteKey not implemented")
+}
+func (UnimplementedKStashServer) TransitEncrypt(context.Context, *TransitEncryptRequest) (*TransitEncryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitEncrypt not implemented")
+}
+func (UnimplementedKStashServer) TransitGetKey(context.Context, *TransitGetKeyRequest) (*TransitGetKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitGetKey not implemented")
+}
+func (UnimplementedKStashServer) TransitHMAC(context.Context, *TransitHMACRequest) (*TransitHMACResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitHMAC not implemented")
+}
+func (UnimplementedKStashServer) TransitRewrap(context.Context, *TransitRewrapRequest) (*TransitRewrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitRewrap not implemented")
+}
+func (UnimplementedKStashServer) TransitRotateKey(context.Context, *TransitRotateKeyRequest) (*TransitRotateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitRotateKey not implemented")
+}
+func (UnimplementedKStashServer) TransitSign(context.Context, *TransitSignRequest) (*TransitSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitSign not implemented")
+}
+func (UnimplementedKStashServer) TransitVerify(context.Context, *TransitVerifyRequest) (*TransitVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitVerify not implemented")
+}
+func (UnimplementedKStashServer) mustEmbedUnimplementedKStashServer() {}
+
+// UnsafeKStashServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KStashServer will
+// result in compilation errors.
+type UnsafeKStashServer interface {
+	mustEmbedUnimplementedKStashServer()
 }
 
 func RegisterKStashServer(s grpc.ServiceRegistrar, srv KStashServer) {
 	s.RegisterService(&KStash_ServiceDesc, srv)
 }
 
-func _KStash_AuthTokenLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthTokenLookupRequest)
+func _KStash_ACLExplain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).ACLExplain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/ACLExplain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).ACLExplain(ctx, req.(*ACLExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_AuthTokenLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).AuthTokenLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/AuthTokenLookup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).AuthTokenLookup(ctx, req.(*AuthTokenLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_AuthTokenRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenRenewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).AuthTokenRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/AuthTokenRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).AuthTokenRenew(ctx, req.(*AuthTokenRenewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_AuthTokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).AuthTokenRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/AuthTokenRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).AuthTokenRevoke(ctx, req.(*AuthTokenRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_DatabaseConfigureConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseConfigureConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).DatabaseConfigureConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/DatabaseConfigureConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).DatabaseConfigureConnection(ctx, req.(*DatabaseConfigureConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_DatabaseDeleteConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseDeleteConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).DatabaseDeleteConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/DatabaseDeleteConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).DatabaseDeleteConnection(ctx, req.(*DatabaseDeleteConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_DatabaseDeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseDeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).DatabaseDeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/DatabaseDeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).DatabaseDeleteRole(ctx, req.(*DatabaseDeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_DatabaseGenerateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseGenerateCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).DatabaseGenerateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/DatabaseGenerateCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).DatabaseGenerateCredentials(ctx, req.(*DatabaseGenerateCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_DatabaseGetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseGetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).DatabaseGetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/DatabaseGetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).DatabaseGetRole(ctx, req.(*DatabaseGetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_DatabasePutRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabasePutRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).DatabasePutRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/DatabasePutRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).DatabasePutRole(ctx, req.(*DatabasePutRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVList(ctx, req.(*KVListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVGet(ctx, req.(*KVGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVPut(ctx, req.(*KVPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVDelete(ctx, req.(*KVDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVGetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVGetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVGetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVGetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVGetMetadata(ctx, req.(*KVGetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVPutMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVPutMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVPutMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVPutMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVPutMetadata(ctx, req.(*KVPutMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_KVSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).KVSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/KVSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).KVSearch(ctx, req.(*KVSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_LeaseRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRenewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).LeaseRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/LeaseRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).LeaseRenew(ctx, req.(*LeaseRenewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_LeaseRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).LeaseRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/LeaseRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).LeaseRevoke(ctx, req.(*LeaseRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_LeaseRevokePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRevokePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).LeaseRevokePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/LeaseRevokePrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).LeaseRevokePrefix(ctx, req.(*LeaseRevokePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_NamespaceConfigureKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceConfigureKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).NamespaceConfigureKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/NamespaceConfigureKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).NamespaceConfigureKey(ctx, req.(*NamespaceConfigureKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_NamespaceLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).NamespaceLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/NamespaceLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).NamespaceLock(ctx, req.(*NamespaceLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_NamespaceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).NamespaceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/NamespaceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).NamespaceStatus(ctx, req.(*NamespaceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_NamespaceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).NamespaceUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/NamespaceUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).NamespaceUnlock(ctx, req.(*NamespaceUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIDeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIDeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIDeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIDeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIDeleteRole(ctx, req.(*PKIDeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIGenerateCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIGenerateCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIGenerateCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIGenerateCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIGenerateCA(ctx, req.(*PKIGenerateCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIGetCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIGetCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIGetCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIGetCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIGetCA(ctx, req.(*PKIGetCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIGetCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIGetCRLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIGetCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIGetCRL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIGetCRL(ctx, req.(*PKIGetCRLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIGetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIGetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIGetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIGetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIGetRole(ctx, req.(*PKIGetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIImportCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIImportCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIImportCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIImportCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIImportCA(ctx, req.(*PKIImportCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIIssue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIIssue(ctx, req.(*PKIIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIPutRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIPutRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIPutRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIPutRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIPutRole(ctx, req.(*PKIPutRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKIRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKIRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKIRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKIRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKIRevoke(ctx, req.(*PKIRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PKISign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKISignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PKISign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PKISign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PKISign(ctx, req.(*PKISignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PolicyDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PolicyDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PolicyDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PolicyDelete(ctx, req.(*PolicyDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PolicyGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PolicyGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PolicyGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PolicyGet(ctx, req.(*PolicyGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PolicyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PolicyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PolicyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PolicyList(ctx, req.(*PolicyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_PolicyPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).PolicyPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/PolicyPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).PolicyPut(ctx, req.(*PolicyPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SSHDeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHDeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SSHDeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SSHDeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SSHDeleteRole(ctx, req.(*SSHDeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SSHGenerateCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHGenerateCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SSHGenerateCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SSHGenerateCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SSHGenerateCA(ctx, req.(*SSHGenerateCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SSHGetCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHGetCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SSHGetCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SSHGetCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SSHGetCA(ctx, req.(*SSHGetCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SSHGetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHGetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SSHGetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SSHGetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SSHGetRole(ctx, req.(*SSHGetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SSHImportCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHImportCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SSHImportCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SSHImportCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SSHImportCA(ctx, req.(*SSHImportCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SSHPutRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHPutRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SSHPutRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SSHPutRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SSHPutRole(ctx, req.(*SSHPutRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SignSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SignSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SignSSHKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SignSSHKey(ctx, req.(*SignSSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemGenerateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGenerateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemGenerateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemGenerateAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemGenerateAccessToken(ctx, req.(*SystemGenerateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemGenerateGatekeeperToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGenerateGatekeeperTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemGenerateGatekeeperToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemGenerateGatekeeperToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemGenerateGatekeeperToken(ctx, req.(*SystemGenerateGatekeeperTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemInitialize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemInitializeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemInitialize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemInitialize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemInitialize(ctx, req.(*SystemInitializeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemListGatekeeperTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemListGatekeeperTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemListGatekeeperTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemListGatekeeperTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemListGatekeeperTokens(ctx, req.(*SystemListGatekeeperTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemPruneTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemPruneTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemPruneTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemPruneTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemPruneTokens(ctx, req.(*SystemPruneTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemRekeyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRekeyItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemRekeyItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemRekeyItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemRekeyItems(ctx, req.(*SystemRekeyItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemRestore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KStashServer).SystemRestore(&kStashSystemRestoreServer{stream})
+}
+
+type KStash_SystemRestoreServer interface {
+	SendAndClose(*SystemRestoreResponse) error
+	Recv() (*SystemRestoreRequest, error)
+	grpc.ServerStream
+}
+
+type kStashSystemRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *kStashSystemRestoreServer) SendAndClose(m *SystemRestoreResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *kStashSystemRestoreServer) Recv() (*SystemRestoreRequest, error) {
+	m := new(SystemRestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _KStash_SystemRotateAccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRotateAccessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemRotateAccessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemRotateAccessKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemRotateAccessKey(ctx, req.(*SystemRotateAccessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemRotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KStashServer).SystemRotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kstash.v1.KStash/SystemRotateEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KStashServer).SystemRotateEncryptionKey(ctx, req.(*SystemRotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KStash_SystemRotateGatekeeperToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRotateGatekeeperTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).AuthTokenLookup(ctx, in)
+		return srv.(KStashServer).SystemRotateGatekeeperToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/AuthTokenLookup",
+		FullMethod: "/kstash.v1.KStash/SystemRotateGatekeeperToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).AuthTokenLookup(ctx, req.(*AuthTokenLookupRequest))
+		return srv.(KStashServer).SystemRotateGatekeeperToken(ctx, req.(*SystemRotateGatekeeperTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_AuthTokenRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthTokenRenewRequest)
+func _KStash_SystemRotateUnsealKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRotateUnsealKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).AuthTokenRenew(ctx, in)
+		return srv.(KStashServer).SystemRotateUnsealKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/AuthTokenRenew",
+		FullMethod: "/kstash.v1.KStash/SystemRotateUnsealKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).AuthTokenRenew(ctx, req.(*AuthTokenRenewRequest))
+		return srv.(KStashServer).SystemRotateUnsealKeys(ctx, req.(*SystemRotateUnsealKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_AuthTokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthTokenRevokeRequest)
+func _KStash_SystemRevokeGatekeeperToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRevokeGatekeeperTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).AuthTokenRevoke(ctx, in)
+		return srv.(KStashServer).SystemRevokeGatekeeperToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/AuthTokenRevoke",
+		FullMethod: "/kstash.v1.KStash/SystemRevokeGatekeeperToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).AuthTokenRevoke(ctx, req.(*AuthTokenRevokeRequest))
+		return srv.(KStashServer).SystemRevokeGatekeeperToken(ctx, req.(*SystemRevokeGatekeeperTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_KVList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KVListRequest)
+func _KStash_SystemRevokeGatekeeperTokenAccessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRevokeGatekeeperTokenAccessorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).KVList(ctx, in)
+		return srv.(KStashServer).SystemRevokeGatekeeperTokenAccessor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/KVList",
+		FullMethod: "/kstash.v1.KStash/SystemRevokeGatekeeperTokenAccessor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).KVList(ctx, req.(*KVListRequest))
+		return srv.(KStashServer).SystemRevokeGatekeeperTokenAccessor(ctx, req.(*SystemRevokeGatekeeperTokenAccessorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_KVGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KVGetRequest)
+func _KStash_SystemSeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).KVGet(ctx, in)
+		return srv.(KStashServer).SystemSeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/KVGet",
+		FullMethod: "/kstash.v1.KStash/SystemSeal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).KVGet(ctx, req.(*KVGetRequest))
+		return srv.(KStashServer).SystemSeal(ctx, req.(*SystemSealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_KVPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KVPutRequest)
+func _KStash_SystemShredNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemShredNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).KVPut(ctx, in)
+		return srv.(KStashServer).SystemShredNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/KVPut",
+		FullMethod: "/kstash.v1.KStash/SystemShredNamespace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).KVPut(ctx, req.(*KVPutRequest))
+		return srv.(KStashServer).SystemShredNamespace(ctx, req.(*SystemShredNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_KVDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KVDeleteRequest)
+func _KStash_SystemSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KStashServer).SystemSnapshot(m, &kStashSystemSnapshotServer{stream})
+}
+
+type KStash_SystemSnapshotServer interface {
+	Send(*SystemSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type kStashSystemSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *kStashSystemSnapshotServer) Send(m *SystemSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KStash_SystemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).KVDelete(ctx, in)
+		return srv.(KStashServer).SystemStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/KVDelete",
+		FullMethod: "/kstash.v1.KStash/SystemStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).KVDelete(ctx, req.(*KVDeleteRequest))
+		return srv.(KStashServer).SystemStatus(ctx, req.(*SystemStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemGenerateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemGenerateAccessTokenRequest)
+func _KStash_SystemUnseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemUnsealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemGenerateAccessToken(ctx, in)
+		return srv.(KStashServer).SystemUnseal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemGenerateAccessToken",
+		FullMethod: "/kstash.v1.KStash/SystemUnseal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemGenerateAccessToken(ctx, req.(*SystemGenerateAccessTokenRequest))
+		return srv.(KStashServer).SystemUnseal(ctx, req.(*SystemUnsealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemGenerateGatekeeperToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemGenerateGatekeeperTokenRequest)
+func _KStash_SystemUpgradeItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemUpgradeItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemGenerateGatekeeperToken(ctx, in)
+		return srv.(KStashServer).SystemUpgradeItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemGenerateGatekeeperToken",
+		FullMethod: "/kstash.v1.KStash/SystemUpgradeItems",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemGenerateGatekeeperToken(ctx, req.(*SystemGenerateGatekeeperTokenRequest))
+		return srv.(KStashServer).SystemUpgradeItems(ctx, req.(*SystemUpgradeItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemInitialize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemInitializeRequest)
+func _KStash_TransitCreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitCreateKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemInitialize(ctx, in)
+		return srv.(KStashServer).TransitCreateKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemInitialize",
+		FullMethod: "/kstash.v1.KStash/TransitCreateKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemInitialize(ctx, req.(*SystemInitializeRequest))
+		return srv.(KStashServer).TransitCreateKey(ctx, req.(*TransitCreateKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemPruneTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemPruneTokensRequest)
+func _KStash_TransitDecrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitDecryptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemPruneTokens(ctx, in)
+		return srv.(KStashServer).TransitDecrypt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemPruneTokens",
+		FullMethod: "/kstash.v1.KStash/TransitDecrypt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemPruneTokens(ctx, req.(*SystemPruneTokensRequest))
+		return srv.(KStashServer).TransitDecrypt(ctx, req.(*TransitDecryptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemRotateAccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemRotateAccessKeyRequest)
+func _KStash_TransitDeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitDeleteKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemRotateAccessKey(ctx, in)
+		return srv.(KStashServer).TransitDeleteKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemRotateAccessKey",
+		FullMethod: "/kstash.v1.KStash/TransitDeleteKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemRotateAccessKey(ctx, req.(*SystemRotateAccessKeyRequest))
+		return srv.(KStashServer).TransitDeleteKey(ctx, req.(*TransitDeleteKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemRotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemRotateEncryptionKeyRequest)
+func _KStash_TransitEncrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitEncryptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemRotateEncryptionKey(ctx, in)
+		return srv.(KStashServer).TransitEncrypt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemRotateEncryptionKey",
+		FullMethod: "/kstash.v1.KStash/TransitEncrypt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemRotateEncryptionKey(ctx, req.(*SystemRotateEncryptionKeyRequest))
+		return srv.(KStashServer).TransitEncrypt(ctx, req.(*TransitEncryptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemRotateGatekeeperToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemRotateGatekeeperTokenRequest)
+func _KStash_TransitGetKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitGetKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemRotateGatekeeperToken(ctx, in)
+		return srv.(KStashServer).TransitGetKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemRotateGatekeeperToken",
+		FullMethod: "/kstash.v1.KStash/TransitGetKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemRotateGatekeeperToken(ctx, req.(*SystemRotateGatekeeperTokenRequest))
+		return srv.(KStashServer).TransitGetKey(ctx, req.(*TransitGetKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemRotateUnsealKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemRotateUnsealKeysRequest)
+func _KStash_TransitHMAC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitHMACRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemRotateUnsealKeys(ctx, in)
+		return srv.(KStashServer).TransitHMAC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemRotateUnsealKeys",
+		FullMethod: "/kstash.v1.KStash/TransitHMAC",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemRotateUnsealKeys(ctx, req.(*SystemRotateUnsealKeysRequest))
+		return srv.(KStashServer).TransitHMAC(ctx, req.(*TransitHMACRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemRevokeGatekeeperToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemRevokeGatekeeperTokenRequest)
+func _KStash_TransitRewrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitRewrapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemRevokeGatekeeperToken(ctx, in)
+		return srv.(KStashServer).TransitRewrap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemRevokeGatekeeperToken",
+		FullMethod: "/kstash.v1.KStash/TransitRewrap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemRevokeGatekeeperToken(ctx, req.(*SystemRevokeGatekeeperTokenRequest))
+		return srv.(KStashServer).TransitRewrap(ctx, req.(*TransitRewrapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemSeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemSealRequest)
+func _KStash_TransitRotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitRotateKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemSeal(ctx, in)
+		return srv.(KStashServer).TransitRotateKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemSeal",
+		FullMethod: "/kstash.v1.KStash/TransitRotateKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemSeal(ctx, req.(*SystemSealRequest))
+		return srv.(KStashServer).TransitRotateKey(ctx, req.(*TransitRotateKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemStatusRequest)
+func _KStash_TransitSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitSignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemStatus(ctx, in)
+		return srv.(KStashServer).TransitSign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemStatus",
+		FullMethod: "/kstash.v1.KStash/TransitSign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemStatus(ctx, req.(*SystemStatusRequest))
+		return srv.(KStashServer).TransitSign(ctx, req.(*TransitSignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KStash_SystemUnseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemUnsealRequest)
+func _KStash_TransitVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitVerifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KStashServer).SystemUnseal(ctx, in)
+		return srv.(KStashServer).TransitVerify(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kstash.v1.KStash/SystemUnseal",
+		FullMethod: "/kstash.v1.KStash/TransitVerify",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KStashServer).SystemUnseal(ctx, req.(*SystemUnsealRequest))
+		return srv.(KStashServer).TransitVerify(ctx, req.(*TransitVerifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -667,6 +2486,10 @@ var KStash_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "kstash.v1.KStash",
 	HandlerType: (*KStashServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ACLExplain",
+			Handler:    _KStash_ACLExplain_Handler,
+		},
 		{
 			MethodName: "AuthTokenLookup",
 			Handler:    _KStash_AuthTokenLookup_Handler,
@@ -679,6 +2502,30 @@ var KStash_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthTokenRevoke",
 			Handler:    _KStash_AuthTokenRevoke_Handler,
 		},
+		{
+			MethodName: "DatabaseConfigureConnection",
+			Handler:    _KStash_DatabaseConfigureConnection_Handler,
+		},
+		{
+			MethodName: "DatabaseDeleteConnection",
+			Handler:    _KStash_DatabaseDeleteConnection_Handler,
+		},
+		{
+			MethodName: "DatabaseDeleteRole",
+			Handler:    _KStash_DatabaseDeleteRole_Handler,
+		},
+		{
+			MethodName: "DatabaseGenerateCredentials",
+			Handler:    _KStash_DatabaseGenerateCredentials_Handler,
+		},
+		{
+			MethodName: "DatabaseGetRole",
+			Handler:    _KStash_DatabaseGetRole_Handler,
+		},
+		{
+			MethodName: "DatabasePutRole",
+			Handler:    _KStash_DatabasePutRole_Handler,
+		},
 		{
 			MethodName: "KVList",
 			Handler:    _KStash_KVList_Handler,
@@ -695,6 +2542,130 @@ var KStash_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KVDelete",
 			Handler:    _KStash_KVDelete_Handler,
 		},
+		{
+			MethodName: "KVGetMetadata",
+			Handler:    _KStash_KVGetMetadata_Handler,
+		},
+		{
+			MethodName: "KVPutMetadata",
+			Handler:    _KStash_KVPutMetadata_Handler,
+		},
+		{
+			MethodName: "KVSearch",
+			Handler:    _KStash_KVSearch_Handler,
+		},
+		{
+			MethodName: "LeaseRenew",
+			Handler:    _KStash_LeaseRenew_Handler,
+		},
+		{
+			MethodName: "LeaseRevoke",
+			Handler:    _KStash_LeaseRevoke_Handler,
+		},
+		{
+			MethodName: "LeaseRevokePrefix",
+			Handler:    _KStash_LeaseRevokePrefix_Handler,
+		},
+		{
+			MethodName: "NamespaceConfigureKey",
+			Handler:    _KStash_NamespaceConfigureKey_Handler,
+		},
+		{
+			MethodName: "NamespaceLock",
+			Handler:    _KStash_NamespaceLock_Handler,
+		},
+		{
+			MethodName: "NamespaceStatus",
+			Handler:    _KStash_NamespaceStatus_Handler,
+		},
+		{
+			MethodName: "NamespaceUnlock",
+			Handler:    _KStash_NamespaceUnlock_Handler,
+		},
+		{
+			MethodName: "PKIDeleteRole",
+			Handler:    _KStash_PKIDeleteRole_Handler,
+		},
+		{
+			MethodName: "PKIGenerateCA",
+			Handler:    _KStash_PKIGenerateCA_Handler,
+		},
+		{
+			MethodName: "PKIGetCA",
+			Handler:    _KStash_PKIGetCA_Handler,
+		},
+		{
+			MethodName: "PKIGetCRL",
+			Handler:    _KStash_PKIGetCRL_Handler,
+		},
+		{
+			MethodName: "PKIGetRole",
+			Handler:    _KStash_PKIGetRole_Handler,
+		},
+		{
+			MethodName: "PKIImportCA",
+			Handler:    _KStash_PKIImportCA_Handler,
+		},
+		{
+			MethodName: "PKIIssue",
+			Handler:    _KStash_PKIIssue_Handler,
+		},
+		{
+			MethodName: "PKIPutRole",
+			Handler:    _KStash_PKIPutRole_Handler,
+		},
+		{
+			MethodName: "PKIRevoke",
+			Handler:    _KStash_PKIRevoke_Handler,
+		},
+		{
+			MethodName: "PKISign",
+			Handler:    _KStash_PKISign_Handler,
+		},
+		{
+			MethodName: "PolicyDelete",
+			Handler:    _KStash_PolicyDelete_Handler,
+		},
+		{
+			MethodName: "PolicyGet",
+			Handler:    _KStash_PolicyGet_Handler,
+		},
+		{
+			MethodName: "PolicyList",
+			Handler:    _KStash_PolicyList_Handler,
+		},
+		{
+			MethodName: "PolicyPut",
+			Handler:    _KStash_PolicyPut_Handler,
+		},
+		{
+			MethodName: "SSHDeleteRole",
+			Handler:    _KStash_SSHDeleteRole_Handler,
+		},
+		{
+			MethodName: "SSHGenerateCA",
+			Handler:    _KStash_SSHGenerateCA_Handler,
+		},
+		{
+			MethodName: "SSHGetCA",
+			Handler:    _KStash_SSHGetCA_Handler,
+		},
+		{
+			MethodName: "SSHGetRole",
+			Handler:    _KStash_SSHGetRole_Handler,
+		},
+		{
+			MethodName: "SSHImportCA",
+			Handler:    _KStash_SSHImportCA_Handler,
+		},
+		{
+			MethodName: "SSHPutRole",
+			Handler:    _KStash_SSHPutRole_Handler,
+		},
+		{
+			MethodName: "SignSSHKey",
+			Handler:    _KStash_SignSSHKey_Handler,
+		},
 		{
 			MethodName: "SystemGenerateAccessToken",
 			Handler:    _KStash_SystemGenerateAccessToken_Handler,
@@ -707,10 +2678,18 @@ var KStash_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemInitialize",
 			Handler:    _KStash_SystemInitialize_Handler,
 		},
+		{
+			MethodName: "SystemListGatekeeperTokens",
+			Handler:    _KStash_SystemListGatekeeperTokens_Handler,
+		},
 		{
 			MethodName: "SystemPruneTokens",
 			Handler:    _KStash_SystemPruneTokens_Handler,
 		},
+		{
+			MethodName: "SystemRekeyItems",
+			Handler:    _KStash_SystemRekeyItems_Handler,
+		},
 		{
 			MethodName: "SystemRotateAccessKey",
 			Handler:    _KStash_SystemRotateAccessKey_Handler,
@@ -731,10 +2710,18 @@ var KStash_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemRevokeGatekeeperToken",
 			Handler:    _KStash_SystemRevokeGatekeeperToken_Handler,
 		},
+		{
+			MethodName: "SystemRevokeGatekeeperTokenAccessor",
+			Handler:    _KStash_SystemRevokeGatekeeperTokenAccessor_Handler,
+		},
 		{
 			MethodName: "SystemSeal",
 			Handler:    _KStash_SystemSeal_Handler,
 		},
+		{
+			MethodName: "SystemShredNamespace",
+			Handler:    _KStash_SystemShredNamespace_Handler,
+		},
 		{
 			MethodName: "SystemStatus",
 			Handler:    _KStash_SystemStatus_Handler,
@@ -743,7 +2730,62 @@ var KStash_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemUnseal",
 			Handler:    _KStash_SystemUnseal_Handler,
 		},
+		{
+			MethodName: "SystemUpgradeItems",
+			Handler:    _KStash_SystemUpgradeItems_Handler,
+		},
+		{
+			MethodName: "TransitCreateKey",
+			Handler:    _KStash_TransitCreateKey_Handler,
+		},
+		{
+			MethodName: "TransitDecrypt",
+			Handler:    _KStash_TransitDecrypt_Handler,
+		},
+		{
+			MethodName: "TransitDeleteKey",
+			Handler:    _KStash_TransitDeleteKey_Handler,
+		},
+		{
+			MethodName: "TransitEncrypt",
+			Handler:    _KStash_TransitEncrypt_Handler,
+		},
+		{
+			MethodName: "TransitGetKey",
+			Handler:    _KStash_TransitGetKey_Handler,
+		},
+		{
+			MethodName: "TransitHMAC",
+			Handler:    _KStash_TransitHMAC_Handler,
+		},
+		{
+			MethodName: "TransitRewrap",
+			Handler:    _KStash_TransitRewrap_Handler,
+		},
+		{
+			MethodName: "TransitRotateKey",
+			Handler:    _KStash_TransitRotateKey_Handler,
+		},
+		{
+			MethodName: "TransitSign",
+			Handler:    _KStash_TransitSign_Handler,
+		},
+		{
+			MethodName: "TransitVerify",
+			Handler:    _KStash_TransitVerify_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SystemRestore",
+			Handler:       _KStash_SystemRestore_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SystemSnapshot",
+			Handler:       _KStash_SystemSnapshot_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kstash.proto",
 }
@@ -0,0 +1,7 @@
+package main
+
+// the database/sql drivers of the database plugins.
+import (
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
@@ -57,6 +57,8 @@ func listenGRPC() error {
 	opts := []grpc.ServerOption{
 		v1.WithUnaryLogging(log),
 		v1.WithStreamLogging(log),
+		v1.WithUnaryForwarding(v1Service),
+		v1.WithStreamForwarding(v1Service),
 	}
 	server := grpc.NewServer(opts...)
 	apiv1.RegisterKStashServer(server, v1Service)
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"database/sql"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/slaskawi/vault-poc/pkg/secret/database"
+)
+
+func TestKStash(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "kstash")
+}
+
+var _ = Describe("kstash", func() {
+	It("links the drivers of all database plugins", func() {
+		Expect(database.Drivers()).NotTo(BeEmpty())
+		Expect(sql.Drivers()).To(ContainElements(database.Drivers()))
+	})
+})
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// explain explains whether an access token has a permission on a path. The token of the request is explained, unless the ID or,
+// with the access key, the reference ID of another token is given.
+func explain(args []string) error {
+	flags := flag.NewFlagSet("explain", flag.ExitOnError)
+	token := flags.String("token", os.Getenv("KSTASH_TOKEN"), "access token the request is made with (KSTASH_TOKEN)")
+	tokenID := flags.String("token-id", "", "ID of the access token to explain instead of the token of the request")
+	referenceID := flags.String("reference-id", "", "reference ID of the access token to explain, which requires the access key")
+	accessKey := flags.String("access-key", os.Getenv("KSTASH_ACCESS_KEY"), "access key used to explain a token by its reference ID (KSTASH_ACCESS_KEY)")
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		return fmt.Errorf("expected a path and a permission")
+	}
+
+	perm, ok := apiv1.Permission_value[strings.ToUpper(flags.Arg(1))]
+	if !ok || apiv1.Permission(perm) == apiv1.Permission_DENY {
+		return fmt.Errorf("unknown permission: %s", flags.Arg(1))
+	}
+
+	client, conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	if len(*token) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+
+	resp, err := client.ACLExplain(ctx, &apiv1.ACLExplainRequest{
+		TokenID:          *tokenID,
+		TokenReferenceID: *referenceID,
+		AccessKey:        *accessKey,
+		Path:             flags.Arg(0),
+		Permission:       apiv1.Permission(perm),
+	})
+	if err != nil {
+		return err
+	}
+
+	printExplanation(resp.Explanation)
+	return nil
+}
+
+func printExplanation(e *apiv1.ACLExplanation) {
+	decision := "denied"
+	if e.Allowed {
+		decision = "allowed"
+	}
+	fmt.Printf("%s: %s\n", decision, e.Reason)
+
+	var effective []string
+	for _, perm := range e.EffectivePermissions {
+		effective = append(effective, perm.String())
+	}
+	fmt.Printf("effective permissions: %s\n\n", strings.Join(effective, ","))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "\tPATH\tPERMISSIONS\tSOURCE\tMATCHED\tAPPLIED\tCONDITIONS")
+	for _, rule := range e.Rules {
+		marker := ""
+		if e.MatchedRule != nil && proto.Equal(rule, e.MatchedRule) {
+			marker = "*"
+		}
+
+		var perms []string
+		for _, perm := range rule.Acl.Permissions {
+			perms = append(perms, perm.String())
+		}
+
+		conditions := "-"
+		if rule.Acl.Conditions != nil {
+			conditions = "met"
+		}
+		if len(rule.ConditionFailure) > 0 {
+			conditions = "not met: " + rule.ConditionFailure
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\t%s\n", marker, rule.Acl.Path, strings.Join(perms, ","), rule.Source, rule.Matched, rule.Applied, conditions)
+	}
+	w.Flush()
+}
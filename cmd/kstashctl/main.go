@@ -0,0 +1,94 @@
+// Command kstashctl is a command line client for administering K-Stash.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var (
+	address  string
+	commands = map[string]command{
+		"explain":  {usage: "explain [flags] <path> <permission>: explain whether an access token has a permission on a path", run: explain},
+		"migrate":  {usage: "migrate [flags]: copy every item between two storage backends while K-Stash is stopped", run: migrateStorage},
+		"restore":  {usage: "restore <file>: restore a snapshot into an uninitialized K-Stash, use - to read from stdin", run: restore},
+		"snapshot": {usage: "snapshot [flags] <file>: write a snapshot of the encrypted store, use - to write to stdout", run: snapshot},
+	}
+)
+
+func main() {
+	flag.StringVar(&address, "address", getEnv("KSTASH_ADDRESS", "localhost:8080"), "gRPC address of K-Stash (KSTASH_ADDRESS)")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(flag.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <command> [command flags]\n\nFlags:\n", os.Args[0])
+	flag.PrintDefaults()
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(flag.CommandLine.Output(), "\nCommands:\n")
+	for _, name := range names {
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s\n", commands[name].usage)
+	}
+}
+
+// dial connects to the K-Stash gRPC API. The connection must be closed by the caller.
+func dial() (apiv1.KStashClient, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return apiv1.NewKStashClient(conn), conn, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+
+	return defaultValue
+}
+
+func toSlice(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/slaskawi/vault-poc/pkg/config"
+	"github.com/slaskawi/vault-poc/pkg/migrate"
+)
+
+// migrateStorage copies every item between two storage backends. The backends are configured like K-Stash's own storage backend,
+// with environment variables prefixed by SOURCE_ and DESTINATION_, e.g. SOURCE_STORAGE_BACKEND or DESTINATION_ETCD_PASSWORD.
+func migrateStorage(args []string) error {
+	src := config.GetStorageConfig("SOURCE_")
+	dst := config.GetStorageConfig("DESTINATION_")
+
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	flags.StringVar(&src.Backend, "source-backend", src.Backend, "source storage backend (SOURCE_STORAGE_BACKEND)")
+	flags.Var((*sliceValue)(&src.EtcdEndpoints), "source-etcd-endpoints", "comma-separated source Etcd endpoints (SOURCE_ETCD_ENDPOINTS)")
+	flags.StringVar(&src.EtcdUsername, "source-etcd-username", src.EtcdUsername, "source Etcd username (SOURCE_ETCD_USERNAME)")
+	flags.StringVar(&dst.Backend, "destination-backend", dst.Backend, "destination storage backend (DESTINATION_STORAGE_BACKEND)")
+	flags.Var((*sliceValue)(&dst.EtcdEndpoints), "destination-etcd-endpoints", "comma-separated destination Etcd endpoints (DESTINATION_ETCD_ENDPOINTS)")
+	flags.StringVar(&dst.EtcdUsername, "destination-etcd-username", dst.EtcdUsername, "destination Etcd username (DESTINATION_ETCD_USERNAME)")
+	resume := flags.Bool("resume", false, "continue an interrupted migration, skipping items that were already copied")
+	dryRun := flags.Bool("dry-run", false, "list the items that would be copied without writing anything")
+	verbose := flags.Bool("verbose", false, "print every item and the action taken for it")
+	flags.Parse(args)
+
+	srcStore, err := src.Storage()
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	dstStore, err := dst.Storage()
+	if err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	opts := &migrate.Options{
+		Resume: *resume,
+		DryRun: *dryRun,
+	}
+	if *verbose || *dryRun {
+		opts.Progress = func(key string, action migrate.Action) {
+			fmt.Fprintf(os.Stderr, "%s %s\n", action, key)
+		}
+	}
+
+	result, err := migrate.Migrate(context.Background(), srcStore, dstStore, opts)
+	if result != nil {
+		verb := "copied"
+		if *dryRun {
+			verb = "would copy"
+		}
+		fmt.Printf("%s %d items, skipped %d items that were already copied\n", verb, result.Copied, result.Skipped)
+	}
+
+	return err
+}
+
+// sliceValue is a flag.Value for comma-separated values.
+type sliceValue []string
+
+func (v *sliceValue) String() string {
+	return fmt.Sprint([]string(*v))
+}
+
+func (v *sliceValue) Set(s string) error {
+	*v = toSlice(s)
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+const snapshotChunkSize = 64 * 1024
+
+func snapshot(args []string) error {
+	flags := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	gatekeeperToken := flags.String("gatekeeper-token", os.Getenv("KSTASH_GATEKEEPER_TOKEN"), "gatekeeper token used to authorize the snapshot (KSTASH_GATEKEEPER_TOKEN)")
+	unsealKeys := flags.String("unseal-keys", "", "comma-separated unseal keys used to authorize the snapshot instead of a gatekeeper token")
+	renew := flags.Bool("renew", false, "keep the gatekeeper token valid after taking the snapshot")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("expected exactly one output file")
+	}
+
+	client, conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.SystemSnapshot(ctx, &apiv1.SystemSnapshotRequest{
+		GatekeeperToken: *gatekeeperToken,
+		UnsealKeys:      toSlice(*unsealKeys),
+		Renew:           *renew,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := flags.Arg(0)
+	if path == "-" {
+		return receiveSnapshot(stream, os.Stdout)
+	}
+
+	// write to a temporary file first, so a failed snapshot never replaces a previous one
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := receiveSnapshot(stream, f); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func receiveSnapshot(stream apiv1.KStash_SystemSnapshotClient, w io.Writer) error {
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(resp.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func restore(args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("expected exactly one input file")
+	}
+
+	var r io.Reader = os.Stdin
+	if path := flags.Arg(0); path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	client, conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := client.SystemRestore(context.Background())
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, snapshotChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&apiv1.SystemRestoreRequest{Data: buf[:n]}); err != nil {
+				// the server closed the stream, so the actual error is returned by CloseAndRecv
+				if err == io.EOF {
+					break
+				}
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("restored %d items into K-Stash %s, unseal it with the unseal keys of the snapshot's deployment\n", resp.NumItems, resp.BarrierID)
+	return nil
+}
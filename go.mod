@@ -5,14 +5,18 @@ go 1.16
 require (
 	github.com/go-logr/logr v0.4.0
 	github.com/go-logr/zapr v0.4.0
+	github.com/go-sql-driver/mysql v1.6.0
+	github.com/google/tink/go v1.7.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.6.0
+	github.com/lib/pq v1.10.2
 	github.com/onsi/ginkgo v1.16.4
 	github.com/onsi/gomega v1.13.0
 	go.etcd.io/etcd/client/v3 v3.5.0
 	go.etcd.io/etcd/server/v3 v3.5.0
 	go.uber.org/zap v1.17.0
-	google.golang.org/genproto v0.0.0-20210903162649-d08c68adba83
-	google.golang.org/grpc v1.40.0
+	golang.org/x/crypto v0.0.0-20220214200702-86341886e292
+	google.golang.org/genproto v0.0.0-20220218161850-94dd64e39d7c
+	google.golang.org/grpc v1.44.0
 	google.golang.org/protobuf v1.27.1
 	sigs.k8s.io/secrets-store-csi-driver v0.3.0
 )
@@ -1,37 +1,103 @@
 package auth
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"sort"
-	"strings"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 // ACLManager object.
-type ACLManager struct{}
+type ACLManager struct {
+	policies *PolicyManager
+}
+
+// NewACLManager creates a new ACLManager object. The policies referenced by tokens are read from the given PolicyManager,
+// which can be nil if tokens have no policies.
+func NewACLManager(policies *PolicyManager) *ACLManager {
+	return &ACLManager{policies: policies}
+}
 
-// NewACLManager creates a new ACLManager object.
-func NewACLManager() *ACLManager {
-	return &ACLManager{}
+// TokenACLs returns the ACLs of a token along with the ACLs of the policies it references. Policies are read on every call,
+// so a change to a policy applies to all of its tokens immediately. Policies that no longer exist are skipped.
+// Templates in the ACL paths are interpolated with the metadata of the token, and ACLs whose templates cannot be interpolated are skipped.
+func (m *ACLManager) TokenACLs(ctx context.Context, token *apiv1.AccessToken) ([]*apiv1.ACL, error) {
+	acls, _, err := m.tokenACLs(ctx, token)
+	return acls, err
+}
+
+// tokenACLs returns the ACLs of a token like TokenACLs, along with the source of each ACL: `token` or `policy/<name>`.
+func (m *ACLManager) tokenACLs(ctx context.Context, token *apiv1.AccessToken) ([]*apiv1.ACL, []string, error) {
+	acls := make([]*apiv1.ACL, 0, len(token.Acls))
+	var sources []string
+
+	add := func(source string, list []*apiv1.ACL) {
+		for _, acl := range list {
+			if acl, ok := interpolateACL(acl, token.Metadata); ok {
+				acls = append(acls, acl)
+				sources = append(sources, source)
+			}
+		}
+	}
+
+	add("token", token.Acls)
+	if m.policies == nil {
+		return acls, sources, nil
+	}
+
+	for _, name := range token.Policies {
+		policy, err := m.policies.GetPolicy(ctx, name)
+		if err != nil {
+			if errors.Is(err, ErrPolicyNotFound) {
+				continue
+			}
+			return nil, nil, err
+		}
+		add("policy/"+name, policy.Acls)
+	}
+
+	return acls, sources, nil
 }
 
 // CanPerform determines if the requested action is allowed to be performed based on its ACLs.
-// An error is returned if the action is not allowed to peformed.
-func (m *ACLManager) CanPerform(acls []*apiv1.ACL, perm apiv1.Permission, namespace, path string) error {
+// An error is returned if the action is not allowed to peformed. The conditions of the ACLs that apply to the path are evaluated
+// against the request carried by the context: an ACL only grants its permissions, and a DENY only applies, if the request meets them.
+// If the permission is only granted by ACLs whose conditions are not met, a ConditionError reports the condition that failed.
+func (m *ACLManager) CanPerform(ctx context.Context, acls []*apiv1.ACL, perm apiv1.Permission, namespace, path string) error {
 	if len(acls) == 0 {
 		return ErrForbidden
 	}
 
-	permissions, err := m.CalculatePermissions(acls, namespace, path)
+	matched, err := m.matchACLs(acls, path)
 	if err != nil {
 		return err
 	}
 
-	for _, p := range permissions {
-		if p == perm {
-			return nil
+	req := RequestFromContext(ctx)
+	for _, acl := range matched {
+		if acl.Permissions[0] == apiv1.Permission_DENY && checkConditions(acl, req) == nil {
+			return ErrForbidden
+		}
+	}
+
+	var condErr *ConditionError
+	for _, acl := range matched {
+		if !hasPermission(acl.Permissions, perm) {
+			continue
+		}
+		if err := checkConditions(acl, req); err != nil {
+			if condErr == nil {
+				condErr = err
+			}
+			continue
 		}
+		return nil
+	}
+
+	if condErr != nil {
+		return condErr
 	}
 
 	return ErrForbidden
@@ -46,12 +112,15 @@ func (m *ACLManager) ValidateACLs(acls []*apiv1.ACL) error {
 		if len(acl.Path) == 0 {
 			return fmt.Errorf("acl path cannot be empty")
 		}
-		if idx := strings.Index(acl.Path, "*"); idx > -1 && idx < len(acl.Path)-1 {
-			return fmt.Errorf("the `*` wildcard can only be used at the end of an acl path: %s", acl.Path)
+		if _, err := parseACLPath(acl.Path); err != nil {
+			return err
 		}
 		if len(acl.Permissions) == 0 {
 			return fmt.Errorf("acl has no permissions: %s", acl.Path)
 		}
+		if err := validateConditions(acl); err != nil {
+			return err
+		}
 		for _, perm := range acl.Permissions {
 			if perm == apiv1.Permission_DENY && len(acl.Permissions) > 1 {
 				return fmt.Errorf("a DENY permission cannot be mixed with other permissions: %s", acl.Path)
@@ -61,41 +130,93 @@ func (m *ACLManager) ValidateACLs(acls []*apiv1.ACL) error {
 	return nil
 }
 
-// CalculatePermissions calculates the permissions for the given ACLs and path.
+// CalculatePermissions calculates the permissions for the given ACLs and path. The ACLs of a token are evaluated together with
+// the ACLs of its policies, as returned by TokenACLs, so a DENY of a policy only applies if no ACL of the token is more specific.
+// ACL paths are relative to the namespace, and only the most specific ACLs that match the path apply: the first segment in which
+// two ACL paths differ decides, where a literal is more specific than a glob, a glob more specific than `+` and `+` more specific
+// than a trailing `*`. The permissions of equally specific ACLs are combined, unless one of them is a DENY.
+// The conditions of the ACLs are not evaluated, which CanPerform does.
 func (m *ACLManager) CalculatePermissions(acls []*apiv1.ACL, namespace, path string) ([]apiv1.Permission, error) {
-	if err := m.ValidateACLs(acls); err != nil {
+	matched, err := m.matchACLs(acls, path)
+	if err != nil {
 		return nil, err
 	}
 
-	// sort the ACLs by shortest path first
-	sort.Slice(acls, func(i, j int) bool {
-		return len(acls[i].Path) < len(acls[j].Path) && acls[i].Path < acls[j].Path
-	})
+	permissions := []apiv1.Permission{}
+	for _, acl := range matched {
+		for _, perm := range acl.Permissions {
+			if perm == apiv1.Permission_DENY {
+				return []apiv1.Permission{}, nil
+			}
+			if !hasPermission(permissions, perm) {
+				permissions = append(permissions, perm)
+			}
+		}
+	}
+
+	return permissions, nil
+}
 
-	path = strings.Trim(namespace, "/") + "/" + strings.TrimPrefix(path, "/")
-	noPermissions := []apiv1.Permission{}
-	permissions := noPermissions
+// matchACLs returns the most specific ACLs that match a path.
+func (m *ACLManager) matchACLs(acls []*apiv1.ACL, path string) ([]*apiv1.ACL, error) {
+	if err := m.ValidateACLs(acls); err != nil {
+		return nil, err
+	}
+
+	segments := splitPath(path)
+	var best aclPath
+	var matched []*apiv1.ACL
 
 	for _, acl := range acls {
-		p := strings.Trim(namespace, "/") + "/" + strings.TrimPrefix(acl.Path, "/")
+		p, err := parseACLPath(acl.Path)
+		if err != nil {
+			return nil, err
+		}
 
-		if path == p {
-			if acl.Permissions[0] == apiv1.Permission_DENY {
-				permissions = noPermissions
-			} else {
-				permissions = acl.Permissions
-			}
-			break
+		if !p.match(segments) {
+			continue
 		}
 
-		if strings.HasSuffix(p, "*") && strings.HasPrefix(path, p[:len(p)-1]) {
-			if acl.Permissions[0] == apiv1.Permission_DENY {
-				permissions = noPermissions
-			} else {
-				permissions = acl.Permissions
+		if best != nil {
+			cmp := p.compare(best)
+			if cmp < 0 {
+				continue
+			}
+			if cmp > 0 {
+				matched = nil
 			}
 		}
+
+		best = p
+		matched = append(matched, acl)
 	}
 
-	return permissions, nil
+	return matched, nil
+}
+
+// interpolateACL returns a copy of an ACL whose templates are interpolated with the metadata of a token.
+// ACLs without templates are returned as they are.
+func interpolateACL(acl *apiv1.ACL, metadata map[string]string) (*apiv1.ACL, bool) {
+	if acl == nil || !templatePattern.MatchString(acl.Path) {
+		return acl, true
+	}
+
+	path, ok := interpolate(acl.Path, metadata)
+	if !ok {
+		return nil, false
+	}
+
+	interpolated := proto.Clone(acl).(*apiv1.ACL)
+	interpolated.Path = path
+	return interpolated, true
+}
+
+func hasPermission(permissions []apiv1.Permission, perm apiv1.Permission) bool {
+	for _, p := range permissions {
+		if p == perm {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ConditionCIDR       = "cidr"
+	ConditionTimeWindow = "timeWindow"
+	ConditionMetadata   = "metadata"
+
+	timeWindowLayout = "15:04"
+)
+
+// Request describes a request, which the conditions of ACLs are evaluated against.
+type Request struct {
+	// ClientIP is the address of the client, or nil if it is unknown.
+	ClientIP net.IP
+	// Metadata is the metadata of the request. Keys are lower case.
+	Metadata map[string][]string
+	// Time is the time the request was made.
+	Time time.Time
+}
+
+type requestKey struct{}
+
+// NewRequestContext returns a context that carries a request for the evaluation of ACL conditions.
+func NewRequestContext(ctx context.Context, req *Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// RequestFromContext returns the request carried by a context. If there is none, a request without a client address
+// or metadata is returned, so only conditions on the time of day can be met.
+func RequestFromContext(ctx context.Context) *Request {
+	if req, ok := ctx.Value(requestKey{}).(*Request); ok && req != nil {
+		return req
+	}
+
+	return &Request{Time: time.Now()}
+}
+
+// ConditionError is returned if the ACLs grant a permission, but the conditions of the ACL are not met by the request.
+// It is an ErrForbidden, and is reported to gRPC clients as PermissionDenied with the failed condition in its details.
+type ConditionError struct {
+	// Path is the path of the ACL whose condition failed.
+	Path string
+	// Condition is the condition that failed, one of ConditionCIDR, ConditionTimeWindow, or ConditionMetadata.
+	Condition string
+	// Reason describes why the condition failed.
+	Reason string
+}
+
+func (e *ConditionError) Error() string {
+	return fmt.Sprintf("%s: %s condition of acl %s not met: %s", ErrForbidden, e.Condition, e.Path, e.Reason)
+}
+
+func (e *ConditionError) Unwrap() error {
+	return ErrForbidden
+}
+
+// GRPCStatus returns the gRPC status of the error.
+func (e *ConditionError) GRPCStatus() *status.Status {
+	st := status.New(codes.PermissionDenied, e.Error())
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "ACL_CONDITION_NOT_MET",
+		Domain: "kstash",
+		Metadata: map[string]string{
+			"path":      e.Path,
+			"condition": e.Condition,
+			"reason":    e.Reason,
+		},
+	})
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// checkConditions determines if a request meets the conditions of an ACL.
+func checkConditions(acl *apiv1.ACL, req *Request) *ConditionError {
+	cond := acl.Conditions
+	if cond == nil {
+		return nil
+	}
+
+	if len(cond.Cidrs) > 0 && !inNetworks(req.ClientIP, cond.Cidrs) {
+		client := "unknown"
+		if req.ClientIP != nil {
+			client = req.ClientIP.String()
+		}
+		return &ConditionError{Path: acl.Path, Condition: ConditionCIDR, Reason: fmt.Sprintf("client address %s is not in %s", client, strings.Join(cond.Cidrs, ", "))}
+	}
+
+	if len(cond.TimeWindows) > 0 && !inTimeWindows(req.Time, cond.TimeWindows) {
+		return &ConditionError{Path: acl.Path, Condition: ConditionTimeWindow, Reason: fmt.Sprintf("%s is outside of the allowed time windows", req.Time.UTC().Format(time.RFC3339))}
+	}
+
+	for key, value := range cond.RequiredMetadata {
+		values := req.Metadata[strings.ToLower(key)]
+		if len(values) == 0 || len(values[0]) == 0 {
+			return &ConditionError{Path: acl.Path, Condition: ConditionMetadata, Reason: fmt.Sprintf("metadata %s is missing", key)}
+		}
+		if len(value) > 0 && values[0] != value {
+			return &ConditionError{Path: acl.Path, Condition: ConditionMetadata, Reason: fmt.Sprintf("metadata %s does not have the required value", key)}
+		}
+	}
+
+	return nil
+}
+
+// validateConditions validates that the conditions of an ACL are configured properly.
+func validateConditions(acl *apiv1.ACL) error {
+	cond := acl.Conditions
+	if cond == nil {
+		return nil
+	}
+
+	for _, cidr := range cond.Cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid cidr condition of acl %s: %w", acl.Path, err)
+		}
+	}
+
+	for _, window := range cond.TimeWindows {
+		if window == nil {
+			return fmt.Errorf("time window condition of acl %s cannot be nil", acl.Path)
+		}
+		start, end, _, err := parseTimeWindow(window)
+		if err != nil {
+			return fmt.Errorf("invalid time window condition of acl %s: %w", acl.Path, err)
+		}
+		if start == end {
+			return fmt.Errorf("time window condition of acl %s cannot start and end at the same time", acl.Path)
+		}
+	}
+
+	for key := range cond.RequiredMetadata {
+		if len(key) == 0 {
+			return fmt.Errorf("required metadata condition of acl %s cannot have an empty key", acl.Path)
+		}
+	}
+
+	return nil
+}
+
+func inNetworks(ip net.IP, cidrs []string) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range cidrs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func inTimeWindows(t time.Time, windows []*apiv1.TimeWindow) bool {
+	for _, window := range windows {
+		start, end, loc, err := parseTimeWindow(window)
+		if err != nil {
+			continue
+		}
+
+		local := t.In(loc)
+		minute := local.Hour()*60 + local.Minute()
+		if start < end && minute >= start && minute < end {
+			return true
+		}
+		// the window spans midnight.
+		if start > end && (minute >= start || minute < end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTimeWindow returns the start and end of a time window as minutes of the day, along with its timezone.
+func parseTimeWindow(window *apiv1.TimeWindow) (int, int, *time.Location, error) {
+	start, err := time.Parse(timeWindowLayout, window.Start)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	end, err := time.Parse(timeWindowLayout, window.End)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	loc := time.UTC
+	if len(window.Timezone) > 0 {
+		if loc, err = time.LoadLocation(window.Timezone); err != nil {
+			return 0, 0, nil, err
+		}
+	}
+
+	return start.Hour()*60 + start.Minute(), end.Hour()*60 + end.Minute(), loc, nil
+}
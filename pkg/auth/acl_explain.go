@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// Explain explains whether a token has a permission on a path, following the same rules as CanPerform. The conditions of the
+// ACLs are evaluated against the request carried by the context, so it explains the decision for a request like the current one.
+func (m *ACLManager) Explain(ctx context.Context, token *apiv1.AccessToken, perm apiv1.Permission, path string) (*apiv1.ACLExplanation, error) {
+	acls, sources, err := m.tokenACLs(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := m.matchACLs(acls, path)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &apiv1.ACLExplanation{}
+	explanation.EffectivePermissions, err = m.CalculatePermissions(acls, token.Namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	type considered struct {
+		rule    *apiv1.ACLRule
+		path    aclPath
+		condErr *ConditionError
+	}
+
+	req := RequestFromContext(ctx)
+	segments := splitPath(path)
+	rules := make([]considered, len(acls))
+	for i, acl := range acls {
+		p, err := parseACLPath(acl.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		r := considered{
+			rule: &apiv1.ACLRule{Acl: acl, Source: sources[i], Matched: p.match(segments), Applied: containsACL(applied, acl)},
+			path: p,
+		}
+		if r.condErr = checkConditions(acl, req); r.condErr != nil {
+			r.rule.ConditionFailure = fmt.Sprintf("%s: %s", r.condErr.Condition, r.condErr.Reason)
+		}
+		rules[i] = r
+	}
+
+	// the most specific ACLs that match the path are considered first.
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].rule.Matched != rules[j].rule.Matched {
+			return rules[i].rule.Matched
+		}
+		return rules[i].rule.Matched && rules[i].path.compare(rules[j].path) > 0
+	})
+
+	var granting, conditional *considered
+	for i := range rules {
+		r := &rules[i]
+		explanation.Rules = append(explanation.Rules, r.rule)
+		if !r.rule.Applied {
+			continue
+		}
+
+		switch {
+		case r.rule.Acl.Permissions[0] == apiv1.Permission_DENY:
+			if r.condErr == nil && explanation.Deny == nil {
+				explanation.Deny = r.rule
+			}
+		case !hasPermission(r.rule.Acl.Permissions, perm):
+		case r.condErr == nil:
+			if granting == nil {
+				granting = r
+			}
+		case conditional == nil:
+			conditional = r
+		}
+	}
+
+	err = m.CanPerform(ctx, acls, perm, token.Namespace, path)
+	if err != nil && !errors.Is(err, ErrForbidden) {
+		return nil, err
+	}
+	explanation.Allowed = err == nil
+
+	switch {
+	case explanation.Deny != nil:
+		explanation.MatchedRule = explanation.Deny
+		explanation.Reason = fmt.Sprintf("denied by acl %s from %s", explanation.Deny.Acl.Path, explanation.Deny.Source)
+	case granting != nil:
+		explanation.MatchedRule = granting.rule
+		explanation.Reason = fmt.Sprintf("%s is granted by acl %s from %s", perm, granting.rule.Acl.Path, granting.rule.Source)
+	case conditional != nil:
+		explanation.MatchedRule = conditional.rule
+		explanation.Reason = fmt.Sprintf("%s is granted by acl %s from %s, but its %s condition is not met: %s",
+			perm, conditional.rule.Acl.Path, conditional.rule.Source, conditional.condErr.Condition, conditional.condErr.Reason)
+	case len(applied) == 0:
+		explanation.Reason = "no acl matches the path"
+	default:
+		explanation.Reason = fmt.Sprintf("%s is not granted by the most specific acls that match the path", perm)
+	}
+
+	return explanation, nil
+}
+
+func containsACL(acls []*apiv1.ACL, acl *apiv1.ACL) bool {
+	for _, a := range acls {
+		if a == acl {
+			return true
+		}
+	}
+
+	return false
+}
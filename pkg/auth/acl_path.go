@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// segmentKind is the kind of a segment of an ACL path. Kinds are ordered by how specific they are, the least specific first.
+type segmentKind int
+
+const (
+	// segmentRest is the last segment of a path that ends with `*`. It is matched like a glob, after which any path may follow.
+	segmentRest segmentKind = iota
+	// segmentAny is a `+` or `*` segment, which matches any single segment.
+	segmentAny
+	// segmentGlob is a segment with wildcards and literal characters, e.g. `db-*`.
+	segmentGlob
+	// segmentLiteral is a segment without wildcards.
+	segmentLiteral
+	// segmentTemplate is a segment with a template that has not been interpolated. It never matches.
+	segmentTemplate
+)
+
+const globChars = `*?[\`
+
+// EnginesSegment is the first segment of the ACL paths of the secret engines, e.g. `engines/pki/ca`. KV keys cannot be stored under it,
+// and it is only matched by ACL paths that start with it literally, so ACLs such as `*` or `+/ca` for KV items never grant access to the engines.
+const EnginesSegment = "engines"
+
+// templatePattern matches the templates of ACL paths, e.g. `{{token.metadata.team}}`.
+var templatePattern = regexp.MustCompile(`\{\{\s*token\.metadata\.([^{}\s]+)\s*\}\}`)
+
+type segment struct {
+	kind  segmentKind
+	value string
+	// literals is the number of characters of a glob that are not wildcards.
+	literals int
+}
+
+// aclPath is a parsed ACL path. ACL paths are relative to the namespace of a token and consist of segments separated by `/`:
+//
+//	literal   matches the segment itself, e.g. `apps`
+//	+         matches any single segment, as does a segment of just `*`
+//	glob      matches a single segment with the syntax of path.Match, e.g. `db-*` or `team-?`
+//	template  is interpolated with the metadata of a token, e.g. `{{token.metadata.team}}`, and then matched literally
+//
+// If the path ends with `*`, its last segment is matched as a glob and anything may follow it, so `apps/*` matches all paths under `apps/`.
+// Paths under EnginesSegment are only matched by ACL paths whose first segment is the literal `engines`.
+type aclPath []segment
+
+func parseACLPath(p string) (aclPath, error) {
+	p = strings.TrimPrefix(p, "/")
+	parts := strings.Split(p, "/")
+
+	parsed := make(aclPath, len(parts))
+	for i, part := range parts {
+		seg := segment{value: part}
+
+		switch {
+		case strings.Contains(part, "{{") || strings.Contains(part, "}}"):
+			stripped := templatePattern.ReplaceAllString(part, "")
+			if strings.Contains(stripped, "{{") || strings.Contains(stripped, "}}") {
+				return nil, fmt.Errorf("unknown template in acl path, only {{token.metadata.<key>}} is supported: %s", p)
+			}
+			if _, err := path.Match(stripped, ""); err != nil {
+				return nil, fmt.Errorf("invalid wildcard in acl path: %s: %w", p, err)
+			}
+			seg.kind = segmentTemplate
+		case i == len(parts)-1 && strings.HasSuffix(part, "*"):
+			seg.kind = segmentRest
+		case part == "+" || part == "*":
+			seg.kind = segmentAny
+		case strings.ContainsAny(part, globChars):
+			seg.kind = segmentGlob
+		default:
+			seg.kind = segmentLiteral
+		}
+
+		if seg.kind == segmentRest || seg.kind == segmentGlob {
+			if _, err := path.Match(part, ""); err != nil {
+				return nil, fmt.Errorf("invalid wildcard in acl path: %s: %w", p, err)
+			}
+			seg.literals = countLiterals(part)
+		}
+
+		parsed[i] = seg
+	}
+
+	return parsed, nil
+}
+
+// match determines if the ACL path matches the segments of a path.
+func (a aclPath) match(segments []string) bool {
+	if segments[0] == EnginesSegment && a[0].kind != segmentLiteral {
+		return false
+	}
+
+	for i, seg := range a {
+		if i >= len(segments) {
+			return false
+		}
+
+		switch seg.kind {
+		case segmentRest:
+			ok, _ := path.Match(seg.value, segments[i])
+			return ok
+		case segmentAny:
+			if len(segments[i]) == 0 {
+				return false
+			}
+		case segmentGlob:
+			if ok, _ := path.Match(seg.value, segments[i]); !ok {
+				return false
+			}
+		case segmentLiteral:
+			if seg.value != segments[i] {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return len(a) == len(segments)
+}
+
+// compare compares how specific two ACL paths that match the same path are. The first segment in which they differ decides:
+// a literal segment is more specific than a glob, a glob more specific than `+`, and `+` more specific than a trailing `*`.
+// Globs with more literal characters are more specific. It returns a positive number if a is more specific than b,
+// a negative number if b is more specific, and zero if they are equally specific.
+func (a aclPath) compare(b aclPath) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i].kind != b[i].kind {
+			return int(a[i].kind) - int(b[i].kind)
+		}
+		if a[i].literals != b[i].literals {
+			return a[i].literals - b[i].literals
+		}
+		if a[i].kind == segmentRest {
+			return 0
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+// interpolate replaces the templates of an ACL path with the metadata of a token. The path cannot be interpolated if the token
+// is missing any of the metadata or if a value could be mistaken for a wildcard or a separator.
+func interpolate(p string, metadata map[string]string) (string, bool) {
+	ok := true
+	interpolated := templatePattern.ReplaceAllStringFunc(p, func(template string) string {
+		value, found := metadata[templatePattern.FindStringSubmatch(template)[1]]
+		if !found || len(value) == 0 || value == "+" || strings.ContainsAny(value, globChars+"/{}") {
+			ok = false
+		}
+		return value
+	})
+
+	return interpolated, ok
+}
+
+func splitPath(p string) []string {
+	return strings.Split(strings.TrimPrefix(p, "/"), "/")
+}
+
+// IsEnginePath determines if a path is under EnginesSegment, which is reserved for the ACL paths of the secret engines.
+func IsEnginePath(p string) bool {
+	return splitPath(p)[0] == EnginesSegment
+}
+
+func countLiterals(glob string) int {
+	n := 0
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*', '?':
+		case '[':
+			// a character class matches a single character.
+			if end := strings.IndexByte(glob[i:], ']'); end > -1 {
+				i += end
+			}
+		case '\\':
+			i++
+			n++
+		default:
+			n++
+		}
+	}
+
+	return n
+}
@@ -10,6 +10,7 @@ const (
 	TokenLength            = 20
 	authPrefix             = ".auth/"
 	authTokensPrefix       = authPrefix + "tokens/"
+	authPoliciesPrefix     = authPrefix + "policies/"
 	tokenIDPrefix          = "kt"
 	tokenReferenceIDPrefix = "kr"
 )
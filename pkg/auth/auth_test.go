@@ -2,11 +2,17 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
@@ -136,6 +142,29 @@ var _ = Describe("token", func() {
 		Expect(t).To(BeNil())
 	})
 
+	It("revokes a token even if cleaning up after it fails", func() {
+		failing := NewTokenManager(barr)
+		var cleaned []string
+		failing.OnRevoke(func(ctx context.Context, referenceID string) error {
+			return fmt.Errorf("unreachable")
+		})
+		failing.OnRevoke(func(ctx context.Context, referenceID string) error {
+			cleaned = append(cleaned, referenceID)
+			return nil
+		})
+
+		t, err := failing.NewToken()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failing.SaveToken(ctx, t)).To(Succeed())
+
+		err = failing.RevokeToken(ctx, t.Id)
+		Expect(err).To(MatchError(ContainSubstring("unreachable")))
+		Expect(cleaned).To(Equal([]string{t.ReferenceID}))
+
+		_, err = failing.GetToken(ctx, t.Id)
+		Expect(err).To(MatchError(ErrTokenNotFound))
+	})
+
 	It("can renew a token", func() {
 		token, err = tm.NewToken()
 		Expect(err).NotTo(HaveOccurred())
@@ -211,7 +240,7 @@ var _ = Describe("acls", func() {
 		"/test/kv/myfolder/f1/f2/f3/f4/f5/myitem": {apiv1.Permission_LIST, apiv1.Permission_READ, apiv1.Permission_CREATE, apiv1.Permission_UPDATE, apiv1.Permission_DELETE},
 	}
 
-	am := NewACLManager()
+	am := NewACLManager(nil)
 
 	It("allows expected permissions", func() {
 		for path, exPerms := range expected {
@@ -232,22 +261,22 @@ var _ = Describe("acls", func() {
 	})
 
 	It("correctly reports if an action can be performed", func() {
-		err := am.CanPerform(acls, apiv1.Permission_UPDATE, "", "/test/kv/folder1/item2")
+		err := am.CanPerform(context.Background(), acls, apiv1.Permission_UPDATE, "", "/test/kv/folder1/item2")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("correctly reports if an action can be performed with namespace", func() {
-		err := am.CanPerform(acls, apiv1.Permission_UPDATE, "/my/namespace", "/test/kv/folder1/item2")
+		err := am.CanPerform(context.Background(), acls, apiv1.Permission_UPDATE, "/my/namespace", "/test/kv/folder1/item2")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
 	It("fail if path matches but permission is not allowed", func() {
-		err := am.CanPerform(acls, apiv1.Permission_DELETE, "", "/test/kv/folder1/item2")
+		err := am.CanPerform(context.Background(), acls, apiv1.Permission_DELETE, "", "/test/kv/folder1/item2")
 		Expect(err).To(MatchError(ErrForbidden))
 	})
 
 	It("fail if path matches but permission is not allowed with namespace", func() {
-		err := am.CanPerform(acls, apiv1.Permission_DELETE, "/my/namespace", "/test/kv/folder1/item2")
+		err := am.CanPerform(context.Background(), acls, apiv1.Permission_DELETE, "/my/namespace", "/test/kv/folder1/item2")
 		Expect(err).To(MatchError(ErrForbidden))
 	})
 
@@ -256,13 +285,13 @@ var _ = Describe("acls", func() {
 		err := am.ValidateACLs(acls)
 		Expect(err).NotTo(HaveOccurred())
 
-		err = am.CanPerform(acls, apiv1.Permission_LIST, "", "/")
+		err = am.CanPerform(context.Background(), acls, apiv1.Permission_LIST, "", "/")
 		Expect(err).To(MatchError(ErrForbidden))
 	})
 
 	It("should fail with misused wildcard", func() {
 		acls := append(acls, &apiv1.ACL{
-			Path:        "/test/*/",
+			Path:        "/test/[/",
 			Permissions: []apiv1.Permission{apiv1.Permission_LIST},
 		})
 		err := am.ValidateACLs(acls)
@@ -311,3 +340,618 @@ var _ = Describe("acls", func() {
 		Expect(err).To(HaveOccurred())
 	})
 })
+
+var _ = Describe("acl paths", func() {
+	all := []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ, apiv1.Permission_CREATE, apiv1.Permission_UPDATE, apiv1.Permission_DELETE}
+	acls := []*apiv1.ACL{
+		{
+			Path:        "/apps/*/db-password",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+		{
+			Path:        "/apps/+/config/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+		},
+		{
+			Path:        "/apps/+/config/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+		{
+			Path:        "/apps/legacy/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_DENY},
+		},
+		{
+			Path:        "/apps/legacy/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+		},
+		{
+			Path:        "/apps/web/db-password",
+			Permissions: []apiv1.Permission{apiv1.Permission_UPDATE},
+		},
+		{
+			Path:        "/apps/web-*/db-password",
+			Permissions: []apiv1.Permission{apiv1.Permission_CREATE},
+		},
+		{
+			Path:        "/apps/web-prod*/db-password",
+			Permissions: []apiv1.Permission{apiv1.Permission_DELETE},
+		},
+		{
+			Path:        "/apps/web-prod/+",
+			Permissions: []apiv1.Permission{apiv1.Permission_DENY},
+		},
+		{
+			Path:        "/apps/web-prod/cert-?",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+		{
+			Path:        "/certs/*.pem",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+		{
+			Path:        "/certs/[a-c]*",
+			Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+		},
+		{
+			Path:        "/teams/{{token.metadata.team}}/*",
+			Permissions: all,
+		},
+		{
+			Path:        "/literal/\\*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+	}
+
+	expected := map[string][]apiv1.Permission{
+		"/":                                {},
+		"/apps/":                           {},
+		"/apps/api/db-password":            {apiv1.Permission_READ},
+		"/apps/api/db-password/":           {},
+		"/apps/api/other":                  {},
+		"/apps//db-password":               {},
+		"/apps/api/v2/db-password":         {},
+		"/apps/api/config":                 {},
+		"/apps/api/config/":                {apiv1.Permission_LIST, apiv1.Permission_READ},
+		"/apps/api/config/a/b":             {apiv1.Permission_LIST, apiv1.Permission_READ},
+		"/apps/api/config/db-password":     {apiv1.Permission_LIST, apiv1.Permission_READ},
+		"/apps/legacy/":                    {},
+		"/apps/legacy/db-password":         {},
+		"/apps/legacy/config/":             {},
+		"/apps/web/db-password":            {apiv1.Permission_UPDATE},
+		"/apps/web-dev/db-password":        {apiv1.Permission_CREATE},
+		"/apps/web-prod/db-password":       {},
+		"/apps/web-prod-eu/db-password":    {apiv1.Permission_DELETE},
+		"/apps/web-prod/cert-a":            {apiv1.Permission_READ},
+		"/apps/web-prod/cert-ab":           {},
+		"/apps/web-prod/config/":           {apiv1.Permission_LIST, apiv1.Permission_READ},
+		"/certs/":                          {},
+		"/certs/a.pem":                     {apiv1.Permission_READ},
+		"/certs/a.pem/key":                 {apiv1.Permission_LIST},
+		"/certs/bundle":                    {apiv1.Permission_LIST},
+		"/certs/d":                         {},
+		"/teams/red/item":                  {},
+		"/teams/{{token.metadata.team}}/x": {},
+		"/literal/*":                       {apiv1.Permission_READ},
+		"/literal/item":                    {},
+	}
+
+	am := NewACLManager(nil)
+
+	It("allows expected permissions", func() {
+		for path, exPerms := range expected {
+			perms, err := am.CalculatePermissions(acls, "", path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perms).NotTo(BeNil())
+			Expect(perms).To(ConsistOf(exPerms), path)
+		}
+	})
+
+	It("allows expected permissions with a namespace", func() {
+		for path, exPerms := range expected {
+			perms, err := am.CalculatePermissions(acls, "/my/namespace/", path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perms).NotTo(BeNil())
+			Expect(perms).To(ConsistOf(exPerms), path)
+		}
+	})
+
+	It("does not depend on the order of the ACLs", func() {
+		reversed := make([]*apiv1.ACL, len(acls))
+		for i, acl := range acls {
+			reversed[len(acls)-1-i] = acl
+		}
+
+		for path, exPerms := range expected {
+			perms, err := am.CalculatePermissions(reversed, "", path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perms).To(ConsistOf(exPerms), path)
+		}
+		Expect(reversed[0]).To(Equal(acls[len(acls)-1]))
+	})
+
+	It("prefers the most specific path", func() {
+		precedence := []struct {
+			specific, general, path string
+		}{
+			{"/a/b/c", "/a/b/+", "/a/b/c"},
+			{"/a/b/c", "/a/b/*", "/a/b/c"},
+			{"/a/b/c", "/a/b/c*", "/a/b/c"},
+			{"/a/b/c", "/a/+/c", "/a/b/c"},
+			{"/a/b/c", "/*", "/a/b/c"},
+			{"/a/b/+", "/a/+/c", "/a/b/c"},
+			{"/a/b/+", "/a/b/*", "/a/b/c"},
+			{"/a/b*/c", "/a/+/c", "/a/bc/c"},
+			{"/a/b?/c", "/a/*/c", "/a/bc/c"},
+			{"/a/bc*/c", "/a/b*/c", "/a/bcd/c"},
+			{"/a/b*/+", "/a/+/c", "/a/bc/c"},
+			{"/a/b/c*", "/a/b/*", "/a/b/cd/e"},
+			{"/a/+/c", "/a/*", "/a/b/c"},
+			{"/a/+/+", "/a/+/*", "/a/b/c"},
+			{"/a/*", "/*", "/a/b/c"},
+		}
+
+		for _, p := range precedence {
+			for _, deny := range []string{p.specific, p.general} {
+				acls := []*apiv1.ACL{
+					{Path: p.specific, Permissions: []apiv1.Permission{apiv1.Permission_READ}},
+					{Path: p.general, Permissions: []apiv1.Permission{apiv1.Permission_LIST}},
+				}
+				for _, acl := range acls {
+					if acl.Path == deny {
+						acl.Permissions = []apiv1.Permission{apiv1.Permission_DENY}
+					}
+				}
+
+				exPerms := []apiv1.Permission{}
+				if deny == p.general {
+					exPerms = []apiv1.Permission{apiv1.Permission_READ}
+				}
+
+				perms, err := am.CalculatePermissions(acls, "", p.path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(perms).To(ConsistOf(exPerms), p.specific+" over "+p.general)
+			}
+		}
+	})
+
+	It("only matches engine paths with ACLs that start with the engines segment", func() {
+		acls := []*apiv1.ACL{
+			{Path: "/*", Permissions: all},
+			{Path: "/+/pki/ca", Permissions: all},
+			{Path: "/eng*/pki/ca", Permissions: all},
+			{Path: "/engines/transit/*", Permissions: []apiv1.Permission{apiv1.Permission_READ}},
+		}
+
+		perms, err := am.CalculatePermissions(acls, "", "/engines/pki/ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perms).To(BeEmpty())
+
+		perms, err = am.CalculatePermissions(acls, "", "/engines/transit/key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perms).To(ConsistOf(apiv1.Permission_READ))
+
+		perms, err = am.CalculatePermissions(acls, "", "/apps/pki/ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perms).To(ConsistOf(all))
+
+		Expect(IsEnginePath("engines/pki/ca")).To(BeTrue())
+		Expect(IsEnginePath("/engines/pki/ca")).To(BeTrue())
+		Expect(IsEnginePath("apps/engines/pki")).To(BeFalse())
+	})
+
+	It("interpolates templates with the metadata of a token", func() {
+		token := &apiv1.AccessToken{
+			Metadata: map[string]string{"team": "red"},
+			Acls:     acls,
+		}
+
+		acls, err := am.TokenACLs(context.Background(), token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.Acls[12].Path).To(Equal("/teams/{{token.metadata.team}}/*"))
+
+		perms, err := am.CalculatePermissions(acls, "", "/teams/red/item")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perms).To(ConsistOf(all))
+
+		perms, err = am.CalculatePermissions(acls, "", "/teams/blue/item")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perms).To(BeEmpty())
+	})
+
+	It("skips templates that cannot be interpolated", func() {
+		for _, team := range []string{"", "+", "*", "red/blue", "red*", "r?d", "[red]", "{{token.metadata.team}}"} {
+			token := &apiv1.AccessToken{
+				Metadata: map[string]string{"team": team},
+				Acls:     acls,
+			}
+
+			interpolated, err := am.TokenACLs(context.Background(), token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(interpolated).To(HaveLen(len(acls)-1), team)
+		}
+
+		interpolated, err := am.TokenACLs(context.Background(), &apiv1.AccessToken{Acls: acls})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interpolated).To(HaveLen(len(acls) - 1))
+	})
+
+	It("validates acl paths", func() {
+		valid := []string{"/*", "/a/*/b", "/a/+/b", "/a/+", "/a/b*c/d", "/a/b?/c", "/a/[a-z]/b", "/a/\\[/b", "/a/{{token.metadata.team}}/*", "/a/x-{{ token.metadata.team }}-*"}
+		invalid := []string{"/a/[/b", "/a/[a-/*", "/a/\\", "/a/{{token.id}}/*", "/a/{{token.metadata.team}/*", "/a/{{token.metadata.team}}}}/b", "/a/{{token.metadata.team}}[/b"}
+
+		for _, p := range valid {
+			err := am.ValidateACLs([]*apiv1.ACL{{Path: p, Permissions: []apiv1.Permission{apiv1.Permission_READ}}})
+			Expect(err).NotTo(HaveOccurred(), p)
+		}
+
+		for _, p := range invalid {
+			err := am.ValidateACLs([]*apiv1.ACL{{Path: p, Permissions: []apiv1.Permission{apiv1.Permission_READ}}})
+			Expect(err).To(HaveOccurred(), p)
+		}
+	})
+})
+
+var _ = Describe("acl conditions", func() {
+	am := NewACLManager(nil)
+	noon := time.Date(2021, 9, 6, 12, 0, 0, 0, time.UTC)
+
+	acls := []*apiv1.ACL{
+		{
+			Path:        "/office/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+			Conditions:  &apiv1.ACLConditions{Cidrs: []string{"10.0.0.0/8", "fd00::/8"}},
+		},
+		{
+			Path:        "/hours/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+			Conditions: &apiv1.ACLConditions{TimeWindows: []*apiv1.TimeWindow{
+				{Start: "09:00", End: "17:00"},
+				{Start: "22:00", End: "02:00"},
+			}},
+		},
+		{
+			Path:        "/prod/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_UPDATE},
+			Conditions:  &apiv1.ACLConditions{RequiredMetadata: map[string]string{"X-Change-Ticket": "", "x-env": "prod"}},
+		},
+		{
+			Path:        "/prod/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+		{
+			Path:        "/shared/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+			Conditions:  &apiv1.ACLConditions{Cidrs: []string{"10.0.0.0/8"}},
+		},
+		{
+			Path:        "/shared/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+			Conditions:  &apiv1.ACLConditions{Cidrs: []string{"192.168.0.0/16"}},
+		},
+		{
+			Path:        "/guarded/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+		},
+		{
+			Path:        "/guarded/*",
+			Permissions: []apiv1.Permission{apiv1.Permission_DENY},
+			Conditions:  &apiv1.ACLConditions{Cidrs: []string{"0.0.0.0/0"}},
+		},
+		{
+			Path:        "/guarded/open",
+			Permissions: []apiv1.Permission{apiv1.Permission_READ},
+			Conditions:  &apiv1.ACLConditions{Cidrs: []string{"10.0.0.0/8"}},
+		},
+	}
+
+	type request struct {
+		path    string
+		perm    apiv1.Permission
+		req     *Request
+		failed  string
+		allowed bool
+	}
+
+	at := func(ip string, t time.Time, md map[string][]string) *Request {
+		return &Request{ClientIP: net.ParseIP(ip), Time: t, Metadata: md}
+	}
+
+	requests := []request{
+		{path: "office/a", perm: apiv1.Permission_READ, req: at("10.1.2.3", noon, nil), allowed: true},
+		{path: "office/a", perm: apiv1.Permission_READ, req: at("fd00::1", noon, nil), allowed: true},
+		{path: "office/a", perm: apiv1.Permission_READ, req: at("11.1.2.3", noon, nil), failed: ConditionCIDR},
+		{path: "office/a", perm: apiv1.Permission_READ, req: at("", noon, nil), failed: ConditionCIDR},
+		{path: "office/a", perm: apiv1.Permission_LIST, req: at("10.1.2.3", noon, nil)},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon, nil), allowed: true},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.Add(-3*time.Hour), nil), allowed: true},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.Add(-3*time.Hour-time.Minute), nil), failed: ConditionTimeWindow},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.Add(5*time.Hour), nil), failed: ConditionTimeWindow},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.Add(10*time.Hour), nil), allowed: true},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.Add(13*time.Hour), nil), allowed: true},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.Add(14*time.Hour), nil), failed: ConditionTimeWindow},
+		{path: "hours/a", perm: apiv1.Permission_READ, req: at("", noon.In(time.FixedZone("UTC+10", 10*60*60)), nil), allowed: true},
+		{path: "prod/a", perm: apiv1.Permission_UPDATE, req: at("", noon, map[string][]string{"x-change-ticket": {"CHG-1"}, "x-env": {"prod"}}), allowed: true},
+		{path: "prod/a", perm: apiv1.Permission_UPDATE, req: at("", noon, map[string][]string{"x-env": {"prod"}}), failed: ConditionMetadata},
+		{path: "prod/a", perm: apiv1.Permission_UPDATE, req: at("", noon, map[string][]string{"x-change-ticket": {""}, "x-env": {"prod"}}), failed: ConditionMetadata},
+		{path: "prod/a", perm: apiv1.Permission_UPDATE, req: at("", noon, map[string][]string{"x-change-ticket": {"CHG-1"}, "x-env": {"dev"}}), failed: ConditionMetadata},
+		{path: "prod/a", perm: apiv1.Permission_READ, req: at("", noon, nil), allowed: true},
+		{path: "shared/a", perm: apiv1.Permission_READ, req: at("10.0.0.1", noon, nil), allowed: true},
+		{path: "shared/a", perm: apiv1.Permission_READ, req: at("192.168.0.1", noon, nil), allowed: true},
+		{path: "shared/a", perm: apiv1.Permission_READ, req: at("172.16.0.1", noon, nil), failed: ConditionCIDR},
+		{path: "guarded/a", perm: apiv1.Permission_READ, req: at("10.0.0.1", noon, nil)},
+		{path: "guarded/a", perm: apiv1.Permission_READ, req: at("", noon, nil), allowed: true},
+		{path: "guarded/open", perm: apiv1.Permission_READ, req: at("10.0.0.1", noon, nil), allowed: true},
+		{path: "guarded/open", perm: apiv1.Permission_READ, req: at("11.0.0.1", noon, nil), failed: ConditionCIDR},
+	}
+
+	It("evaluates the conditions of ACLs with the request", func() {
+		for _, r := range requests {
+			description := fmt.Sprintf("%s %s from %v at %s", r.perm, r.path, r.req.ClientIP, r.req.Time)
+			err := am.CanPerform(NewRequestContext(context.Background(), r.req), acls, r.perm, "test", r.path)
+			if r.allowed {
+				Expect(err).NotTo(HaveOccurred(), description)
+				continue
+			}
+
+			Expect(err).To(MatchError(ErrForbidden), description)
+
+			var condErr *ConditionError
+			if len(r.failed) == 0 {
+				Expect(errors.As(err, &condErr)).To(BeFalse(), description)
+				continue
+			}
+			Expect(errors.As(err, &condErr)).To(BeTrue(), description)
+			Expect(condErr.Condition).To(Equal(r.failed), description)
+		}
+	})
+
+	It("does not meet conditions on the client without a request", func() {
+		err := am.CanPerform(context.Background(), acls, apiv1.Permission_READ, "test", "office/a")
+		Expect(err).To(MatchError(ErrForbidden))
+
+		perms, err := am.CalculatePermissions(acls, "test", "office/a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perms).To(ConsistOf(apiv1.Permission_READ))
+	})
+
+	It("reports the failed condition in the details of the gRPC status", func() {
+		err := am.CanPerform(NewRequestContext(context.Background(), at("11.1.2.3", noon, nil)), acls, apiv1.Permission_READ, "test", "office/a")
+
+		st, ok := status.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(st.Code()).To(Equal(codes.PermissionDenied))
+		Expect(st.Details()).To(HaveLen(1))
+
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		Expect(ok).To(BeTrue())
+		Expect(info.Reason).To(Equal("ACL_CONDITION_NOT_MET"))
+		Expect(info.Metadata).To(HaveKeyWithValue("condition", ConditionCIDR))
+		Expect(info.Metadata).To(HaveKeyWithValue("path", "/office/*"))
+	})
+
+	It("validates conditions", func() {
+		invalid := []*apiv1.ACLConditions{
+			{Cidrs: []string{"10.0.0.1"}},
+			{Cidrs: []string{"10.0.0.0/33"}},
+			{TimeWindows: []*apiv1.TimeWindow{nil}},
+			{TimeWindows: []*apiv1.TimeWindow{{Start: "9", End: "17:00"}}},
+			{TimeWindows: []*apiv1.TimeWindow{{Start: "09:00", End: "25:00"}}},
+			{TimeWindows: []*apiv1.TimeWindow{{Start: "09:00", End: "09:00"}}},
+			{TimeWindows: []*apiv1.TimeWindow{{Start: "09:00", End: "17:00", Timezone: "Nowhere/Invalid"}}},
+			{RequiredMetadata: map[string]string{"": "value"}},
+		}
+
+		for _, cond := range invalid {
+			err := am.ValidateACLs([]*apiv1.ACL{{Path: "/*", Permissions: []apiv1.Permission{apiv1.Permission_READ}, Conditions: cond}})
+			Expect(err).To(HaveOccurred(), cond.String())
+		}
+
+		Expect(am.ValidateACLs(acls)).To(Succeed())
+	})
+})
+
+var _ = Describe("acl explain", func() {
+	ctx := context.Background()
+	am := NewACLManager(nil)
+
+	token := &apiv1.AccessToken{
+		Namespace: "test",
+		Acls: []*apiv1.ACL{
+			{Path: "/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST}},
+			{Path: "apps/+/db-password", Permissions: []apiv1.Permission{apiv1.Permission_READ}},
+			{Path: "apps/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ}},
+			{Path: "apps/legacy/db-password", Permissions: []apiv1.Permission{apiv1.Permission_DENY}},
+			{
+				Path:        "apps/web/db-password",
+				Permissions: []apiv1.Permission{apiv1.Permission_UPDATE},
+				Conditions:  &apiv1.ACLConditions{Cidrs: []string{"10.0.0.0/8"}},
+			},
+		},
+	}
+
+	paths := func(rules []*apiv1.ACLRule) []string {
+		var paths []string
+		for _, rule := range rules {
+			paths = append(paths, rule.Acl.Path)
+		}
+		return paths
+	}
+
+	It("explains the rule that grants a permission", func() {
+		e, err := am.Explain(ctx, token, apiv1.Permission_READ, "apps/api/db-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeTrue())
+		Expect(e.MatchedRule.Acl.Path).To(Equal("apps/+/db-password"))
+		Expect(e.MatchedRule.Source).To(Equal("token"))
+		Expect(e.Deny).To(BeNil())
+		Expect(e.EffectivePermissions).To(ConsistOf(apiv1.Permission_READ))
+		Expect(e.Reason).To(ContainSubstring("apps/+/db-password"))
+
+		// the most specific matching rules come first, followed by the others in the order of the token.
+		Expect(paths(e.Rules)).To(Equal([]string{"apps/+/db-password", "apps/*", "/*", "apps/legacy/db-password", "apps/web/db-password"}))
+		Expect(e.Rules[0].Applied).To(BeTrue())
+		Expect(e.Rules[1].Matched).To(BeTrue())
+		Expect(e.Rules[1].Applied).To(BeFalse())
+		Expect(e.Rules[3].Matched).To(BeFalse())
+	})
+
+	It("explains that the most specific rules do not grant a permission", func() {
+		e, err := am.Explain(ctx, token, apiv1.Permission_LIST, "apps/api/db-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeFalse())
+		Expect(e.MatchedRule).To(BeNil())
+		Expect(e.Reason).To(ContainSubstring("not granted by the most specific acls"))
+	})
+
+	It("explains a DENY", func() {
+		e, err := am.Explain(ctx, token, apiv1.Permission_READ, "apps/legacy/db-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeFalse())
+		Expect(e.Deny.Acl.Path).To(Equal("apps/legacy/db-password"))
+		Expect(e.MatchedRule).To(Equal(e.Deny))
+		Expect(e.EffectivePermissions).To(BeEmpty())
+	})
+
+	It("explains conditions that are not met", func() {
+		e, err := am.Explain(ctx, token, apiv1.Permission_UPDATE, "apps/web/db-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeFalse())
+		Expect(e.MatchedRule.Acl.Path).To(Equal("apps/web/db-password"))
+		Expect(e.MatchedRule.ConditionFailure).To(HavePrefix(ConditionCIDR))
+		Expect(e.Reason).To(ContainSubstring("condition is not met"))
+		Expect(e.EffectivePermissions).To(ConsistOf(apiv1.Permission_UPDATE))
+
+		reqCtx := NewRequestContext(ctx, &Request{ClientIP: net.ParseIP("10.0.0.1"), Time: time.Now()})
+		e, err = am.Explain(reqCtx, token, apiv1.Permission_UPDATE, "apps/web/db-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeTrue())
+		Expect(e.MatchedRule.ConditionFailure).To(BeEmpty())
+	})
+
+	It("explains that no rule matches", func() {
+		e, err := am.Explain(ctx, &apiv1.AccessToken{Namespace: "test"}, apiv1.Permission_READ, "apps/api/db-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeFalse())
+		Expect(e.Rules).To(BeEmpty())
+		Expect(e.Reason).To(Equal("no acl matches the path"))
+	})
+})
+
+var _ = Describe("policy", func() {
+	ctx := context.Background()
+
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	barr, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Initialize(ctx, gatekeeperKey, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Unseal(ctx, gatekeeperKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	pm := NewPolicyManager(barr)
+	am := NewACLManager(pm)
+
+	It("stores policies", func() {
+		err := pm.PutPolicy(ctx, &apiv1.Policy{
+			Name: "payments",
+			Acls: []*apiv1.ACL{
+				{Path: "payments/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		policy, err := pm.GetPolicy(ctx, "payments")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Acls).To(HaveLen(1))
+
+		names, err := pm.ListPolicies(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(Equal([]string{"payments"}))
+	})
+
+	It("rejects invalid policies", func() {
+		for _, name := range []string{"", "a/b", "a b"} {
+			err := pm.PutPolicy(ctx, &apiv1.Policy{Name: name, Acls: []*apiv1.ACL{{Path: "/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST}}}})
+			Expect(err).To(MatchError(ErrInvalidPolicyName), name)
+		}
+
+		err := pm.PutPolicy(ctx, &apiv1.Policy{Name: "empty"})
+		Expect(err).To(HaveOccurred())
+
+		err = pm.PutPolicy(ctx, &apiv1.Policy{Name: "wildcard", Acls: []*apiv1.ACL{{Path: "/[/a", Permissions: []apiv1.Permission{apiv1.Permission_LIST}}}})
+		Expect(err).To(HaveOccurred())
+
+		_, err = pm.GetPolicy(ctx, "missing")
+		Expect(err).To(MatchError(ErrPolicyNotFound))
+	})
+
+	It("evaluates the ACLs of a token along with its policies", func() {
+		token := &apiv1.AccessToken{
+			Namespace: "test",
+			Acls: []*apiv1.ACL{
+				{Path: "payments/private/*", Permissions: []apiv1.Permission{apiv1.Permission_DENY}},
+			},
+			Policies: []string{"payments", "missing"},
+		}
+
+		acls, err := am.TokenACLs(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acls).To(HaveLen(2))
+		Expect(token.Acls).To(HaveLen(1))
+
+		Expect(am.CanPerform(ctx, acls, apiv1.Permission_READ, token.Namespace, "payments/db")).To(Succeed())
+		Expect(am.CanPerform(ctx, acls, apiv1.Permission_READ, token.Namespace, "payments/private/db")).To(MatchError(ErrForbidden))
+
+		e, err := am.Explain(ctx, token, apiv1.Permission_READ, "payments/db")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Allowed).To(BeTrue())
+		Expect(e.MatchedRule.Source).To(Equal("policy/payments"))
+
+		e, err = am.Explain(ctx, token, apiv1.Permission_READ, "payments/private/db")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Deny.Source).To(Equal("token"))
+
+		// changes to a policy apply to the next request.
+		err = pm.PutPolicy(ctx, &apiv1.Policy{
+			Name: "payments",
+			Acls: []*apiv1.ACL{
+				{Path: "payments/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		acls, err = am.TokenACLs(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(am.CanPerform(ctx, acls, apiv1.Permission_READ, token.Namespace, "payments/db")).To(MatchError(ErrForbidden))
+
+		// the most specific ACL applies, even if a policy denies a less specific path.
+		err = pm.PutPolicy(ctx, &apiv1.Policy{
+			Name: "payments",
+			Acls: []*apiv1.ACL{
+				{Path: "payments/*", Permissions: []apiv1.Permission{apiv1.Permission_DENY}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		token.Acls = append(token.Acls, &apiv1.ACL{Path: "payments/db", Permissions: []apiv1.Permission{apiv1.Permission_READ}})
+		acls, err = am.TokenACLs(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(am.CanPerform(ctx, acls, apiv1.Permission_READ, token.Namespace, "payments/db")).To(Succeed())
+		Expect(am.CanPerform(ctx, acls, apiv1.Permission_READ, token.Namespace, "payments/other")).To(MatchError(ErrForbidden))
+		token.Acls = token.Acls[:1]
+
+		Expect(pm.DeletePolicy(ctx, "payments")).To(Succeed())
+		Expect(pm.DeletePolicy(ctx, "payments")).To(MatchError(ErrPolicyNotFound))
+
+		acls, err = am.TokenACLs(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(am.CanPerform(ctx, acls, apiv1.Permission_LIST, token.Namespace, "payments/db")).To(MatchError(ErrForbidden))
+	})
+})
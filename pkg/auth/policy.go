@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrPolicyNotFound    = fmt.Errorf("policy not found")
+	ErrInvalidPolicyName = fmt.Errorf("invalid policy name")
+)
+
+// PolicyManager stores named policies, which access tokens reference instead of embedding a copy of their ACLs.
+type PolicyManager struct {
+	b  *barrier.Barrier
+	am *ACLManager
+}
+
+// NewPolicyManager returns a new PolicyManager.
+func NewPolicyManager(b *barrier.Barrier) *PolicyManager {
+	return &PolicyManager{b: b, am: NewACLManager(nil)}
+}
+
+// PutPolicy creates or replaces a policy. The change applies to every token that references the policy from its next request on.
+func (p *PolicyManager) PutPolicy(ctx context.Context, policy *apiv1.Policy) error {
+	if policy == nil {
+		return fmt.Errorf("policy cannot be nil")
+	}
+
+	if err := validatePolicyName(policy.Name); err != nil {
+		return err
+	}
+
+	if len(policy.Acls) == 0 {
+		return fmt.Errorf("policy has no acls: %s", policy.Name)
+	}
+
+	if err := p.am.ValidateACLs(policy.Acls); err != nil {
+		return err
+	}
+
+	bs, err := proto.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	return p.b.Put(ctx, &apiv1.Item{Key: authPoliciesPrefix + policy.Name, Raw: bs})
+}
+
+// GetPolicy gets a policy by its name.
+func (p *PolicyManager) GetPolicy(ctx context.Context, name string) (*apiv1.Policy, error) {
+	if err := validatePolicyName(name); err != nil {
+		return nil, err
+	}
+
+	item, err := p.b.Get(ctx, authPoliciesPrefix+name)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrPolicyNotFound, name)
+		}
+		return nil, err
+	}
+
+	policy := &apiv1.Policy{}
+	if err := proto.Unmarshal(item.Raw, policy); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal policy: %s: %w", name, err)
+	}
+
+	return policy, nil
+}
+
+// ListPolicies lists the names of all policies.
+func (p *PolicyManager) ListPolicies(ctx context.Context) ([]string, error) {
+	return p.b.List(ctx, authPoliciesPrefix)
+}
+
+// DeletePolicy deletes a policy. Tokens that reference it keep their other ACLs.
+func (p *PolicyManager) DeletePolicy(ctx context.Context, name string) error {
+	if _, err := p.GetPolicy(ctx, name); err != nil {
+		return err
+	}
+
+	return p.b.Delete(ctx, authPoliciesPrefix+name)
+}
+
+func validatePolicyName(name string) error {
+	if len(name) == 0 || strings.Contains(name, "/") || strings.IndexFunc(name, func(r rune) bool {
+		return !unicode.IsPrint(r) || unicode.IsSpace(r)
+	}) > -1 {
+		return fmt.Errorf("%w: %q", ErrInvalidPolicyName, name)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
@@ -13,7 +14,8 @@ import (
 
 // TokenManager object.
 type TokenManager struct {
-	b *barrier.Barrier
+	b        *barrier.Barrier
+	onRevoke []func(ctx context.Context, referenceID string) error
 }
 
 // NewTokenManager returns a new TokenManager.
@@ -21,6 +23,12 @@ func NewTokenManager(b *barrier.Barrier) *TokenManager {
 	return &TokenManager{b: b}
 }
 
+// OnRevoke registers a function that is called with the reference ID of a token after the token is revoked, to clean up after it.
+// The token stays revoked if the function returns an error, so the function must retry failed cleanups on its own.
+func (t *TokenManager) OnRevoke(fn func(ctx context.Context, referenceID string) error) {
+	t.onRevoke = append(t.onRevoke, fn)
+}
+
 // NewToken creates and initializes a token's fields and generates IDs for it.
 func (t *TokenManager) NewToken() (*apiv1.AccessToken, error) {
 	bs, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
@@ -124,13 +132,26 @@ func (t *TokenManager) RevokeToken(ctx context.Context, id string) error {
 	return t.RevokeTokenByReferenceID(ctx, refID)
 }
 
-// RevokeTokenByReferenceID immediately deletes a token by its reference ID.
+// RevokeTokenByReferenceID immediately deletes a token by its reference ID. An error of the functions registered with OnRevoke
+// is returned after the token has been deleted.
 func (t *TokenManager) RevokeTokenByReferenceID(ctx context.Context, referenceID string) error {
 	if len(referenceID) != TokenLength {
 		return ErrTokenInvalid
 	}
 
-	return t.b.Delete(ctx, authTokensPrefix+referenceID)
+	// the token is deleted first, so a failed cleanup never keeps a token valid.
+	if err := t.b.Delete(ctx, authTokensPrefix+referenceID); err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, fn := range t.onRevoke {
+		if err := fn(ctx, referenceID); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("token %s was revoked, but cleaning up after it failed: %w", referenceID, err)
+		}
+	}
+
+	return firstErr
 }
 
 // RenewToken renews a token as long as it is still valid.
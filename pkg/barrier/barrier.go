@@ -3,13 +3,17 @@ package barrier
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
 	"github.com/slaskawi/vault-poc/pkg/barrier/keychain"
+	"github.com/slaskawi/vault-poc/pkg/barrier/securemem"
 	"github.com/slaskawi/vault-poc/pkg/storage"
 	"google.golang.org/protobuf/proto"
 )
@@ -20,6 +24,23 @@ const (
 	keychainKey = "keychain"
 	idKey       = "id"
 	idLength    = 18
+
+	// itemVersion is the encryption format of items written by the barrier. Version 1 binds the storage key and encryption key ID as associated data.
+	// Version 2 encrypts items in namespaces with derived namespace keys.
+	itemVersion = namespaceKeysVersion
+
+	// KeychainUpdatesPath holds a record for each encryption key added by a rotation. Each record is encrypted with the keychain's sync key,
+	// which allows other unsealed replicas to add new keys to their keychains without needing the gatekeeper key. The sync key is only stored
+	// in the keychain, so unlike encryption keys, it cannot be leaked through the items it encrypts.
+	KeychainUpdatesPath = barrierPath + "keychain-updates/"
+
+	// Path holds the items owned by the barrier, including its ID at IDPath and its encrypted keychain at KeychainPath.
+	Path         = barrierPath
+	IDPath       = barrierPath + idKey
+	KeychainPath = barrierPath + keychainKey
+
+	// keychainPollInterval is how often keychain updates are checked when the storage backend cannot watch keys.
+	keychainPollInterval = 30 * time.Second
 )
 
 var (
@@ -30,6 +51,9 @@ var (
 	ErrBarrierInvalidKey         = fmt.Errorf("unseal failed due to invalid key")
 	ErrDisallowedPath            = fmt.Errorf("key path is not allowed")
 	ErrMixRawMapValues           = fmt.Errorf("cannot mix raw and map values")
+	ErrItemKeyMismatch           = fmt.Errorf("item was not encrypted for its storage key")
+
+	errNoSyncKey = fmt.Errorf("keychain has no sync key")
 )
 
 var disallowedPaths = map[string]struct{}{
@@ -39,20 +63,76 @@ var disallowedPaths = map[string]struct{}{
 
 // Barrier object.
 type Barrier struct {
-	store    storage.Storage
-	keychain *keychain.Keychain
-	mu       sync.RWMutex
+	store        storage.Storage
+	keychain     *keychain.Keychain
+	cipherType   apiv1.CipherType
+	envelope     bool
+	stopWatching context.CancelFunc
+	mu           sync.RWMutex
+
+	// settings of items written by Put
+	chunkSize           int
+	compression         bool
+	sizeLimit           int
+	namespaceSizeLimits map[string]int
+
+	// id caches the barrier's ID
+	id   []byte
+	idMu sync.Mutex
+
+	// unlocked holds the unwrapped secrets of namespaces with tenant wrapping keys that have been unlocked
+	unlocked map[string]*securemem.Buffer
+	nsMu     sync.RWMutex
+
+	// chunksMu guards the replacement of chunked items, since not every storage backend locks keys
+	chunksMu sync.Mutex
 }
 
 // NewBarrier returns a new Barrier object.
 func NewBarrier(store storage.Storage) (*Barrier, error) {
 	b := &Barrier{
-		store: store,
+		store:     store,
+		chunkSize: DefaultChunkSize,
 	}
 
 	return b, nil
 }
 
+// SetCipherType sets the cipher used by encryption keys that are generated when the barrier is initialized or its encryption key is rotated.
+// Items that were encrypted by older keys are still decrypted with the cipher of their key.
+func (b *Barrier) SetCipherType(cipherType apiv1.CipherType) error {
+	if _, ok := apiv1.CipherType_name[int32(cipherType)]; !ok {
+		return fmt.Errorf("unknown cipherType: %s", cipherType.String())
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.cipherType = cipherType
+	if b.keychain != nil {
+		return b.keychain.SetCipherType(cipherType)
+	}
+
+	return nil
+}
+
+// SetEnvelopeEncryption enables or disables envelope encryption of items written by the barrier. With envelope encryption, every item
+// is encrypted by its own randomly generated data key, which is stored with the item encrypted by the active encryption key.
+// Items are decrypted regardless of the mode they were written with.
+func (b *Barrier) SetEnvelopeEncryption(enabled bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.envelope = enabled
+}
+
+func (b *Barrier) envelopeEncryption() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.envelope
+}
+
 // IsInitialized determines if the secret store has been initialized.
 func (b *Barrier) IsInitialized(ctx context.Context) (bool, error) {
 	b.mu.RLock()
@@ -104,9 +184,15 @@ func (b *Barrier) Initialize(ctx context.Context, gatekeeperKey []byte, extraIni
 
 	// create new keychain
 	b.keychain = keychain.NewKeychain()
+	if err := b.keychain.SetCipherType(b.cipherType); err != nil {
+		return err
+	}
 	if err := b.keychain.Rotate(); err != nil {
 		return fmt.Errorf("failed to create keychain: %w", err)
 	}
+	if err := b.keychain.GenerateSyncKey(); err != nil {
+		return fmt.Errorf("failed to create keychain: %w", err)
+	}
 
 	// perform any additional initialization steps
 	if extraInit != nil {
@@ -122,6 +208,7 @@ func (b *Barrier) Initialize(ctx context.Context, gatekeeperKey []byte, extraIni
 	err = b.persistKeychain(ctx, gatekeeperKey)
 
 	// seal immediately after initializing
+	b.keychain.Destroy()
 	b.keychain = nil
 	return err
 }
@@ -155,8 +242,13 @@ func (b *Barrier) IsSealed(ctx context.Context) (bool, error) {
 
 // ValidateGatekeeperKey validates that the given gatekeeper key is valid.
 func (b *Barrier) ValidateGatekeeperKey(ctx context.Context, gatekeeperKey []byte) error {
-	_, err := b.retrieveKeychain(ctx, gatekeeperKey)
-	return err
+	kc, err := b.retrieveKeychain(ctx, gatekeeperKey)
+	if err != nil {
+		return err
+	}
+
+	kc.Destroy()
+	return nil
 }
 
 // Unseal uses the given gatekeeper key to decrypt the Keychain. If the key is invalid, the unseal operation will fail.
@@ -173,23 +265,46 @@ func (b *Barrier) Unseal(ctx context.Context, gatekeeperKey []byte) error {
 	if err != nil {
 		return err
 	}
+	if kc.SyncKey() == nil {
+		kc.Destroy()
+		if kc, err = b.upgradeKeychain(ctx, gatekeeperKey); err != nil {
+			return err
+		}
+	}
 
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if err := kc.SetCipherType(b.cipherType); err != nil {
+		kc.Destroy()
+		return err
+	}
+
 	b.keychain = kc
+	b.watchKeychain()
 	return nil
 }
 
 // Seal will drop the current Keychain from memory, requiring an Unseal prior to any other operations.
+// The keys are zeroed and every cached AEAD is flushed, so no key material remains in memory.
 func (b *Barrier) Seal() {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.keychain != nil {
+		b.keychain.Destroy()
+	}
 	b.keychain = nil
+	encryption.FlushCache()
+	b.lockAllNamespaces()
+	if b.stopWatching != nil {
+		b.stopWatching()
+		b.stopWatching = nil
+	}
 }
 
 // ChangeGatekeeperKey re-encrypts the current Keychain with the given gatekeeper key.
+// Keys added by other replicas are merged into the Keychain first, so they are not dropped from the stored Keychain.
 func (b *Barrier) ChangeGatekeeperKey(ctx context.Context, gatekeeperKey []byte) error {
 	sealed, err := b.IsSealed(ctx)
 	if err != nil {
@@ -199,9 +314,19 @@ func (b *Barrier) ChangeGatekeeperKey(ctx context.Context, gatekeeperKey []byte)
 		return ErrBarrierSealed
 	}
 
+	mu, err := b.lockKeychain(ctx)
+	if err != nil {
+		return err
+	}
+	defer mu.Unlock()
+
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if _, err := b.reloadKeychain(ctx); err != nil {
+		return err
+	}
+
 	return b.persistKeychain(ctx, gatekeeperKey)
 }
 
@@ -215,56 +340,241 @@ func (b *Barrier) RotateEncryptionKey(ctx context.Context, gatekeeperKey []byte)
 		return ErrBarrierSealed
 	}
 
+	mu, err := b.lockKeychain(ctx)
+	if err != nil {
+		return err
+	}
+	defer mu.Unlock()
+
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	// another replica may have rotated the key since this one was unsealed, so start from the stored keychain to avoid overwriting its keys
+	stored, err := b.retrieveKeychain(ctx, gatekeeperKey)
+	if err != nil {
+		return err
+	}
+	defer stored.Destroy()
+
+	if _, err := b.keychain.Merge(stored.Keys()); err != nil {
+		return err
+	}
+
 	if err := b.keychain.Rotate(); err != nil {
 		return err
 	}
 
-	return b.persistKeychain(ctx, gatekeeperKey)
+	if err := b.persistKeychain(ctx, gatekeeperKey); err != nil {
+		return err
+	}
+
+	return b.publishKey(ctx, b.keychain.ActiveKey())
+}
+
+// ReloadKeychain adds encryption keys that were added by rotations on other replicas sharing the same storage backend to the keychain.
+// The new keys are merged atomically, so concurrent encryptions either use the previous or the new active key.
+// Returns the number of keys that were added.
+func (b *Barrier) ReloadKeychain(ctx context.Context) (int, error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if sealed {
+		return 0, ErrBarrierSealed
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.reloadKeychain(ctx)
+}
+
+// reloadKeychain must be called while holding the write lock.
+func (b *Barrier) reloadKeychain(ctx context.Context) (int, error) {
+	if b.keychain == nil {
+		return 0, ErrBarrierSealed
+	}
+
+	syncKey := b.keychain.SyncKey()
+	if syncKey == nil {
+		return 0, errNoSyncKey
+	}
+
+	names, err := b.store.List(ctx, KeychainUpdatesPath)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := []*apiv1.EncryptionKey{}
+	for _, name := range names {
+		id, err := strconv.ParseUint(name, 10, 32)
+		if err != nil || b.keychain.Key(uint32(id)) != nil {
+			continue
+		}
+
+		bitem, err := b.store.Get(ctx, KeychainUpdatesPath+name)
+		if err != nil {
+			if storage.IsErrNotFound(err) {
+				continue
+			}
+			return 0, err
+		}
+
+		data, err := encryption.DecryptWithAD(apiv1.CipherType_AES256_GCM, syncKey, bitem.Val, []byte(bitem.Key))
+		if err != nil {
+			return 0, fmt.Errorf("unable to reload keychain: %w", err)
+		}
+
+		key := &apiv1.EncryptionKey{}
+		err = proto.Unmarshal(data, key)
+		securemem.Wipe(data)
+		if err != nil {
+			return 0, fmt.Errorf("unable to reload keychain: %w", err)
+		}
+		defer securemem.Wipe(key.Key)
+
+		keys = append(keys, key)
+	}
+
+	return b.keychain.Merge(keys)
 }
 
 // EncryptItem encrypts the given Item using the barrier's active encryption key. This is useful for encrypting/decrypting information that doesn't need to be written to storage.
-func (b *Barrier) EncryptItem(item *apiv1.Item) (*apiv1.BackendItem, error) {
+// If envelope encryption is enabled, the Item is encrypted by a new data key, which is encrypted by the active encryption key instead.
+// Items in namespaces are encrypted by the namespace's key derived from the active encryption key. The Item is never split into chunks.
+func (b *Barrier) EncryptItem(ctx context.Context, item *apiv1.Item) (*apiv1.BackendItem, error) {
+	bitem, _, err := b.encryptItem(ctx, item, 0)
+	return bitem, err
+}
+
+// encryptItem encrypts the given Item and splits its encrypted value into chunks if it is larger than chunkSize. Zero disables chunking.
+func (b *Barrier) encryptItem(ctx context.Context, item *apiv1.Item, chunkSize int) (*apiv1.BackendItem, []*apiv1.BackendItem, error) {
 	bs, err := proto.Marshal(item)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal item: %s: %w", item.Key, err)
+		return nil, nil, fmt.Errorf("unable to marshal item: %s: %w", item.Key, err)
 	}
 
-	encKey := b.keychain.ActiveKey()
-	encrypted, err := encryption.Encrypt(encKey.Type, encKey.Key, bs)
+	item.Key = storagePath(item.Key)
+
+	kc, release, err := b.useKeychain()
 	if err != nil {
-		return nil, fmt.Errorf("unable to encrypt item: %s: %w", item.Key, err)
+		return nil, nil, err
 	}
+	defer release()
 
-	if !strings.HasPrefix(item.Key, secretsPath) {
-		item.Key = getSecretPath(item.Key)
+	activeKey := kc.ActiveKey()
+	encKey, destroy, err := b.itemKey(ctx, activeKey, item.Key, itemVersion, true)
+	if err != nil {
+		return nil, nil, err
 	}
+	defer destroy()
 
 	bitem := &apiv1.BackendItem{
 		Key:             item.Key,
 		EncryptionKeyID: encKey.Id,
-		Val:             encrypted,
+		Version:         itemVersion,
+		ExpiresAt:       item.ExpiresAt,
+	}
+
+	if _, compression := b.itemSettings(); compression {
+		bs, bitem.Compression, err = compress(bs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to compress item: %s: %w", item.Key, err)
+		}
+	}
+
+	payloadKey, payloadKeyID := encKey, encKey.Id
+	if b.envelopeEncryption() {
+		dataKeyBS, err := encryption.GenerateKey(encKey.Type)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to generate data key: %s: %w", item.Key, err)
+		}
+		dataKey := &apiv1.EncryptionKey{Type: encKey.Type, Key: dataKeyBS}
+
+		bitem.DataKey, err = wrapDataKey(encKey, item.Key, dataKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to encrypt data key: %s: %w", item.Key, err)
+		}
+
+		// the data key outlives the encryption key it is wrapped by, so the value is only bound to its storage key
+		payloadKey, payloadKeyID = dataKey, 0
+	}
+
+	if chunkSize <= 0 || len(bs) <= chunkSize {
+		bitem.Val, err = encryption.EncryptWithAD(payloadKey.Type, payloadKey.Key, bs, payloadAD(bitem, payloadKeyID))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to encrypt item: %s: %w", item.Key, err)
+		}
+
+		return bitem, nil, nil
+	}
+
+	chunks, err := encryptChunks(bitem, payloadKey, payloadKeyID, bs, chunkSize)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return bitem, nil
+	return bitem, chunks, nil
 }
 
 // DecryptItem decrypts the given Item using a known barrier encryption key ID. This is useful for encrypting/decrypting information that doesn't need to be written to storage.
-func (b *Barrier) DecyptItem(bitem *apiv1.BackendItem) (*apiv1.Item, error) {
-	if bitem.EncryptionKeyID == 0 {
-		return nil, fmt.Errorf("encryptionKeyID cannot be zero")
+// The item must have been encrypted for its storage key, so values cannot be copied between keys. The chunks of chunked items are read from the storage backend.
+func (b *Barrier) DecyptItem(ctx context.Context, bitem *apiv1.BackendItem) (*apiv1.Item, error) {
+	kc, release, err := b.useKeychain()
+	if err != nil {
+		return nil, err
 	}
+	defer release()
 
-	encKey := b.keychain.Key(bitem.EncryptionKeyID)
-	if encKey == nil {
-		return nil, fmt.Errorf("unable to unencrypt value: reported EncryptionKeyID %d does not exist", bitem.EncryptionKeyID)
+	encKey, err := encryptionKey(kc, bitem)
+	if err != nil {
+		return nil, err
 	}
 
-	decrypted, err := encryption.Decrypt(encKey.Type, encKey.Key, bitem.Val)
+	var item *apiv1.Item
+	err = b.withItemKey(ctx, encKey, bitem, func(itemKey *apiv1.EncryptionKey) error {
+		var err error
+		item, err = b.decryptItem(ctx, bitem, itemKey)
+		return err
+	})
+
+	return item, err
+}
+
+// decryptItem decrypts the given BackendItem with the given item key.
+func (b *Barrier) decryptItem(ctx context.Context, bitem *apiv1.BackendItem, encKey *apiv1.EncryptionKey) (*apiv1.Item, error) {
+	var err error
+	payloadKey, payloadKeyID := encKey, bitem.EncryptionKeyID
+	if len(bitem.DataKey) > 0 {
+		payloadKey, err = unwrapDataKey(encKey, bitem.Key, bitem.DataKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt data key: %s: %w", bitem.Key, err)
+		}
+		payloadKeyID = 0
+	}
+
+	var decrypted []byte
+	if bitem.Chunks != nil {
+		decrypted, err = b.decryptChunks(ctx, bitem, payloadKey, payloadKeyID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// items written before version 1 were encrypted without associated data, so only the key stored inside them can be verified
+		var ad []byte
+		if bitem.Version > 0 {
+			ad = payloadAD(bitem, payloadKeyID)
+		}
+
+		decrypted, err = encryption.DecryptWithAD(payloadKey.Type, payloadKey.Key, bitem.Val, ad)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt key: %s: %w", bitem.Key, err)
+		}
+	}
+
+	decrypted, err = decompress(decrypted, bitem.Compression)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decrypt key: %s: %w", bitem.Key, err)
+		return nil, fmt.Errorf("unable to decompress key: %s: %w", bitem.Key, err)
 	}
 
 	item := &apiv1.Item{}
@@ -272,10 +582,216 @@ func (b *Barrier) DecyptItem(bitem *apiv1.BackendItem) (*apiv1.Item, error) {
 		return nil, fmt.Errorf("unable to unmarshal key: %s: %w", bitem.Key, err)
 	}
 
+	if storagePath(item.Key) != bitem.Key {
+		return nil, fmt.Errorf("%w: %s", ErrItemKeyMismatch, bitem.Key)
+	}
+
 	item.Key = strings.TrimPrefix(item.Key, secretsPath)
 	return item, nil
 }
 
+func encryptionKey(kc *keychain.Keychain, bitem *apiv1.BackendItem) (*apiv1.EncryptionKey, error) {
+	if bitem.EncryptionKeyID == 0 {
+		return nil, fmt.Errorf("encryptionKeyID cannot be zero")
+	}
+
+	encKey := kc.Key(bitem.EncryptionKeyID)
+	if encKey == nil {
+		return nil, fmt.Errorf("unable to unencrypt value: reported EncryptionKeyID %d does not exist", bitem.EncryptionKeyID)
+	}
+
+	return encKey, nil
+}
+
+// RekeyItems moves every item that is not encrypted by the active encryption key onto it. Items written with envelope encryption only have their data key re-encrypted,
+// while all other items are re-encrypted entirely. Items in locked namespaces are skipped. Returns the number of rekeyed items.
+func (b *Barrier) RekeyItems(ctx context.Context) (int, error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if sealed {
+		return 0, ErrBarrierSealed
+	}
+
+	rekeyed := 0
+	err = storage.Walk(ctx, b.store, secretsPath, func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+
+		ok, err := b.rekeyItem(ctx, key)
+		if ok {
+			rekeyed++
+		}
+		// items in namespaces that are locked by their tenant cannot be decrypted until the namespace is unlocked
+		if errors.Is(err, ErrNamespaceLocked) {
+			return nil
+		}
+		return err
+	})
+
+	return rekeyed, err
+}
+
+func (b *Barrier) rekeyItem(ctx context.Context, key string) (bool, error) {
+	mu, err := b.store.LockKey(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	if err := mu.Lock(); err != nil {
+		return false, err
+	}
+	defer mu.Unlock()
+
+	bitem, err := b.store.Get(ctx, key)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	kc, release, err := b.useKeychain()
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	activeKey := kc.ActiveKey()
+	if bitem.EncryptionKeyID == activeKey.Id {
+		return false, nil
+	}
+
+	if !b.hasKey(bitem.EncryptionKeyID) {
+		if _, err := b.ReloadKeychain(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	if len(bitem.DataKey) == 0 {
+		item, err := b.DecyptItem(ctx, bitem)
+		if err != nil {
+			return false, err
+		}
+
+		chunkSize, _ := b.itemSettings()
+		rekeyed, chunks, err := b.encryptItem(ctx, item, chunkSize)
+		if err != nil {
+			return false, err
+		}
+
+		return true, b.putItem(ctx, rekeyed, chunks)
+	}
+
+	encKey, err := encryptionKey(kc, bitem)
+	if err != nil {
+		return false, err
+	}
+
+	var dataKey *apiv1.EncryptionKey
+	err = b.withItemKey(ctx, encKey, bitem, func(itemKey *apiv1.EncryptionKey) error {
+		var err error
+		dataKey, err = unwrapDataKey(itemKey, bitem.Key, bitem.DataKey)
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to decrypt data key: %s: %w", bitem.Key, err)
+	}
+
+	// the data key is wrapped in the current format, which upgrades the item as well
+	wrapKey, destroyWrapKey, err := b.itemKey(ctx, activeKey, bitem.Key, itemVersion, true)
+	if err != nil {
+		return false, err
+	}
+	defer destroyWrapKey()
+
+	wrapped, err := wrapDataKey(wrapKey, bitem.Key, dataKey)
+	if err != nil {
+		return false, fmt.Errorf("unable to encrypt data key: %s: %w", bitem.Key, err)
+	}
+
+	bitem.EncryptionKeyID = activeKey.Id
+	bitem.DataKey = wrapped
+	bitem.Version = itemVersion
+	return true, b.store.Put(ctx, bitem)
+}
+
+// UpgradeItems re-encrypts the items written by previous versions of the barrier with the active encryption key,
+// binding them to their storage keys and encrypting items in namespaces with the namespace's key. Items in locked namespaces are skipped. Returns the number of upgraded items.
+func (b *Barrier) UpgradeItems(ctx context.Context) (int, error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if sealed {
+		return 0, ErrBarrierSealed
+	}
+
+	upgraded := 0
+	err = storage.Walk(ctx, b.store, secretsPath, func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+
+		ok, err := b.upgradeItem(ctx, key)
+		if ok {
+			upgraded++
+		}
+		// items in namespaces that are locked by their tenant cannot be decrypted until the namespace is unlocked
+		if errors.Is(err, ErrNamespaceLocked) {
+			return nil
+		}
+		return err
+	})
+
+	return upgraded, err
+}
+
+func (b *Barrier) upgradeItem(ctx context.Context, key string) (bool, error) {
+	mu, err := b.store.LockKey(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	if err := mu.Lock(); err != nil {
+		return false, err
+	}
+	defer mu.Unlock()
+
+	bitem, err := b.store.Get(ctx, key)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if !needsUpgrade(bitem) {
+		return false, nil
+	}
+
+	if !b.hasKey(bitem.EncryptionKeyID) {
+		if _, err := b.ReloadKeychain(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	item, err := b.DecyptItem(ctx, bitem)
+	if err != nil {
+		return false, err
+	}
+
+	chunkSize, _ := b.itemSettings()
+	upgraded, chunks, err := b.encryptItem(ctx, item, chunkSize)
+	if err != nil {
+		return false, err
+	}
+
+	return true, b.putItem(ctx, upgraded, chunks)
+}
+
 // List items in the given prefix.
 func (b *Barrier) List(ctx context.Context, prefix string) ([]string, error) {
 	sealed, err := b.IsSealed(ctx)
@@ -289,6 +805,19 @@ func (b *Barrier) List(ctx context.Context, prefix string) ([]string, error) {
 	return b.store.List(ctx, getSecretPath(prefix))
 }
 
+// ListPage lists a page of keys with the given prefix from the storage backend. See storage.Storage.ListPage.
+func (b *Barrier) ListPage(ctx context.Context, prefix string, opts storage.ListOptions) ([]string, bool, error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if sealed {
+		return nil, false, ErrBarrierSealed
+	}
+
+	return b.store.ListPage(ctx, getSecretPath(prefix), opts)
+}
+
 // Get an item from the storage backend.
 func (b *Barrier) Get(ctx context.Context, key string) (*apiv1.Item, error) {
 	sealed, err := b.IsSealed(ctx)
@@ -307,7 +836,35 @@ func (b *Barrier) Get(ctx context.Context, key string) (*apiv1.Item, error) {
 		return nil, err
 	}
 
-	return b.DecyptItem(bitem)
+	// the item may have been written by another replica with a key this replica has not seen yet
+	if !b.hasKey(bitem.EncryptionKeyID) {
+		if _, err := b.ReloadKeychain(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.DecyptItem(ctx, bitem)
+}
+
+// GetExpiry gets the expiry of an item from the storage backend without decrypting it. See apiv1.BackendItem.ExpiresAt.
+func (b *Barrier) GetExpiry(ctx context.Context, key string) (int64, error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if sealed {
+		return 0, ErrBarrierSealed
+	}
+	if _, ok := disallowedPaths[key]; ok {
+		return 0, ErrDisallowedPath
+	}
+
+	bitem, err := b.store.Get(ctx, getSecretPath(key))
+	if err != nil {
+		return 0, err
+	}
+
+	return bitem.ExpiresAt, nil
 }
 
 // Put an item in the storage backend after encrypting it.
@@ -327,12 +884,17 @@ func (b *Barrier) Put(ctx context.Context, item *apiv1.Item) error {
 		return ErrMixRawMapValues
 	}
 
-	bitem, err := b.EncryptItem(item)
+	if err := b.checkSize(storagePath(item.Key), proto.Size(item)); err != nil {
+		return err
+	}
+
+	chunkSize, _ := b.itemSettings()
+	bitem, chunks, err := b.encryptItem(ctx, item, chunkSize)
 	if err != nil {
 		return err
 	}
 
-	return b.store.Put(ctx, bitem)
+	return b.putItem(ctx, bitem, chunks)
 }
 
 // Delete an item from the storage backend.
@@ -348,7 +910,7 @@ func (b *Barrier) Delete(ctx context.Context, key string) error {
 		return ErrDisallowedPath
 	}
 
-	return b.store.Delete(ctx, getSecretPath(key))
+	return b.deleteItem(ctx, getSecretPath(key))
 }
 
 // LockKey locks a key using the storage backend's Mutex (if supported).
@@ -368,7 +930,12 @@ func (b *Barrier) LockKey(ctx context.Context, key string) (storage.Mutex, error
 }
 
 func (b *Barrier) persistKeychain(ctx context.Context, gatekeeperKey []byte) error {
-	snapshot, err := b.keychain.Snapshot(gatekeeperKey)
+	return b.storeKeychain(ctx, b.keychain, gatekeeperKey)
+}
+
+// storeKeychain encrypts the given Keychain with the gatekeeper key and writes it to the storage backend.
+func (b *Barrier) storeKeychain(ctx context.Context, kc *keychain.Keychain, gatekeeperKey []byte) error {
+	snapshot, err := kc.Snapshot(gatekeeperKey)
 	if err != nil {
 		return fmt.Errorf("failed to create keychain snapshot: %w", err)
 	}
@@ -385,6 +952,148 @@ func (b *Barrier) persistKeychain(ctx context.Context, gatekeeperKey []byte) err
 	return nil
 }
 
+// useKeychain returns the Keychain of the unsealed barrier along with a function that releases it. The key material of the Keychain stays
+// in memory until it is released, even if the barrier is sealed in the meantime, so the keys returned by it can be used until then.
+func (b *Barrier) useKeychain() (*keychain.Keychain, func(), error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.keychain == nil {
+		return nil, nil, ErrBarrierSealed
+	}
+
+	return b.keychain, b.keychain.Acquire(), nil
+}
+
+func (b *Barrier) hasKey(id uint32) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.keychain != nil && b.keychain.Key(id) != nil
+}
+
+// lockKeychain locks the stored Keychain to prevent replicas from overwriting each other's changes.
+func (b *Barrier) lockKeychain(ctx context.Context) (storage.Mutex, error) {
+	mu, err := b.store.LockKey(ctx, barrierPath+keychainKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mu.Lock(); err != nil {
+		return nil, err
+	}
+
+	return mu, nil
+}
+
+// watchKeychain reloads the Keychain whenever another replica rotates the encryption key, until the barrier is sealed.
+// Backends that cannot watch keys are polled instead. It must be called while holding the write lock.
+func (b *Barrier) watchKeychain() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.stopWatching = cancel
+
+	// the watch is started before returning, so rotations right after unsealing are not missed
+	var changes <-chan string
+	if b.store.Capabilities().Has(storage.CapabilityWatching) {
+		changes, _ = b.store.Watch(ctx, KeychainUpdatesPath)
+	}
+
+	go func() {
+		ticker := time.NewTicker(keychainPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+			case <-ticker.C:
+			}
+
+			// failures are retried on the next change or poll, and reads with unknown keys reload the keychain themselves
+			b.ReloadKeychain(ctx)
+		}
+	}()
+}
+
+// publishKey writes a keychain update record for the given key, encrypted with the keychain's sync key.
+func (b *Barrier) publishKey(ctx context.Context, key *apiv1.EncryptionKey) error {
+	syncKey := b.keychain.SyncKey()
+	if syncKey == nil {
+		return errNoSyncKey
+	}
+
+	data, err := proto.Marshal(key)
+	if err != nil {
+		return err
+	}
+	defer securemem.Wipe(data)
+
+	recordKey := fmt.Sprintf("%s%010d", KeychainUpdatesPath, key.Id)
+	encrypted, err := encryption.EncryptWithAD(apiv1.CipherType_AES256_GCM, syncKey, data, []byte(recordKey))
+	if err != nil {
+		return err
+	}
+
+	item := &apiv1.BackendItem{
+		Key: recordKey,
+		Val: encrypted,
+	}
+	if err := b.store.Put(ctx, item); err != nil {
+		return fmt.Errorf("failed to put keychain update in backend storage: %w", err)
+	}
+
+	return nil
+}
+
+// upgradeKeychain adds a sync key to a keychain that was stored without one, and returns the upgraded keychain. Keychain update records
+// written before are encrypted with the previously active encryption key, which would chain every key to the ones before it, so they are deleted.
+// Replicas that are unsealed already pick up later keys once they are unsealed again.
+func (b *Barrier) upgradeKeychain(ctx context.Context, gatekeeperKey []byte) (*keychain.Keychain, error) {
+	mu, err := b.lockKeychain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer mu.Unlock()
+
+	kc, err := b.retrieveKeychain(ctx, gatekeeperKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// another replica may have upgraded the keychain while waiting for the lock
+	if kc.SyncKey() != nil {
+		return kc, nil
+	}
+
+	names, err := b.store.List(ctx, KeychainUpdatesPath)
+	if err != nil {
+		kc.Destroy()
+		return nil, err
+	}
+	for _, name := range names {
+		if err := b.store.Delete(ctx, KeychainUpdatesPath+name); err != nil && !storage.IsErrNotFound(err) {
+			kc.Destroy()
+			return nil, err
+		}
+	}
+
+	if err := kc.GenerateSyncKey(); err != nil {
+		kc.Destroy()
+		return nil, err
+	}
+	if err := b.storeKeychain(ctx, kc, gatekeeperKey); err != nil {
+		kc.Destroy()
+		return nil, err
+	}
+
+	return kc, nil
+}
+
 func (b *Barrier) retrieveKeychain(ctx context.Context, gatekeeperKey []byte) (*keychain.Keychain, error) {
 	item, err := b.store.Get(ctx, barrierPath+keychainKey)
 	if err != nil {
@@ -402,3 +1111,55 @@ func (b *Barrier) retrieveKeychain(ctx context.Context, gatekeeperKey []byte) (*
 func getSecretPath(key string) string {
 	return secretsPath + strings.TrimPrefix(key, "/")
 }
+
+// storagePath returns the key an item is stored at, given either the item's key or its storage key.
+func storagePath(key string) string {
+	if strings.HasPrefix(key, secretsPath) {
+		return key
+	}
+
+	return getSecretPath(key)
+}
+
+// needsUpgrade determines if the given item was written in a format that is missing protections of the current format.
+// Only items in namespaces are encrypted differently since version 1.
+func needsUpgrade(bitem *apiv1.BackendItem) bool {
+	if bitem.Version >= itemVersion {
+		return false
+	}
+
+	return bitem.Version == 0 || len(namespaceOf(bitem.Key)) > 0
+}
+
+// associatedData binds an encrypted item to its storage key and encryption key ID.
+func associatedData(key string, encryptionKeyID uint32) []byte {
+	ad := make([]byte, 0, len(key)+5)
+	ad = append(ad, key...)
+	ad = append(ad, 0)
+	return strconv.AppendUint(ad, uint64(encryptionKeyID), 10)
+}
+
+// wrapDataKey encrypts the given data key by the given encryption key for the item stored at key.
+func wrapDataKey(encKey *apiv1.EncryptionKey, key string, dataKey *apiv1.EncryptionKey) ([]byte, error) {
+	bs, err := proto.Marshal(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.EncryptWithAD(encKey.Type, encKey.Key, bs, associatedData(key, encKey.Id))
+}
+
+// unwrapDataKey decrypts the data key of the item stored at key, which was encrypted by the given encryption key.
+func unwrapDataKey(encKey *apiv1.EncryptionKey, key string, wrapped []byte) (*apiv1.EncryptionKey, error) {
+	bs, err := encryption.DecryptWithAD(encKey.Type, encKey.Key, wrapped, associatedData(key, encKey.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := &apiv1.EncryptionKey{}
+	if err := proto.Unmarshal(bs, dataKey); err != nil {
+		return nil, err
+	}
+
+	return dataKey, nil
+}
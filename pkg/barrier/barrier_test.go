@@ -1,15 +1,23 @@
 package barrier
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/barrier/keychain"
 	"github.com/slaskawi/vault-poc/pkg/storage"
 	"github.com/slaskawi/vault-poc/pkg/storage/memory"
 )
@@ -328,4 +336,812 @@ var _ = Describe("barrier", func() {
 		Expect(item.Map).To(BeNil())
 		Expect(item.Raw).NotTo(BeEmpty())
 	})
+
+	It("can reload keys rotated by another replica", func() {
+		replica, err := NewBarrier(back)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = replica.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer replica.Seal()
+
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		added, err := replica.ReloadKeychain(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(added).To(Equal(2))
+		Expect(replica.keychain.ActiveKey().Id).To(Equal(barrier.keychain.ActiveKey().Id))
+
+		added, err = replica.ReloadKeychain(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(added).To(BeZero())
+	})
+
+	It("encrypts keychain updates with the sync key instead of the previous encryption key", func() {
+		prevKey := barrier.keychain.ActiveKey()
+		err := barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, fmt.Sprintf("%s%010d", KeychainUpdatesPath, barrier.keychain.ActiveKey().Id))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = encryption.Decrypt(prevKey.Type, prevKey.Key, bitem.Val)
+		Expect(err).To(HaveOccurred())
+
+		data, err := encryption.DecryptWithAD(apiv1.CipherType_AES256_GCM, barrier.keychain.SyncKey(), bitem.Val, []byte(bitem.Key))
+		Expect(err).NotTo(HaveOccurred())
+		key := &apiv1.EncryptionKey{}
+		Expect(proto.Unmarshal(data, key)).To(Succeed())
+		Expect(key.Id).To(Equal(barrier.keychain.ActiveKey().Id))
+	})
+
+	It("adds a sync key to keychains stored without one when unsealing", func() {
+		legacy := keychain.NewKeychain()
+		_, err := legacy.Merge(barrier.keychain.Keys())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(barrier.storeKeychain(ctx, legacy, gatekeeperKey)).To(Succeed())
+		legacy.Destroy()
+
+		legacyRecord := fmt.Sprintf("%s%010d", KeychainUpdatesPath, 999)
+		Expect(back.Put(ctx, &apiv1.BackendItem{Key: legacyRecord, EncryptionKeyID: 1, Val: []byte("chained")})).To(Succeed())
+
+		replica, err := NewBarrier(back)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replica.Unseal(ctx, gatekeeperKey)).To(Succeed())
+		defer replica.Seal()
+		Expect(replica.keychain.SyncKey()).NotTo(BeNil())
+
+		_, err = back.Get(ctx, legacyRecord)
+		Expect(storage.IsErrNotFound(err)).To(BeTrue())
+
+		stored, err := barrier.retrieveKeychain(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer stored.Destroy()
+		Expect(stored.SyncKey()).To(Equal(replica.keychain.SyncKey()))
+
+		// the barrier picks up the new sync key once it is unsealed again
+		barrier.Seal()
+		Expect(barrier.Unseal(ctx, gatekeeperKey)).To(Succeed())
+		Expect(barrier.keychain.SyncKey()).To(Equal(replica.keychain.SyncKey()))
+	})
+
+	It("reloads the keychain when reading an item encrypted with an unknown key", func() {
+		replica, err := NewBarrier(back)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = replica.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer replica.Seal()
+
+		// stop the watcher so the read has to reload the keychain itself
+		replica.stopWatching()
+
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/key3", Raw: []byte("rotated")})
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := replica.Get(ctx, "/testing/key3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("rotated")))
+		Expect(replica.keychain.ActiveKey().Id).To(Equal(barrier.keychain.ActiveKey().Id))
+	})
+
+	It("watches for keys rotated by another replica", func() {
+		replica, err := NewBarrier(back)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = replica.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer replica.Seal()
+
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() bool {
+			return replica.hasKey(barrier.keychain.ActiveKey().Id)
+		}).Should(BeTrue())
+	})
+
+	It("keeps keys rotated by another replica when rotating", func() {
+		replica, err := NewBarrier(back)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = replica.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		replica.Seal()
+
+		// unseal without a watcher so the replica's keychain is stale when it rotates
+		kc, err := replica.retrieveKeychain(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		replica.keychain = kc
+
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		rotatedID := barrier.keychain.ActiveKey().Id
+
+		err = replica.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replica.keychain.ActiveKey().Id).To(Equal(rotatedID + 1))
+
+		stored, err := barrier.retrieveKeychain(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Key(rotatedID)).NotTo(BeNil())
+		Expect(stored.ActiveKey().Id).To(Equal(rotatedID + 1))
+
+		err = replica.RotateEncryptionKey(ctx, []byte("wrong-gatekeeper-key-00000000000"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects values copied onto another key", func() {
+		err := barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant1/kv/secret", Raw: []byte("tenant1")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant2/kv/secret", Raw: []byte("tenant2")})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, getSecretPath("namespaces/tenant1/kv/secret"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.Version).To(Equal(uint32(itemVersion)))
+
+		bitem.Key = getSecretPath("namespaces/tenant2/kv/secret")
+		err = back.Put(ctx, bitem)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "namespaces/tenant2/kv/secret")
+		Expect(err).To(HaveOccurred())
+
+		// downgrading the item to skip the associated data must not help either
+		bitem.Version = 0
+		err = back.Put(ctx, bitem)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "namespaces/tenant2/kv/secret")
+		Expect(err).To(HaveOccurred())
+
+		err = barrier.Delete(ctx, "namespaces/tenant2/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("gets the expiry of items without decrypting them", func() {
+		expiresAt := time.Now().Add(time.Hour).Unix()
+		err := barrier.Put(ctx, &apiv1.Item{Key: "/testing/expiring", Raw: []byte("expiring"), ExpiresAt: expiresAt})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, getSecretPath("/testing/expiring"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.ExpiresAt).To(Equal(expiresAt))
+
+		got, err := barrier.GetExpiry(ctx, "/testing/expiring")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(expiresAt))
+
+		// the copy of the expiry is bound to the encrypted value
+		bitem.ExpiresAt += 3600
+		err = back.Put(ctx, bitem)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/expiring")
+		Expect(err).To(HaveOccurred())
+
+		err = barrier.Delete(ctx, "/testing/expiring")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("can read and upgrade items written without associated data", func() {
+		legacyItem := func(key, storageKey string, val []byte) *apiv1.BackendItem {
+			bs, err := proto.Marshal(&apiv1.Item{Key: key, Raw: val})
+			Expect(err).NotTo(HaveOccurred())
+
+			encKey := barrier.keychain.ActiveKey()
+			encrypted, err := encryption.Encrypt(encKey.Type, encKey.Key, bs)
+			Expect(err).NotTo(HaveOccurred())
+
+			return &apiv1.BackendItem{Key: getSecretPath(storageKey), EncryptionKeyID: encKey.Id, Val: encrypted}
+		}
+
+		err := back.Put(ctx, legacyItem("/testing/legacy", "/testing/legacy", []byte("legacy")))
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := barrier.Get(ctx, "/testing/legacy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("legacy")))
+
+		By("rejecting legacy items stored at another key")
+		err = back.Put(ctx, legacyItem("/testing/legacy", "/testing/swapped", []byte("legacy")))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/swapped")
+		Expect(err).To(MatchError(ErrItemKeyMismatch))
+
+		err = back.Delete(ctx, getSecretPath("/testing/swapped"))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("upgrading legacy items")
+		upgraded, err := barrier.UpgradeItems(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded).To(Equal(1))
+
+		bitem, err := back.Get(ctx, getSecretPath("/testing/legacy"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.Version).To(Equal(uint32(itemVersion)))
+
+		item, err = barrier.Get(ctx, "/testing/legacy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Key).To(Equal("/testing/legacy"))
+		Expect(item.Raw).To(Equal([]byte("legacy")))
+
+		upgraded, err = barrier.UpgradeItems(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded).To(BeZero())
+	})
+	It("reads items encrypted by keys of another cipher after rotating", func() {
+		err := barrier.Put(ctx, &apiv1.Item{Key: "/testing/ciphers", Raw: []byte("aes")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.SetCipherType(apiv1.CipherType_AES256_GCM_SIV)
+		Expect(err).NotTo(HaveOccurred())
+		defer barrier.SetCipherType(apiv1.CipherType_AES256_GCM)
+
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(barrier.keychain.ActiveKey().Type).To(Equal(apiv1.CipherType_AES256_GCM_SIV))
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/ciphers/siv", Raw: []byte("siv")})
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := barrier.Get(ctx, "/testing/ciphers")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("aes")))
+
+		By("keeping the cipher after unsealing again")
+		barrier.Seal()
+		err = barrier.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err = barrier.Get(ctx, "/testing/ciphers/siv")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("siv")))
+		Expect(barrier.keychain.CipherType()).To(Equal(apiv1.CipherType_AES256_GCM_SIV))
+	})
+	It("encrypts items with envelope encryption and rekeys them", func() {
+		barrier.SetEnvelopeEncryption(true)
+		defer barrier.SetEnvelopeEncryption(false)
+
+		err := barrier.Put(ctx, &apiv1.Item{Key: "/testing/envelope", Raw: []byte("envelope")})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, getSecretPath("/testing/envelope"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.DataKey).NotTo(BeEmpty())
+		val := bitem.Val
+
+		item, err := barrier.Get(ctx, "/testing/envelope")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("envelope")))
+
+		By("rejecting data keys copied from another item")
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/envelope2", Raw: []byte("envelope2")})
+		Expect(err).NotTo(HaveOccurred())
+
+		other, err := back.Get(ctx, getSecretPath("/testing/envelope2"))
+		Expect(err).NotTo(HaveOccurred())
+
+		err = back.Put(ctx, &apiv1.BackendItem{Key: getSecretPath("/testing/envelope3"), EncryptionKeyID: other.EncryptionKeyID, Val: other.Val, Version: other.Version, DataKey: other.DataKey})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/envelope3")
+		Expect(err).To(HaveOccurred())
+
+		err = back.Delete(ctx, getSecretPath("/testing/envelope3"))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("only rewrapping data keys when rekeying")
+		err = barrier.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		activeKey := barrier.keychain.ActiveKey()
+
+		rekeyed, err := barrier.RekeyItems(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rekeyed).To(BeNumerically(">=", 2))
+
+		bitem, err = back.Get(ctx, getSecretPath("/testing/envelope"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.EncryptionKeyID).To(Equal(activeKey.Id))
+		Expect(bitem.Val).To(Equal(val))
+
+		item, err = barrier.Get(ctx, "/testing/envelope")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("envelope")))
+
+		By("moving items written without envelope encryption onto the active key")
+		bitem, err = back.Get(ctx, getSecretPath("/testing/key1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.EncryptionKeyID).To(Equal(activeKey.Id))
+
+		rekeyed, err = barrier.RekeyItems(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rekeyed).To(BeZero())
+	})
+	It("encrypts items in namespaces with derived namespace keys", func() {
+		err := barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant3/kv/secret", Raw: []byte("tenant3")})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, getSecretPath("namespaces/tenant3/kv/secret"))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = back.Get(ctx, NamespaceSecretsPath+"tenant3")
+		Expect(err).NotTo(HaveOccurred())
+
+		By("failing to decrypt the item with the keychain key alone")
+		encKey := barrier.keychain.Key(bitem.EncryptionKeyID)
+		_, err = encryption.DecryptWithAD(encKey.Type, encKey.Key, bitem.Val, associatedData(bitem.Key, bitem.EncryptionKeyID))
+		Expect(err).To(HaveOccurred())
+
+		item, err := barrier.Get(ctx, "namespaces/tenant3/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant3")))
+
+		By("failing to decrypt the item after the namespace's secret of another barrier is substituted")
+		secret, err := back.Get(ctx, NamespaceSecretsPath+"tenant3")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant4/kv/secret", Raw: []byte("tenant4")})
+		Expect(err).NotTo(HaveOccurred())
+
+		other, err := back.Get(ctx, NamespaceSecretsPath+"tenant4")
+		Expect(err).NotTo(HaveOccurred())
+		other.Key = NamespaceSecretsPath + "tenant3"
+		err = back.Put(ctx, other)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "namespaces/tenant3/kv/secret")
+		Expect(err).To(HaveOccurred())
+
+		err = back.Put(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("upgrading items in namespaces written by the previous version")
+		bs, err := proto.Marshal(&apiv1.Item{Key: getSecretPath("namespaces/tenant3/kv/legacy"), Raw: []byte("legacy")})
+		Expect(err).NotTo(HaveOccurred())
+
+		encKey = barrier.keychain.ActiveKey()
+		encrypted, err := encryption.EncryptWithAD(encKey.Type, encKey.Key, bs, associatedData(getSecretPath("namespaces/tenant3/kv/legacy"), encKey.Id))
+		Expect(err).NotTo(HaveOccurred())
+
+		err = back.Put(ctx, &apiv1.BackendItem{Key: getSecretPath("namespaces/tenant3/kv/legacy"), EncryptionKeyID: encKey.Id, Val: encrypted, Version: 1})
+		Expect(err).NotTo(HaveOccurred())
+
+		upgraded, err := barrier.UpgradeItems(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgraded).To(Equal(1))
+
+		item, err = barrier.Get(ctx, "namespaces/tenant3/kv/legacy")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("legacy")))
+	})
+
+	It("can shred a namespace", func() {
+		bitem, err := back.Get(ctx, getSecretPath("namespaces/tenant3/kv/secret"))
+		Expect(err).NotTo(HaveOccurred())
+
+		deleted, err := barrier.ShredNamespace(ctx, "tenant3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(Equal(2))
+
+		_, err = barrier.Get(ctx, "namespaces/tenant3/kv/secret")
+		Expect(storage.IsErrNotFound(err)).To(BeTrue())
+
+		By("failing to decrypt restored copies of the namespace's items")
+		err = back.Put(ctx, bitem)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "namespaces/tenant3/kv/secret")
+		Expect(err).To(MatchError(ErrNamespaceShredded))
+
+		err = back.Delete(ctx, bitem.Key)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("leaving other namespaces intact")
+		item, err := barrier.Get(ctx, "namespaces/tenant4/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant4")))
+
+		_, err = barrier.ShredNamespace(ctx, "tenant3/kv")
+		Expect(err).To(MatchError(ErrInvalidNamespace))
+	})
+	It("keeps namespaces with tenant wrapping keys locked until the key is supplied", func() {
+		wrappingKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant5/kv/secret", Raw: []byte("tenant5")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.ConfigureNamespaceKey(ctx, "tenant5", nil, wrappingKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		tenantKeyed, locked, err := barrier.NamespaceStatus(ctx, "tenant5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tenantKeyed).To(BeTrue())
+		Expect(locked).To(BeFalse())
+
+		item, err := barrier.Get(ctx, "namespaces/tenant5/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant5")))
+
+		By("locking the namespace when the barrier is sealed")
+		barrier.Seal()
+		err = barrier.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "namespaces/tenant5/kv/secret")
+		Expect(err).To(MatchError(ErrNamespaceLocked))
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant5/kv/secret2", Raw: []byte("tenant5")})
+		Expect(err).To(MatchError(ErrNamespaceLocked))
+
+		item, err = barrier.Get(ctx, "namespaces/tenant4/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant4")))
+
+		By("unlocking the namespace with the wrapping key")
+		err = barrier.UnlockNamespace(ctx, "tenant5", []byte("wrong-wrapping-key-0000000000000"))
+		Expect(err).To(MatchError(ErrInvalidWrappingKey))
+
+		err = barrier.UnlockNamespace(ctx, "tenant4", wrappingKey)
+		Expect(err).To(MatchError(ErrNotTenantKeyed))
+
+		err = barrier.UnlockNamespace(ctx, "tenant5", wrappingKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err = barrier.Get(ctx, "namespaces/tenant5/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant5")))
+
+		By("requiring the current wrapping key to replace it")
+		newKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.ConfigureNamespaceKey(ctx, "tenant5", nil, newKey)
+		Expect(err).To(MatchError(ErrWrappingKeyRequired))
+
+		err = barrier.ConfigureNamespaceKey(ctx, "tenant5", wrappingKey, newKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.LockNamespace("tenant5")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.UnlockNamespace(ctx, "tenant5", wrappingKey)
+		Expect(err).To(MatchError(ErrInvalidWrappingKey))
+
+		err = barrier.UnlockNamespace(ctx, "tenant5", newKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err = barrier.Get(ctx, "namespaces/tenant5/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant5")))
+
+		By("zeroing the secret of the namespace when it is locked, unlocked again, or the barrier is sealed")
+		unlocked := barrier.unlocked["tenant5"]
+		Expect(unlocked.Bytes()).To(HaveLen(namespaceSecretSize))
+
+		err = barrier.UnlockNamespace(ctx, "tenant5", newKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unlocked.Bytes()).To(BeNil())
+
+		unlocked = barrier.unlocked["tenant5"]
+		err = barrier.LockNamespace("tenant5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unlocked.Bytes()).To(BeNil())
+
+		err = barrier.UnlockNamespace(ctx, "tenant5", newKey)
+		Expect(err).NotTo(HaveOccurred())
+		unlocked = barrier.unlocked["tenant5"]
+		barrier.Seal()
+		Expect(unlocked.Bytes()).To(BeNil())
+
+		err = barrier.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		err = barrier.UnlockNamespace(ctx, "tenant5", newKey)
+		Expect(err).NotTo(HaveOccurred())
+	})
+	It("re-encrypts the items of a namespace with a new secret when its wrapping key is configured", func() {
+		err := barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant6/kv/secret", Raw: []byte("tenant6")})
+		Expect(err).NotTo(HaveOccurred())
+
+		barrier.SetEnvelopeEncryption(true)
+		err = barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant6/kv/envelope", Raw: []byte("tenant6")})
+		barrier.SetEnvelopeEncryption(false)
+		Expect(err).NotTo(HaveOccurred())
+
+		nsSecret, err := barrier.retrieveNamespaceSecret(ctx, "tenant6")
+		Expect(err).NotTo(HaveOccurred())
+		oldSecret := nsSecret.Secret
+		oldItem, err := back.Get(ctx, getSecretPath("namespaces/tenant6/kv/secret"))
+		Expect(err).NotTo(HaveOccurred())
+
+		wrappingKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+		err = barrier.ConfigureNamespaceKey(ctx, "tenant6", nil, wrappingKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		nsSecret, err = barrier.retrieveNamespaceSecret(ctx, "tenant6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nsSecret.PreviousSecret).To(BeEmpty())
+
+		for _, key := range []string{"namespaces/tenant6/kv/secret", "namespaces/tenant6/kv/envelope"} {
+			item, err := barrier.Get(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(item.Raw).To(Equal([]byte("tenant6")))
+
+			By("making the items undecryptable with the previous secret of " + key)
+			bitem, err := back.Get(ctx, getSecretPath(key))
+			Expect(err).NotTo(HaveOccurred())
+			previousKey, destroy, err := barrier.deriveItemKey(ctx, barrier.keychain.Key(bitem.EncryptionKeyID), "tenant6", oldSecret)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = barrier.decryptItem(ctx, bitem, previousKey)
+			Expect(err).To(HaveOccurred())
+			destroy()
+		}
+
+		By("reading items that have not been re-encrypted yet with the previous secret")
+		nsSecret.PreviousSecret = oldSecret
+		Expect(barrier.persistNamespaceSecret(ctx, "tenant6", nsSecret)).To(Succeed())
+		Expect(back.Put(ctx, oldItem)).To(Succeed())
+
+		item, err := barrier.Get(ctx, "namespaces/tenant6/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant6")))
+
+		By("finishing the re-encryption when the wrapping key is configured again")
+		err = barrier.ConfigureNamespaceKey(ctx, "tenant6", wrappingKey, wrappingKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		nsSecret, err = barrier.retrieveNamespaceSecret(ctx, "tenant6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nsSecret.PreviousSecret).To(BeEmpty())
+
+		item, err = barrier.Get(ctx, "namespaces/tenant6/kv/secret")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("tenant6")))
+	})
+	It("splits large items into authenticated chunks", func() {
+		barrier.SetChunkSize(1024)
+		defer barrier.SetChunkSize(DefaultChunkSize)
+
+		data, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+		data = bytes.Repeat(data, 100)
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/chunked", Raw: data})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, getSecretPath("/testing/chunked"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.Val).To(BeEmpty())
+		Expect(bitem.Chunks).NotTo(BeNil())
+		Expect(bitem.Chunks.Count).To(BeNumerically(">", 3))
+
+		chunks, err := back.List(ctx, chunksPath+bitem.Chunks.Id+"/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunks).To(HaveLen(int(bitem.Chunks.Count)))
+
+		item, err := barrier.Get(ctx, "/testing/chunked")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal(data))
+
+		By("rejecting reordered chunks")
+		first, err := back.Get(ctx, chunkPath(bitem.Chunks.Id, 0))
+		Expect(err).NotTo(HaveOccurred())
+		second, err := back.Get(ctx, chunkPath(bitem.Chunks.Id, 1))
+		Expect(err).NotTo(HaveOccurred())
+
+		err = back.Put(ctx, &apiv1.BackendItem{Key: first.Key, Val: second.Val, Version: second.Version})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/chunked")
+		Expect(err).To(HaveOccurred())
+
+		By("rejecting missing chunks")
+		err = back.Put(ctx, first)
+		Expect(err).NotTo(HaveOccurred())
+		err = back.Delete(ctx, chunkPath(bitem.Chunks.Id, bitem.Chunks.Count-1))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/chunked")
+		Expect(err).To(MatchError(ContainSubstring(ErrChunksNotFound.Error())))
+
+		By("rejecting manifests copied onto another key")
+		err = back.Put(ctx, &apiv1.BackendItem{Key: getSecretPath("/testing/chunked2"), EncryptionKeyID: bitem.EncryptionKeyID, Version: bitem.Version, Chunks: bitem.Chunks})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/chunked2")
+		Expect(err).To(HaveOccurred())
+
+		err = back.Delete(ctx, getSecretPath("/testing/chunked2"))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("deleting the previous chunks when overwriting an item")
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/chunked", Raw: data})
+		Expect(err).NotTo(HaveOccurred())
+
+		chunks, err = back.List(ctx, chunksPath+bitem.Chunks.Id+"/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunks).To(BeEmpty())
+
+		item, err = barrier.Get(ctx, "/testing/chunked")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal(data))
+
+		By("deleting the chunks with the item")
+		bitem, err = back.Get(ctx, getSecretPath("/testing/chunked"))
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Delete(ctx, "/testing/chunked")
+		Expect(err).NotTo(HaveOccurred())
+
+		chunks, err = back.List(ctx, chunksPath+bitem.Chunks.Id+"/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunks).To(BeEmpty())
+	})
+	It("keeps no orphaned chunks when an item is overwritten concurrently", func() {
+		replica, err := NewBarrier(slowPutStorage{back})
+		Expect(err).NotTo(HaveOccurred())
+		replica.SetChunkSize(1024)
+
+		err = replica.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer replica.Seal()
+
+		data := bytes.Repeat([]byte("chunked"), 1000)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				Expect(replica.Put(ctx, &apiv1.Item{Key: "/testing/concurrent", Raw: data})).To(Succeed())
+			}()
+		}
+		wg.Wait()
+
+		bitem, err := back.Get(ctx, getSecretPath("/testing/concurrent"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.Chunks).NotTo(BeNil())
+
+		var chunks []string
+		err = storage.Walk(ctx, back, chunksPath, func(key string) error {
+			if !strings.HasSuffix(key, "/") {
+				chunks = append(chunks, key)
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunks).To(HaveLen(int(bitem.Chunks.Count)))
+
+		err = replica.Delete(ctx, "/testing/concurrent")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("compresses items that become smaller", func() {
+		barrier.SetCompression(true)
+		defer barrier.SetCompression(false)
+
+		data := bytes.Repeat([]byte("compressible "), 1000)
+		err := barrier.Put(ctx, &apiv1.Item{Key: "/testing/compressed", Raw: data})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err := back.Get(ctx, getSecretPath("/testing/compressed"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.Compression).To(Equal(apiv1.Compression_GZIP))
+		Expect(len(bitem.Val)).To(BeNumerically("<", len(data)))
+
+		item, err := barrier.Get(ctx, "/testing/compressed")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal(data))
+
+		By("binding the compression to the encrypted value")
+		bitem.Compression = apiv1.Compression_UNCOMPRESSED
+		err = back.Put(ctx, bitem)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = barrier.Get(ctx, "/testing/compressed")
+		Expect(err).To(HaveOccurred())
+
+		By("storing small items uncompressed")
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/compressed", Raw: []byte("x")})
+		Expect(err).NotTo(HaveOccurred())
+
+		bitem, err = back.Get(ctx, getSecretPath("/testing/compressed"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bitem.Compression).To(Equal(apiv1.Compression_UNCOMPRESSED))
+
+		item, err = barrier.Get(ctx, "/testing/compressed")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("x")))
+	})
+	It("rejects items larger than the size limit of their namespace", func() {
+		barrier.SetSizeLimits(2048, map[string]int{"tenant6": 64})
+		defer barrier.SetSizeLimits(0, nil)
+
+		err := barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant6/kv/secret", Raw: make([]byte, 128)})
+		Expect(err).To(MatchError(ContainSubstring(ErrItemTooLarge.Error())))
+		Expect(errors.Is(err, ErrItemTooLarge)).To(BeTrue())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "namespaces/tenant6/kv/secret", Raw: make([]byte, 16)})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/limited", Raw: make([]byte, 128)})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barrier.Put(ctx, &apiv1.Item{Key: "/testing/limited", Raw: make([]byte, 4096)})
+		Expect(errors.Is(err, ErrItemTooLarge)).To(BeTrue())
+	})
+	It("zeroes the keychain when sealing", func() {
+		activeKey := barrier.keychain.ActiveKey()
+		Expect(activeKey.Key).NotTo(BeEmpty())
+
+		barrier.Seal()
+		Expect(activeKey.Key).To(BeNil())
+
+		err := barrier.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := barrier.Get(ctx, "/testing/key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).NotTo(BeEmpty())
+	})
+
+	It("keeps the keychain of in-flight operations until they finish when sealing", func() {
+		kc, release, err := barrier.useKeychain()
+		Expect(err).NotTo(HaveOccurred())
+		activeKey := kc.ActiveKey()
+
+		barrier.Seal()
+		Expect(activeKey.Key).NotTo(BeEmpty())
+		_, err = encryption.Encrypt(activeKey.Type, activeKey.Key, []byte("in-flight"))
+		Expect(err).NotTo(HaveOccurred())
+
+		release()
+		Expect(activeKey.Key).To(BeNil())
+
+		By("sealing while items are read and written")
+		Expect(barrier.Unseal(ctx, gatekeeperKey)).To(Succeed())
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+
+			for i := 0; i < 200; i++ {
+				if err := barrier.Put(ctx, &apiv1.Item{Key: "/testing/sealing", Raw: []byte("value")}); err != nil {
+					Expect(err).To(MatchError(ErrBarrierSealed))
+				}
+				if _, err := barrier.Get(ctx, "/testing/sealing"); err != nil && !storage.IsErrNotFound(err) {
+					Expect(err).To(MatchError(ErrBarrierSealed))
+				}
+			}
+		}()
+
+		for i := 0; i < 20; i++ {
+			barrier.Seal()
+			Expect(barrier.Unseal(ctx, gatekeeperKey)).To(Succeed())
+		}
+		<-done
+	})
 })
+
+// slowPutStorage widens the window between reading and writing items.
+type slowPutStorage struct {
+	storage.Storage
+}
+
+func (s slowPutStorage) Put(ctx context.Context, item *apiv1.BackendItem) error {
+	time.Sleep(time.Millisecond)
+	return s.Storage.Put(ctx, item)
+}
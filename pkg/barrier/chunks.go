@@ -0,0 +1,316 @@
+package barrier
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	// chunksPath holds the encrypted chunks of large items, grouped by the ID of their ChunkManifest.
+	chunksPath = "/kstash/chunks/"
+
+	// DefaultChunkSize is the size above which items are split into chunks, which keeps every value well below the value size limit of etcd.
+	DefaultChunkSize = 512 * 1024
+
+	chunkIDLength = 16
+)
+
+var (
+	ErrItemTooLarge   = fmt.Errorf("item exceeds the size limit of its namespace")
+	ErrChunksNotFound = fmt.Errorf("chunks of item are missing")
+)
+
+// SetChunkSize sets the size above which encrypted items are split into chunks that are stored separately. Zero disables chunking.
+func (b *Barrier) SetChunkSize(size int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.chunkSize = size
+}
+
+// SetCompression enables or disables compressing items before they are encrypted. Items are only stored compressed if that makes them smaller.
+func (b *Barrier) SetCompression(enabled bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.compression = enabled
+}
+
+// SetSizeLimits sets the maximum size of a marshaled item before compression. The limit of a namespace overrides the default limit,
+// which also applies to items outside of namespaces. Zero disables a limit.
+func (b *Barrier) SetSizeLimits(defaultLimit int, namespaceLimits map[string]int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sizeLimit = defaultLimit
+	b.namespaceSizeLimits = namespaceLimits
+}
+
+// checkSize returns ErrItemTooLarge if the given item exceeds the size limit of its namespace.
+func (b *Barrier) checkSize(key string, size int) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	limit := b.sizeLimit
+	if nsLimit, ok := b.namespaceSizeLimits[namespaceOf(key)]; ok {
+		limit = nsLimit
+	}
+
+	if limit > 0 && size > limit {
+		return fmt.Errorf("%w: %s is %d bytes, the limit is %d bytes", ErrItemTooLarge, key, size, limit)
+	}
+
+	return nil
+}
+
+func (b *Barrier) itemSettings() (chunkSize int, compression bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.chunkSize, b.compression
+}
+
+// putItem stores the given encrypted item and its chunks. Chunks of the value the item replaces are deleted once the item is stored.
+func (b *Barrier) putItem(ctx context.Context, bitem *apiv1.BackendItem, chunks []*apiv1.BackendItem) error {
+	unlock, err := b.lockChunks(ctx, bitem.Key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	old, err := b.store.Get(ctx, bitem.Key)
+	if err != nil && !storage.IsErrNotFound(err) {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		if err := b.store.Put(ctx, chunk); err != nil {
+			return fmt.Errorf("unable to store chunk of item: %s: %w", bitem.Key, err)
+		}
+	}
+
+	if err := b.store.Put(ctx, bitem); err != nil {
+		return err
+	}
+
+	if old != nil && old.Chunks != nil && (bitem.Chunks == nil || old.Chunks.Id != bitem.Chunks.Id) {
+		return b.deleteChunks(ctx, old.Chunks)
+	}
+
+	return nil
+}
+
+// deleteItem deletes the item stored at key along with its chunks.
+func (b *Barrier) deleteItem(ctx context.Context, key string) error {
+	unlock, err := b.lockChunks(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	bitem, err := b.store.Get(ctx, key)
+	if err != nil && !storage.IsErrNotFound(err) {
+		return err
+	}
+
+	if err := b.store.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	if bitem != nil && bitem.Chunks != nil {
+		return b.deleteChunks(ctx, bitem.Chunks)
+	}
+
+	return nil
+}
+
+// lockChunks locks the chunks of the item stored at key, so that concurrent writers cannot replace the item between reading the chunks it references and deleting them.
+// Callers may already hold the lock of the item's key, so a separate key is locked in the storage backend.
+func (b *Barrier) lockChunks(ctx context.Context, key string) (func(), error) {
+	b.chunksMu.Lock()
+
+	// backends without distributed locking can only be used by a single node, and cannot lock keys that do not exist yet
+	if !b.store.Capabilities().Has(storage.CapabilityDistributedLocking) {
+		return b.chunksMu.Unlock, nil
+	}
+
+	mu, err := b.store.LockKey(ctx, chunksPath+"items"+key)
+	if err != nil {
+		b.chunksMu.Unlock()
+		return nil, err
+	}
+
+	if err := mu.Lock(); err != nil {
+		b.chunksMu.Unlock()
+		return nil, err
+	}
+
+	return func() {
+		mu.Unlock()
+		b.chunksMu.Unlock()
+	}, nil
+}
+
+func (b *Barrier) deleteChunks(ctx context.Context, manifest *apiv1.ChunkManifest) error {
+	for i := uint32(0); i < manifest.Count; i++ {
+		if err := b.store.Delete(ctx, chunkPath(manifest.Id, i)); err != nil && !storage.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encryptChunks splits the given value of bitem into chunks of chunkSize and encrypts each of them, setting the item's ChunkManifest.
+func encryptChunks(bitem *apiv1.BackendItem, key *apiv1.EncryptionKey, keyID uint32, val []byte, chunkSize int) ([]*apiv1.BackendItem, error) {
+	id := make([]byte, chunkIDLength)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, fmt.Errorf("unable to generate chunk ID: %s: %w", bitem.Key, err)
+	}
+
+	bitem.Chunks = &apiv1.ChunkManifest{
+		Id:    hex.EncodeToString(id),
+		Count: uint32((len(val) + chunkSize - 1) / chunkSize),
+		Size:  uint64(len(val)),
+	}
+
+	chunks := make([]*apiv1.BackendItem, 0, bitem.Chunks.Count)
+	for i := uint32(0); i < bitem.Chunks.Count; i++ {
+		end := (int(i) + 1) * chunkSize
+		if end > len(val) {
+			end = len(val)
+		}
+
+		encrypted, err := encryption.EncryptWithAD(key.Type, key.Key, val[int(i)*chunkSize:end], chunkAD(bitem, keyID, i))
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt chunk of item: %s: %w", bitem.Key, err)
+		}
+
+		// chunks are decrypted with the key of their item, so they do not record an encryption key ID
+		chunks = append(chunks, &apiv1.BackendItem{
+			Key:     chunkPath(bitem.Chunks.Id, i),
+			Val:     encrypted,
+			Version: itemVersion,
+		})
+	}
+
+	return chunks, nil
+}
+
+// decryptChunks reads and decrypts every chunk of the given item and reassembles its value.
+func (b *Barrier) decryptChunks(ctx context.Context, bitem *apiv1.BackendItem, key *apiv1.EncryptionKey, keyID uint32) ([]byte, error) {
+	val := make([]byte, 0, bitem.Chunks.Size)
+	for i := uint32(0); i < bitem.Chunks.Count; i++ {
+		chunk, err := b.store.Get(ctx, chunkPath(bitem.Chunks.Id, i))
+		if err != nil {
+			if storage.IsErrNotFound(err) {
+				return nil, fmt.Errorf("%w: %s", ErrChunksNotFound, bitem.Key)
+			}
+			return nil, err
+		}
+
+		decrypted, err := encryption.DecryptWithAD(key.Type, key.Key, chunk.Val, chunkAD(bitem, keyID, i))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt chunk %d of key: %s: %w", i, bitem.Key, err)
+		}
+
+		val = append(val, decrypted...)
+	}
+
+	if uint64(len(val)) != bitem.Chunks.Size {
+		return nil, fmt.Errorf("%w: %s", ErrChunksNotFound, bitem.Key)
+	}
+
+	return val, nil
+}
+
+// compress compresses the given value, unless that does not make it smaller.
+func compress(val []byte) ([]byte, apiv1.Compression, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(val); err != nil {
+		return nil, apiv1.Compression_UNCOMPRESSED, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, apiv1.Compression_UNCOMPRESSED, err
+	}
+
+	if buf.Len() >= len(val) {
+		return val, apiv1.Compression_UNCOMPRESSED, nil
+	}
+
+	return buf.Bytes(), apiv1.Compression_GZIP, nil
+}
+
+func decompress(val []byte, compression apiv1.Compression) ([]byte, error) {
+	switch compression {
+	case apiv1.Compression_UNCOMPRESSED:
+		return val, nil
+	case apiv1.Compression_GZIP:
+		r, err := gzip.NewReader(bytes.NewReader(val))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		return ioutil.ReadAll(r)
+	default:
+		return nil, fmt.Errorf("unknown compression: %s", compression.String())
+	}
+}
+
+// payloadAD binds the encrypted value of an item to its storage key, the ID of the key it was encrypted with, its compression and its expiry.
+// The ID is zero for items with data keys, which outlive the encryption key they are wrapped by.
+func payloadAD(bitem *apiv1.BackendItem, keyID uint32) []byte {
+	ad := associatedData(bitem.Key, keyID)
+	if bitem.Compression != apiv1.Compression_UNCOMPRESSED {
+		ad = append(ad, 0)
+		ad = append(ad, bitem.Compression.String()...)
+	}
+	if bitem.ExpiresAt != 0 {
+		ad = append(ad, 0, 'e')
+		ad = strconv.AppendInt(ad, bitem.ExpiresAt, 10)
+	}
+
+	return ad
+}
+
+// chunkAD binds a chunk to its item, its index, and the number of chunks, so chunks cannot be reordered, truncated, or moved between items.
+func chunkAD(bitem *apiv1.BackendItem, keyID uint32, index uint32) []byte {
+	ad := payloadAD(bitem, keyID)
+	ad = append(ad, 0)
+	ad = append(ad, bitem.Chunks.Id...)
+	ad = append(ad, 0)
+	ad = strconv.AppendUint(ad, uint64(index), 10)
+	ad = append(ad, '/')
+	return strconv.AppendUint(ad, uint64(bitem.Chunks.Count), 10)
+}
+
+// ChunkPaths returns the storage keys of the chunks of the given item. Items that are not split into chunks have none.
+func ChunkPaths(bitem *apiv1.BackendItem) []string {
+	if bitem.Chunks == nil {
+		return nil
+	}
+
+	paths := make([]string, bitem.Chunks.Count)
+	for i := range paths {
+		paths[i] = chunkPath(bitem.Chunks.Id, uint32(i))
+	}
+
+	return paths
+}
+
+func chunkPath(id string, index uint32) string {
+	return chunksPath + id + "/" + strconv.FormatUint(uint64(index), 10)
+}
@@ -4,6 +4,7 @@ package encryption
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
@@ -11,33 +12,119 @@ import (
 	"io"
 	"sync"
 
+	"github.com/google/tink/go/aead/subtle"
+	"golang.org/x/crypto/chacha20poly1305"
+
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 )
 
 const (
-	AES256GCMSize = 32
-	nonceSize     = 12
+	AES256GCMSize         = 32
+	XChaCha20Poly1305Size = chacha20poly1305.KeySize
+	AES256GCMSIVSize      = 32
 )
 
+// maxCachedAEADs bounds the number of cached AEADs. The cache is flushed entirely once it is full.
+const maxCachedAEADs = 1024
+
+type aeadCacheKey [sha256.Size]byte
+
+// aead encrypts and decrypts data along with the nonce it was encrypted with, so every cipher chooses its own nonces.
+type aead interface {
+	Encrypt(plaintext, additionalData []byte) ([]byte, error)
+	Decrypt(ciphertext, additionalData []byte) ([]byte, error)
+}
+
+// randomNonceAEAD prefixes the data encrypted by a cipher.AEAD with the random nonce it was encrypted with.
+type randomNonceAEAD struct {
+	cipher.AEAD
+}
+
+func (a randomNonceAEAD) Encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, a.NonceSize(), a.NonceSize()+len(plaintext)+a.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return a.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func (a randomNonceAEAD) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	nonceSize := a.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, fmt.Errorf("encrypted data is too short")
+	}
+
+	return a.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], additionalData)
+}
+
+// aeadCache holds AEADs by a keyed hash of their cipherType and key. The hash is keyed by a random secret of the process,
+// so neither collisions between keys nor the cache keys themselves can be used to recover keys or confuse them.
 type aeadCache struct {
-	m  map[uint64]cipher.AEAD
-	mu sync.RWMutex
+	m      map[aeadCacheKey]aead
+	secret []byte
+	mu     sync.RWMutex
+}
+
+func newAEADCache() *aeadCache {
+	secret := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		panic(fmt.Sprintf("unable to generate AEAD cache secret: %v", err))
+	}
+
+	return &aeadCache{
+		m:      map[aeadCacheKey]aead{},
+		secret: secret,
+	}
+}
+
+func (c *aeadCache) Key(cipherType apiv1.CipherType, key []byte) aeadCacheKey {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte{byte(cipherType)})
+	mac.Write(key)
+
+	var cacheKey aeadCacheKey
+	copy(cacheKey[:], mac.Sum(nil))
+	return cacheKey
 }
 
-func (c *aeadCache) Get(key uint64) cipher.AEAD {
+func (c *aeadCache) Get(key aeadCacheKey) aead {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	return c.m[key]
 }
 
-func (c *aeadCache) Put(key uint64, aead cipher.AEAD) {
+func (c *aeadCache) Put(key aeadCacheKey, a aead) {
 	c.mu.Lock()
-	c.m[key] = aead
+	if len(c.m) >= maxCachedAEADs {
+		c.m = map[aeadCacheKey]aead{}
+	}
+	c.m[key] = a
+	c.mu.Unlock()
+}
+
+func (c *aeadCache) Delete(key aeadCacheKey) {
+	c.mu.Lock()
+	delete(c.m, key)
+	c.mu.Unlock()
+}
+
+func (c *aeadCache) Flush() {
+	c.mu.Lock()
+	c.m = map[aeadCacheKey]aead{}
 	c.mu.Unlock()
 }
 
-var cache = aeadCache{
-	m: map[uint64]cipher.AEAD{},
+var cache = newAEADCache()
+
+// FlushCache drops every cached AEAD, along with the expanded keys they hold in memory.
+func FlushCache() {
+	cache.Flush()
+}
+
+// Evict drops the cached AEAD of the given cipherType and key, if any. Keys that are only used once, like the gatekeeper key, should be evicted after use.
+func Evict(cipherType apiv1.CipherType, key []byte) {
+	cache.Delete(cache.Key(cipherType, key))
 }
 
 // ValidateKey ensures the given key is the correct length.
@@ -46,13 +133,13 @@ func ValidateKey(cipherType apiv1.CipherType, key []byte) error {
 		return fmt.Errorf("key cannot be nil")
 	}
 
-	switch cipherType {
-	case apiv1.CipherType_AES256_GCM:
-		if len(key) != AES256GCMSize {
-			return fmt.Errorf("key must be %d bytes, not %d", AES256GCMSize, len(key))
-		}
-	default:
-		return fmt.Errorf("unknown cipherType: %s", cipherType.String())
+	size, err := keySize(cipherType)
+	if err != nil {
+		return err
+	}
+
+	if len(key) != size {
+		return fmt.Errorf("key must be %d bytes, not %d", size, len(key))
 	}
 
 	return nil
@@ -60,15 +147,12 @@ func ValidateKey(cipherType apiv1.CipherType, key []byte) error {
 
 // GenerateKey generates a new key for the given cipherType.
 func GenerateKey(cipherType apiv1.CipherType) ([]byte, error) {
-	var key []byte
-
-	switch cipherType {
-	case apiv1.CipherType_AES256_GCM:
-		key = make([]byte, AES256GCMSize)
-	default:
-		return nil, fmt.Errorf("unknown cipherType: %s", cipherType.String())
+	size, err := keySize(cipherType)
+	if err != nil {
+		return nil, err
 	}
 
+	key := make([]byte, size)
 	if _, err := io.ReadFull(rand.Reader, key); err != nil {
 		return nil, err
 	}
@@ -76,6 +160,19 @@ func GenerateKey(cipherType apiv1.CipherType) ([]byte, error) {
 	return key, nil
 }
 
+func keySize(cipherType apiv1.CipherType) (int, error) {
+	switch cipherType {
+	case apiv1.CipherType_AES256_GCM:
+		return AES256GCMSize, nil
+	case apiv1.CipherType_XCHACHA20_POLY1305:
+		return XChaCha20Poly1305Size, nil
+	case apiv1.CipherType_AES256_GCM_SIV:
+		return AES256GCMSIVSize, nil
+	default:
+		return 0, fmt.Errorf("unknown cipherType: %s", cipherType.String())
+	}
+}
+
 // Uint64Hash generates a uint64 hash from the given byte slice.
 func Uint64Hash(b []byte) uint64 {
 	hash := fnv.New64a()
@@ -91,67 +188,75 @@ func BytesHash(s string) []byte {
 
 // Encrypt data.
 func Encrypt(cipherType apiv1.CipherType, key, data []byte) ([]byte, error) {
-	if err := ValidateKey(cipherType, key); err != nil {
+	return EncryptWithAD(cipherType, key, data, nil)
+}
+
+// EncryptWithAD encrypts data and authenticates the given additional data, which must be provided again to decrypt the data.
+func EncryptWithAD(cipherType apiv1.CipherType, key, data, additionalData []byte) ([]byte, error) {
+	a, err := newAEAD(cipherType, key)
+	if err != nil {
 		return nil, err
 	}
 
-	switch cipherType {
-	case apiv1.CipherType_AES256_GCM:
-		aesgcm, nonce, err := newAES256GCM(key)
-		if err != nil {
-			return nil, err
-		}
-
-		encrypted := aesgcm.Seal(nil, nonce, data, nil)
-		return append(nonce, encrypted...), nil
-	default:
-		return nil, fmt.Errorf("unknown cipherType: %s", cipherType.String())
-	}
+	return a.Encrypt(data, additionalData)
 }
 
 // Decrypt data.
 func Decrypt(cipherType apiv1.CipherType, key, data []byte) ([]byte, error) {
-	if err := ValidateKey(cipherType, key); err != nil {
+	return DecryptWithAD(cipherType, key, data, nil)
+}
+
+// DecryptWithAD decrypts data that was encrypted with the given additional data.
+func DecryptWithAD(cipherType apiv1.CipherType, key, data, additionalData []byte) ([]byte, error) {
+	a, err := newAEAD(cipherType, key)
+	if err != nil {
 		return nil, err
 	}
 
-	switch cipherType {
-	case apiv1.CipherType_AES256_GCM:
-		aesgcm, _, err := newAES256GCM(key)
-		if err != nil {
-			return nil, err
-		}
-
-		return aesgcm.Open(nil, data[:nonceSize], data[nonceSize:], nil)
-	default:
-		return nil, fmt.Errorf("unknown cipherType: %s", cipherType.String())
-	}
+	return a.Decrypt(data, additionalData)
 }
 
-func newAES256GCM(key []byte) (cipher.AEAD, []byte, error) {
-	nonce := make([]byte, nonceSize)
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return nil, nil, err
+// newAEAD returns the cached AEAD for the given cipherType and key, creating it if required.
+// AES-256-GCM-SIV is provided by Tink, whose AEAD prefixes the data with its nonce just like the other ciphers.
+func newAEAD(cipherType apiv1.CipherType, key []byte) (aead, error) {
+	if err := ValidateKey(cipherType, key); err != nil {
+		return nil, err
 	}
 
-	var aesgcm cipher.AEAD
-	keyHash := Uint64Hash(key)
+	// the same key may be used with different ciphers, so both identify the AEAD
+	cacheKey := cache.Key(cipherType, key)
+	if a := cache.Get(cacheKey); a != nil {
+		return a, nil
+	}
 
-	if aead := cache.Get(keyHash); aead != nil {
-		aesgcm = aead
-	} else {
+	var a aead
+	switch cipherType {
+	case apiv1.CipherType_AES256_GCM:
 		block, err := aes.NewCipher(key)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-
-		aesgcm, err = cipher.NewGCM(block)
+		gcm, err := cipher.NewGCM(block)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-
-		cache.Put(keyHash, aesgcm)
+		a = randomNonceAEAD{gcm}
+	case apiv1.CipherType_XCHACHA20_POLY1305:
+		xchacha, err := chacha20poly1305.NewX(key)
+		if err != nil {
+			return nil, err
+		}
+		a = randomNonceAEAD{xchacha}
+	case apiv1.CipherType_AES256_GCM_SIV:
+		siv, err := subtle.NewAESGCMSIV(key)
+		if err != nil {
+			return nil, err
+		}
+		a = siv
+	default:
+		return nil, fmt.Errorf("unknown cipherType: %s", cipherType.String())
 	}
 
-	return aesgcm, nonce, nil
+	cache.Put(cacheKey, a)
+	return a, nil
 }
@@ -1,6 +1,7 @@
 package encryption
 
 import (
+	"encoding/hex"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
@@ -57,6 +58,26 @@ var _ = Describe("encryption", func() {
 		Expect(decrypted).To(BeEmpty())
 	})
 
+	It("authenticates additional data", func() {
+		encrypted, err := EncryptWithAD(apiv1.CipherType_AES256_GCM, key, data, []byte("/some/path"))
+		Expect(err).NotTo(HaveOccurred())
+
+		decrypted, err := DecryptWithAD(apiv1.CipherType_AES256_GCM, key, encrypted, []byte("/some/path"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal(data))
+
+		_, err = DecryptWithAD(apiv1.CipherType_AES256_GCM, key, encrypted, []byte("/another/path"))
+		Expect(err).To(HaveOccurred())
+
+		_, err = Decrypt(apiv1.CipherType_AES256_GCM, key, encrypted)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails to decrypt truncated data", func() {
+		_, err := Decrypt(apiv1.CipherType_AES256_GCM, key, encrypted[:4])
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("fails on unknown cipherType", func() {
 		cipherType := apiv1.CipherType(9999)
 
@@ -74,6 +95,133 @@ var _ = Describe("encryption", func() {
 	})
 })
 
+var _ = Describe("ciphers", func() {
+	for _, cipherType := range []apiv1.CipherType{apiv1.CipherType_XCHACHA20_POLY1305, apiv1.CipherType_AES256_GCM_SIV} {
+		cipherType := cipherType
+
+		It("encrypts and decrypts data with "+cipherType.String(), func() {
+			key, err := GenerateKey(cipherType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ValidateKey(cipherType, key)).To(Succeed())
+
+			encrypted, err := EncryptWithAD(cipherType, key, data, []byte("/some/path"))
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypted, err := DecryptWithAD(cipherType, key, encrypted, []byte("/some/path"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+
+			_, err = DecryptWithAD(cipherType, key, encrypted, []byte("/another/path"))
+			Expect(err).To(HaveOccurred())
+
+			_, err = Decrypt(cipherType, key, encrypted[:4])
+			Expect(err).To(HaveOccurred())
+
+			// the same key must not decrypt data encrypted with another cipher
+			_, err = Decrypt(apiv1.CipherType_AES256_GCM, key, encrypted)
+			Expect(err).To(HaveOccurred())
+		})
+	}
+
+	decodeHex := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		Expect(err).NotTo(HaveOccurred())
+		return b
+	}
+
+	It("matches the AES-256-GCM-SIV test vectors of RFC 8452", func() {
+		// the vectors of appendix C.2 use systematic inputs, `blocks` builds the plaintexts made of 16-byte blocks that start with the given bytes.
+		blocks := func(starts ...byte) string {
+			b := make([]byte, 16*len(starts))
+			for i, start := range starts {
+				b[16*i] = start
+			}
+			return hex.EncodeToString(b)
+		}
+
+		key := "0100000000000000000000000000000000000000000000000000000000000000"
+		nonce := "030000000000000000000000"
+		zeroKey := "0000000000000000000000000000000000000000000000000000000000000000"
+		zeroNonce := "000000000000000000000000"
+
+		vectors := []struct {
+			key, nonce, aad, plaintext, ciphertext string
+		}{
+			// appendix C.2
+			{key, nonce, "", "", "07f5f4169bbf55a8400cd47ea6fd400f"},
+			{key, nonce, "", "0100000000000000", "c2ef328e5c71c83b843122130f7364b761e0b97427e3df28"},
+			{key, nonce, "", "010000000000000000000000", "9aab2aeb3faa0a34aea8e2b18ca50da9ae6559e48fd10f6e5c9ca17e"},
+			{key, nonce, "", blocks(1), "85a01b63025ba19b7fd3ddfc033b3e76c9eac6fa700942702e90862383c6c366"},
+			{key, nonce, "", blocks(1, 2), "4a6a9db4c8c6549201b9edb53006cba821ec9cf850948a7c86c68ac7539d027fe819e63abcd020b006a976397632eb5d"},
+			{key, nonce, "", blocks(1, 2, 3), "c00d121893a9fa603f48ccc1ca3c57ce7499245ea0046db16c53c7c66fe717e39cf6c748837b61f6ee3adcee17534ed5790bc96880a99ba804bd12c0e6a22cc4"},
+			{key, nonce, "", blocks(1, 2, 3, 4), "c2d5160a1f8683834910acdafc41fbb1632d4a353e8b905ec9a5499ac34f96c7e1049eb080883891a4db8caaa1f99dd004d80487540735234e3744512c6f90ce112864c269fc0d9d88c61fa47e39aa08"},
+			{key, nonce, "01", "0200000000000000", "1de22967237a813291213f267e3b452f02d01ae33e4ec854"},
+			{key, nonce, "01", "020000000000000000000000", "163d6f9cc1b346cd453a2e4cc1a4a19ae800941ccdc57cc8413c277f"},
+			{key, nonce, "01", blocks(2), "c91545823cc24f17dbb0e9e807d5ec17b292d28ff61189e8e49f3875ef91aff7"},
+			{key, nonce, "01", blocks(2, 3), "07dad364bfc2b9da89116d7bef6daaaf6f255510aa654f920ac81b94e8bad365aea1bad12702e1965604374aab96dbbc"},
+			{key, nonce, "01", blocks(2, 3, 4), "c67a1f0f567a5198aa1fcc8e3f21314336f7f51ca8b1af61feac35a86416fa47fbca3b5f749cdf564527f2314f42fe2503332742b228c647173616cfd44c54eb"},
+			{key, nonce, "01", blocks(2, 3, 4, 5), "67fd45e126bfb9a79930c43aad2d36967d3f0e4d217c1e551f59727870beefc98cb933a8fce9de887b1e40799988db1fc3f91880ed405b2dd298318858467c895bde0285037c5de81e5b570a049b62a0"},
+			{key, nonce, "010000000000000000000000", "0200000000000000000000000000000003000000", "8932854141f6bbe652fddeee7d3f2f0995be8d637ab44c86af13b3cd505d7db19160ac03"},
+			{key, nonce, "010000000000000000000000000000000200", "0300000000000000000000000000000004000000", "43dd0163cdb48f9fe3212bf61b201976067f342bb879ad976d8242acc188ab59cabfe307"},
+			// appendix C.3, whose counters wrap around
+			{zeroKey, zeroNonce, "", "000000000000000000000000000000004db923dc793ee6497c76dcc03a98e108", "f3f80f2cf0cb2dd9c5984fcda908456cc537703b5ba70324a6793a7bf218d3eaffffffff000000000000000000000000"},
+			{zeroKey, zeroNonce, "", "eb3640277c7ffd1303c7a542d02d3e4c0000000000000000", "18ce4f0b8cb4d0cac65fea8f79257b20888e53e72299e56dffffffff000000000000000000000000"},
+		}
+		// nonces are chosen randomly when encrypting, so the vectors are checked by decrypting them along with their nonce.
+		for _, v := range vectors {
+			sealed := decodeHex(v.nonce + v.ciphertext)
+
+			opened, err := DecryptWithAD(apiv1.CipherType_AES256_GCM_SIV, decodeHex(v.key), sealed, decodeHex(v.aad))
+			Expect(err).NotTo(HaveOccurred(), "aad %q, plaintext %q", v.aad, v.plaintext)
+			Expect(opened).To(Equal(decodeHex(v.plaintext)))
+
+			_, err = DecryptWithAD(apiv1.CipherType_AES256_GCM_SIV, decodeHex(v.key), sealed, append(decodeHex(v.aad), 0))
+			Expect(err).To(HaveOccurred())
+
+			sealed[len(sealed)-1] ^= 1
+			_, err = DecryptWithAD(apiv1.CipherType_AES256_GCM_SIV, decodeHex(v.key), sealed, decodeHex(v.aad))
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
+
+var _ = Describe("aead cache", func() {
+	It("caches AEADs by cipher and key", func() {
+		key, err := GenerateKey(apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = Encrypt(apiv1.CipherType_AES256_GCM, key, data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.Get(cache.Key(apiv1.CipherType_AES256_GCM, key))).NotTo(BeNil())
+		Expect(cache.Get(cache.Key(apiv1.CipherType_AES256_GCM_SIV, key))).To(BeNil())
+
+		By("evicting a single key")
+		Evict(apiv1.CipherType_AES256_GCM, key)
+		Expect(cache.Get(cache.Key(apiv1.CipherType_AES256_GCM, key))).To(BeNil())
+
+		By("flushing every key")
+		_, err = Encrypt(apiv1.CipherType_AES256_GCM, key, data)
+		Expect(err).NotTo(HaveOccurred())
+
+		FlushCache()
+		Expect(cache.Get(cache.Key(apiv1.CipherType_AES256_GCM, key))).To(BeNil())
+	})
+
+	It("bounds the number of cached AEADs", func() {
+		for i := 0; i <= maxCachedAEADs; i++ {
+			key, err := GenerateKey(apiv1.CipherType_AES256_GCM)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = Encrypt(apiv1.CipherType_AES256_GCM, key, data)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		cache.mu.RLock()
+		defer cache.mu.RUnlock()
+		Expect(len(cache.m)).To(BeNumerically("<=", maxCachedAEADs))
+	})
+})
+
 var _ = Describe("hash", func() {
 	It("creates a Hash object", func() {
 		h := FromHash(data)
@@ -96,3 +244,21 @@ var _ = Describe("hash", func() {
 		Expect(h.Uint64String()).To(Equal("12099483423728366993"))
 	})
 })
+
+// BenchmarkAES256GCMSIV measures sealing a chunk of the default size of the barrier's chunked items.
+func BenchmarkAES256GCMSIV(b *testing.B) {
+	key, err := GenerateKey(apiv1.CipherType_AES256_GCM_SIV)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	plaintext := make([]byte, 512*1024)
+	b.SetBytes(int64(len(plaintext)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := Encrypt(apiv1.CipherType_AES256_GCM_SIV, key, plaintext); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
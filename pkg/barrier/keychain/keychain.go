@@ -3,10 +3,12 @@ package keychain
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/barrier/securemem"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -16,26 +18,45 @@ const (
 	maxKeys      = 1000 // assuming each key marshaled and encrypted consumes ~70B of storage, and we want to keep the size <100KB, then 1,000 keys is about the max we'd want and a rekey would be required to clean up old keys.
 )
 
-// Keychain object.
+// Keychain object. The key material of every key is held in secure memory, which is zeroed when the key is removed or the Keychain is destroyed.
+// Keys that are removed while the Keychain is acquired are only zeroed once every user has released it.
 type Keychain struct {
-	keys []*apiv1.EncryptionKey
+	keys       []*apiv1.EncryptionKey
+	buffers    map[uint32]*securemem.Buffer
+	cipherType apiv1.CipherType
+
+	// syncKey encrypts the records that distribute keys added by rotations to other replicas. It is never used to encrypt items.
+	syncKey *securemem.Buffer
+
+	// users is the number of users that acquired the Keychain, and retired holds the keys that were removed while it was acquired.
+	users   int
+	retired []retiredKey
 
 	mu sync.RWMutex
 }
 
+type retiredKey struct {
+	key *apiv1.EncryptionKey
+	buf *securemem.Buffer
+}
+
 // NewKeychain returns a new Keychain object.
 func NewKeychain() *Keychain {
 	return &Keychain{
-		keys: []*apiv1.EncryptionKey{},
+		keys:    []*apiv1.EncryptionKey{},
+		buffers: map[uint32]*securemem.Buffer{},
 	}
 }
 
 // FromSnapshot returns a new Keychain object from an encrypted KeychainSnapshot.
 func FromSnapshot(gatekeeperKey []byte, snapshot []byte) (*Keychain, error) {
+	defer encryption.Evict(apiv1.CipherType_AES256_GCM, gatekeeperKey)
+
 	data, err := encryption.Decrypt(apiv1.CipherType_AES256_GCM, gatekeeperKey, snapshot)
 	if err != nil {
 		return nil, err
 	}
+	defer securemem.Wipe(data)
 
 	snap := &apiv1.KeychainSnapshot{}
 	if err := proto.Unmarshal(data, snap); err != nil {
@@ -46,9 +67,25 @@ func FromSnapshot(gatekeeperKey []byte, snapshot []byte) (*Keychain, error) {
 		return nil, fmt.Errorf("unknown snapshot format")
 	}
 
-	return &Keychain{
-		keys: snap.Keys,
-	}, nil
+	k := NewKeychain()
+	for _, key := range snap.Keys {
+		secured, err := k.secureKey(key)
+		securemem.Wipe(key.Key)
+		if err != nil {
+			k.Destroy()
+			return nil, err
+		}
+		k.keys = append(k.keys, secured)
+	}
+
+	if len(snap.SyncKey) > 0 {
+		if k.syncKey, err = securemem.FromBytes(snap.SyncKey); err != nil {
+			k.Destroy()
+			return nil, err
+		}
+	}
+
+	return k, nil
 }
 
 // Snapshot returns a new encrypted KeychainSnapshot.
@@ -61,11 +98,16 @@ func (k *Keychain) Snapshot(gatekeeperKey []byte) ([]byte, error) {
 		Keys:    k.keys,
 		Created: timestamppb.Now(),
 	}
+	if k.syncKey != nil {
+		snap.SyncKey = k.syncKey.Bytes()
+	}
 
 	data, err := proto.Marshal(snap)
 	if err != nil {
 		return nil, err
 	}
+	defer securemem.Wipe(data)
+	defer encryption.Evict(apiv1.CipherType_AES256_GCM, gatekeeperKey)
 
 	return encryption.Encrypt(apiv1.CipherType_AES256_GCM, gatekeeperKey, data)
 }
@@ -96,7 +138,7 @@ func (k *Keychain) ActiveKey() *apiv1.EncryptionKey {
 	return k.keys[(len(k.keys) - 1)]
 }
 
-// Add a new Key to the Keychain.
+// Add a new Key to the Keychain. Its key material is copied into secure memory owned by the Keychain.
 func (k *Keychain) Add(key *apiv1.EncryptionKey) error {
 	k.mu.Lock()
 	defer k.mu.Unlock()
@@ -123,11 +165,105 @@ func (k *Keychain) Add(key *apiv1.EncryptionKey) error {
 		}
 	}
 
-	k.keys = append(k.keys, key)
+	secured, err := k.secureKey(key)
+	if err != nil {
+		return err
+	}
+
+	k.keys = append(k.keys, secured)
+	return nil
+}
+
+// Merge adds copies of the given keys that are not already on the Keychain. Keys are kept ordered by ID, so the key with the highest ID becomes the active key.
+// Returns the number of keys that were added.
+func (k *Keychain) Merge(keys []*apiv1.EncryptionKey) (int, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	existing := map[uint32]struct{}{}
+	for _, key := range k.keys {
+		existing[key.Id] = struct{}{}
+	}
+
+	merged := append([]*apiv1.EncryptionKey{}, k.keys...)
+	for _, key := range keys {
+		if key == nil || key.Id == 0 {
+			return 0, fmt.Errorf("cannot merge a key without an ID")
+		}
+		if _, ok := existing[key.Id]; ok {
+			continue
+		}
+
+		existing[key.Id] = struct{}{}
+		merged = append(merged, key)
+	}
+
+	if len(merged) > maxKeys {
+		return 0, fmt.Errorf("the maximum number of keys on the keychain has been exceeded, a rekey operation is required to remove old encryption keys")
+	}
+
+	for i := len(k.keys); i < len(merged); i++ {
+		secured, err := k.secureKey(merged[i])
+		if err != nil {
+			for _, added := range merged[len(k.keys):i] {
+				k.destroyKey(added)
+			}
+			return 0, err
+		}
+		merged[i] = secured
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Id < merged[j].Id
+	})
+
+	added := len(merged) - len(k.keys)
+	k.keys = merged
+	return added, nil
+}
+
+// Keys returns the keys on the Keychain ordered by ID.
+func (k *Keychain) Keys() []*apiv1.EncryptionKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return append([]*apiv1.EncryptionKey{}, k.keys...)
+}
+
+// SyncKey returns the key that encrypts the records which distribute keys added by rotations to other replicas, or nil if the Keychain
+// was stored by an older version without one. The returned key must not be used after the Keychain is destroyed.
+func (k *Keychain) SyncKey() []byte {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if k.syncKey == nil {
+		return nil
+	}
+
+	return k.syncKey.Bytes()
+}
+
+// GenerateSyncKey generates a new sync key, replacing the current one.
+func (k *Keychain) GenerateSyncKey() error {
+	key, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	if err != nil {
+		return err
+	}
+
+	buf, err := securemem.FromBytes(key)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.destroySyncKey()
+	k.syncKey = buf
 	return nil
 }
 
-// Remove a Key from the Keychain.
+// Remove a Key from the Keychain. Its key material is zeroed once the Keychain is no longer acquired.
 func (k *Keychain) Remove(id uint32) {
 	k.mu.Lock()
 	defer k.mu.Unlock()
@@ -140,22 +276,139 @@ func (k *Keychain) Remove(id uint32) {
 	for _, key := range k.keys {
 		if key.Id != id {
 			keys = append(keys, key)
+		} else {
+			k.destroyKey(key)
 		}
 	}
 	k.keys = keys
 }
 
-// Rotate generates a new key and activates it.
+// Acquire keeps the key material of the Keychain in memory until the returned function is called, even if keys are removed or the Keychain
+// is destroyed in the meantime. Keys returned by the Keychain can be used until then. The returned function must be called exactly once.
+func (k *Keychain) Acquire() func() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.users++
+	return k.release
+}
+
+func (k *Keychain) release() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.users--
+	if k.users > 0 {
+		return
+	}
+
+	for _, retired := range k.retired {
+		wipeKey(retired.key, retired.buf)
+	}
+	k.retired = nil
+}
+
+// Destroy removes every Key from the Keychain and zeroes their key material once the Keychain is no longer acquired.
+// Keys returned by the Keychain must not be used afterwards, unless it was acquired before.
+func (k *Keychain) Destroy() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, key := range k.keys {
+		k.destroyKey(key)
+	}
+	k.keys = []*apiv1.EncryptionKey{}
+	k.destroySyncKey()
+}
+
+// SetCipherType sets the cipher used by keys that are generated by future rotations. Existing keys keep the cipher they were created with.
+func (k *Keychain) SetCipherType(cipherType apiv1.CipherType) error {
+	if _, ok := apiv1.CipherType_name[int32(cipherType)]; !ok {
+		return fmt.Errorf("unknown cipherType: %s", cipherType.String())
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.cipherType = cipherType
+	return nil
+}
+
+// CipherType gets the cipher used by keys that are generated by future rotations.
+func (k *Keychain) CipherType() apiv1.CipherType {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.cipherType
+}
+
+// Rotate generates a new key using the Keychain's cipherType and activates it.
 func (k *Keychain) Rotate() error {
-	keyBS, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	cipherType := k.CipherType()
+
+	keyBS, err := encryption.GenerateKey(cipherType)
 	if err != nil {
 		return err
 	}
 
+	defer securemem.Wipe(keyBS)
+
 	key := &apiv1.EncryptionKey{
-		Type: apiv1.CipherType_AES256_GCM,
+		Type: cipherType,
 		Key:  keyBS,
 	}
 
 	return k.Add(key)
 }
+
+// secureKey returns a copy of the given key whose key material is held in a secure buffer owned by the Keychain.
+// The given key may be owned by another Keychain, so its key material is left untouched.
+func (k *Keychain) secureKey(key *apiv1.EncryptionKey) (*apiv1.EncryptionKey, error) {
+	buf, err := securemem.New(len(key.Key))
+	if err != nil {
+		return nil, err
+	}
+	copy(buf.Bytes(), key.Key)
+
+	secured := &apiv1.EncryptionKey{
+		Id:      key.Id,
+		Type:    key.Type,
+		Key:     buf.Bytes(),
+		Created: key.Created,
+	}
+	k.buffers[key.Id] = buf
+	return secured, nil
+}
+
+// destroyKey zeroes the key material of the given key and drops its cached AEAD. If the Keychain is acquired, this is deferred until it is released.
+func (k *Keychain) destroyKey(key *apiv1.EncryptionKey) {
+	buf, ok := k.buffers[key.Id]
+	if !ok {
+		return
+	}
+
+	delete(k.buffers, key.Id)
+	if k.users > 0 {
+		k.retired = append(k.retired, retiredKey{key: key, buf: buf})
+		return
+	}
+
+	wipeKey(key, buf)
+}
+
+// destroySyncKey zeroes the sync key and drops its cached AEAD. The sync key is never used by acquired users, so it is zeroed immediately.
+func (k *Keychain) destroySyncKey() {
+	if k.syncKey == nil {
+		return
+	}
+
+	encryption.Evict(apiv1.CipherType_AES256_GCM, k.syncKey.Bytes())
+	k.syncKey.Destroy()
+	k.syncKey = nil
+}
+
+func wipeKey(key *apiv1.EncryptionKey, buf *securemem.Buffer) {
+	encryption.Evict(key.Type, key.Key)
+	buf.Destroy()
+	key.Key = nil
+}
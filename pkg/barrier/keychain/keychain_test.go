@@ -45,6 +45,25 @@ var _ = Describe("keychain", func() {
 		Expect(kc.ActiveKey().Key).NotTo(BeEmpty())
 	})
 
+	It("can rotate keys using the configured cipher", func() {
+		local := NewKeychain()
+		Expect(local.CipherType()).To(Equal(apiv1.CipherType_AES256_GCM))
+
+		err := local.Rotate()
+		Expect(err).NotTo(HaveOccurred())
+
+		err = local.SetCipherType(apiv1.CipherType_XCHACHA20_POLY1305)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = local.Rotate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(local.ActiveKey().Type).To(Equal(apiv1.CipherType_XCHACHA20_POLY1305))
+		Expect(local.Key(1).Type).To(Equal(apiv1.CipherType_AES256_GCM))
+
+		err = local.SetCipherType(apiv1.CipherType(9999))
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("fails to add an existing key", func() {
 		key := &apiv1.EncryptionKey{
 			Id:   1,
@@ -56,6 +75,35 @@ var _ = Describe("keychain", func() {
 		Expect(err.Error()).To(ContainSubstring("already exists"))
 	})
 
+	It("can merge keys from another keychain", func() {
+		local := NewKeychain()
+		err := local.Rotate()
+		Expect(err).NotTo(HaveOccurred())
+
+		other := NewKeychain()
+		for _, key := range local.Keys() {
+			err := other.Add(key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		err = other.Rotate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.ActiveKey().Id).To(Equal(uint32(2)))
+
+		added, err := local.Merge(other.Keys())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(added).To(Equal(1))
+		Expect(local.ActiveKey().Id).To(Equal(uint32(2)))
+		Expect(local.ActiveKey().Key).To(Equal(other.ActiveKey().Key))
+
+		added, err = local.Merge(other.Keys())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(added).To(BeZero())
+
+		_, err = local.Merge([]*apiv1.EncryptionKey{{Id: 0, Key: []byte("fake")}})
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("can take an encrypted snapshot", func() {
 		var err error
 		gatekeeperKey, err = encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
@@ -96,6 +144,27 @@ var _ = Describe("keychain", func() {
 		Expect(newKC).To(BeNil())
 	})
 
+	It("keeps its sync key in snapshots", func() {
+		local := NewKeychain()
+		Expect(local.Rotate()).To(Succeed())
+		Expect(local.SyncKey()).To(BeNil())
+
+		Expect(local.GenerateSyncKey()).To(Succeed())
+		syncKey := append([]byte{}, local.SyncKey()...)
+		Expect(syncKey).To(HaveLen(encryption.AES256GCMSize))
+
+		snap, err := local.Snapshot(gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		restored, err := FromSnapshot(gatekeeperKey, snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.SyncKey()).To(Equal(syncKey))
+
+		restored.Destroy()
+		Expect(restored.SyncKey()).To(BeNil())
+		local.Destroy()
+	})
+
 	It("can get key by ID", func() {
 		key := kc.Key(1)
 		Expect(key).NotTo(BeNil())
@@ -107,4 +176,52 @@ var _ = Describe("keychain", func() {
 		Expect(len(kc.keys)).To(Equal(1))
 		Expect(kc.ActiveKey().Id).To(Equal(uint32(1)))
 	})
+
+	It("holds key material in secure memory", func() {
+		key := &apiv1.EncryptionKey{Type: apiv1.CipherType_AES256_GCM, Key: []byte("32characters1234567890abcdefghij")}
+		local := NewKeychain()
+		err := local.Add(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		added := local.Key(key.Id)
+		Expect(added.Key).To(Equal(key.Key))
+		Expect(&added.Key[0]).NotTo(BeIdenticalTo(&key.Key[0]))
+		Expect(local.buffers).To(HaveKey(key.Id))
+
+		By("copying merged keys instead of sharing them")
+		other := NewKeychain()
+		_, err = other.Merge(local.Keys())
+		Expect(err).NotTo(HaveOccurred())
+
+		local.Destroy()
+		Expect(local.Keys()).To(BeEmpty())
+		Expect(local.buffers).To(BeEmpty())
+		Expect(added.Key).To(BeNil())
+
+		Expect(other.Key(key.Id).Key).To(Equal(key.Key))
+		other.Destroy()
+	})
+
+	It("zeroes removed keys once it is released", func() {
+		local := NewKeychain()
+		Expect(local.Rotate()).To(Succeed())
+		Expect(local.Rotate()).To(Succeed())
+		first, second := local.Key(1), local.Key(2)
+
+		release := local.Acquire()
+		nested := local.Acquire()
+		local.Remove(1)
+		local.Destroy()
+		Expect(local.Keys()).To(BeEmpty())
+		Expect(first.Key).NotTo(BeEmpty())
+		Expect(second.Key).NotTo(BeEmpty())
+
+		nested()
+		Expect(first.Key).NotTo(BeEmpty())
+
+		release()
+		Expect(first.Key).To(BeNil())
+		Expect(second.Key).To(BeNil())
+		Expect(local.retired).To(BeEmpty())
+	})
 })
@@ -0,0 +1,633 @@
+package barrier
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/hkdf"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/barrier/securemem"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// namespacesPath holds the items of every namespace, e.g. `namespaces/<namespace>/kv/<key>`.
+	namespacesPath = secretsPath + "namespaces/"
+
+	// NamespaceSecretsPath holds a random secret for each namespace, which is mixed into the namespace's derived encryption keys.
+	// Destroying a namespace's secret makes every item in the namespace undecryptable.
+	NamespaceSecretsPath = barrierPath + "namespaces/"
+
+	// namespaceKeysVersion is the first item version that encrypts items in namespaces with derived namespace keys.
+	namespaceKeysVersion = 2
+
+	// namespaceSecretVersion is the first version of namespace secret records that hold a NamespaceSecret. Older records hold the raw secret.
+	namespaceSecretVersion = 3
+
+	namespaceSecretSize   = 32
+	namespaceKeyInfoLabel = "kstash/namespace/"
+)
+
+var (
+	ErrInvalidNamespace    = fmt.Errorf("invalid namespace")
+	ErrNamespaceShredded   = fmt.Errorf("namespace key material has been destroyed")
+	ErrNamespaceLocked     = fmt.Errorf("namespace is locked, its wrapping key must be supplied to unlock it")
+	ErrNotTenantKeyed      = fmt.Errorf("namespace does not have a wrapping key")
+	ErrInvalidWrappingKey  = fmt.Errorf("invalid namespace wrapping key")
+	ErrWrappingKeyRequired = fmt.Errorf("the current wrapping key is required to replace the wrapping key of a namespace")
+)
+
+// ConfigureNamespaceKey wraps the secret of the given namespace with a wrapping key supplied by the namespace's tenant. The namespace is left unlocked,
+// but is locked again whenever the barrier is sealed, until the tenant supplies the wrapping key with UnlockNamespace. Replacing the wrapping key
+// of a namespace that already has one requires its current wrapping key.
+// Copies of the namespace's previous secret may remain in the storage backend's history, snapshots or migrations, so a new secret is generated
+// when the wrapping key is first configured, and every item in the namespace is re-encrypted with it.
+func (b *Barrier) ConfigureNamespaceKey(ctx context.Context, namespace string, currentKey, wrappingKey []byte) error {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return err
+	}
+	if sealed {
+		return ErrBarrierSealed
+	}
+
+	namespace, err = validNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	if err := encryption.ValidateKey(apiv1.CipherType_AES256_GCM, wrappingKey); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWrappingKey, err)
+	}
+
+	unlock, err := b.lockNamespace(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var secret, previous []byte
+	nsSecret, err := b.retrieveNamespaceSecret(ctx, namespace)
+	switch {
+	case err != nil && !storage.IsErrNotFound(err):
+		return err
+	case err == nil && nsSecret.TenantWrapped:
+		if currentKey == nil {
+			return ErrWrappingKeyRequired
+		}
+
+		secret, err = unwrapNamespaceSecret(namespace, currentKey, nsSecret.Secret)
+		if err != nil {
+			return err
+		}
+		// the items of the namespace may not have been re-encrypted completely when the wrapping key was configured
+		previous = nsSecret.PreviousSecret
+	default:
+		if err == nil {
+			previous = nsSecret.Secret
+		}
+
+		secret = make([]byte, namespaceSecretSize)
+		if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+			return fmt.Errorf("unable to generate secret for namespace %s: %w", namespace, err)
+		}
+	}
+	defer securemem.Wipe(secret)
+	defer securemem.Wipe(previous)
+
+	wrapped, err := encryption.EncryptWithAD(apiv1.CipherType_AES256_GCM, wrappingKey, secret, []byte(NamespaceSecretsPath+namespace))
+	if err != nil {
+		return fmt.Errorf("unable to wrap secret of namespace %s: %w", namespace, err)
+	}
+
+	// the previous secret is kept until every item has been re-encrypted, so the items stay readable in the meantime
+	nsSecret = &apiv1.NamespaceSecret{Secret: wrapped, TenantWrapped: true, PreviousSecret: previous}
+	if err := b.persistNamespaceSecret(ctx, namespace, nsSecret); err != nil {
+		return err
+	}
+
+	if err := b.setUnlockedNamespace(namespace, secret); err != nil {
+		return err
+	}
+	if len(previous) == 0 {
+		return nil
+	}
+
+	if err := b.reencryptNamespace(ctx, namespace); err != nil {
+		return err
+	}
+
+	nsSecret.PreviousSecret = nil
+	return b.persistNamespaceSecret(ctx, namespace, nsSecret)
+}
+
+// reencryptNamespace encrypts every item in the given namespace with the namespace's current secret.
+func (b *Barrier) reencryptNamespace(ctx context.Context, namespace string) error {
+	chunkSize, _ := b.itemSettings()
+
+	return storage.Walk(ctx, b.store, namespacesPath+namespace+"/", func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+
+		mu, err := b.store.LockKey(ctx, key)
+		if err != nil {
+			return err
+		}
+		if err := mu.Lock(); err != nil {
+			return err
+		}
+		defer mu.Unlock()
+
+		bitem, err := b.store.Get(ctx, key)
+		if err != nil {
+			if storage.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		item, err := b.DecyptItem(ctx, bitem)
+		if err != nil {
+			return err
+		}
+
+		reencrypted, chunks, err := b.encryptItem(ctx, item, chunkSize)
+		if err != nil {
+			return err
+		}
+
+		return b.putItem(ctx, reencrypted, chunks)
+	})
+}
+
+// UnlockNamespace unwraps the secret of the given namespace with the wrapping key supplied by its tenant. Each replica must be unlocked separately.
+func (b *Barrier) UnlockNamespace(ctx context.Context, namespace string, wrappingKey []byte) error {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return err
+	}
+	if sealed {
+		return ErrBarrierSealed
+	}
+
+	namespace, err = validNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	nsSecret, err := b.retrieveNamespaceSecret(ctx, namespace)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return ErrNotTenantKeyed
+		}
+		return err
+	}
+	if !nsSecret.TenantWrapped {
+		return ErrNotTenantKeyed
+	}
+
+	secret, err := unwrapNamespaceSecret(namespace, wrappingKey, nsSecret.Secret)
+	if err != nil {
+		return err
+	}
+	defer securemem.Wipe(secret)
+
+	return b.setUnlockedNamespace(namespace, secret)
+}
+
+// LockNamespace zeroes the unwrapped secret of the given namespace, so its items cannot be read or written until it is unlocked again.
+func (b *Barrier) LockNamespace(namespace string) error {
+	namespace, err := validNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	return b.setUnlockedNamespace(namespace, nil)
+}
+
+// NamespaceStatus determines if the given namespace has a wrapping key supplied by its tenant, and if it is locked.
+func (b *Barrier) NamespaceStatus(ctx context.Context, namespace string) (tenantKeyed bool, locked bool, err error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	if sealed {
+		return false, false, ErrBarrierSealed
+	}
+
+	namespace, err = validNamespace(namespace)
+	if err != nil {
+		return false, false, err
+	}
+
+	nsSecret, err := b.retrieveNamespaceSecret(ctx, namespace)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+	if !nsSecret.TenantWrapped {
+		return false, false, nil
+	}
+
+	return true, !b.isNamespaceUnlocked(namespace), nil
+}
+
+// ShredNamespace destroys the key material of the given namespace and deletes every item in it. Copies of the namespace's items,
+// e.g. in snapshots, can no longer be decrypted once every snapshot that contains the namespace's secret has been destroyed as well.
+// Returns the number of deleted items.
+func (b *Barrier) ShredNamespace(ctx context.Context, namespace string) (int, error) {
+	sealed, err := b.IsSealed(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if sealed {
+		return 0, ErrBarrierSealed
+	}
+
+	namespace, err = validNamespace(namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	unlock, err := b.lockNamespace(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	if err := b.store.Delete(ctx, NamespaceSecretsPath+namespace); err != nil && !storage.IsErrNotFound(err) {
+		return 0, err
+	}
+	b.setUnlockedNamespace(namespace, nil)
+
+	deleted := 0
+	err = storage.Walk(ctx, b.store, namespacesPath+namespace+"/", func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+
+		if err := b.deleteItem(ctx, key); err != nil && !storage.IsErrNotFound(err) {
+			return err
+		}
+		deleted++
+		return nil
+	})
+
+	return deleted, err
+}
+
+// itemKey returns the key used to encrypt the item stored at key with the given encryption key and item version, along with a function
+// that destroys it once it is no longer used. Items in namespaces are encrypted by a key derived from the encryption key, the namespace's secret,
+// and the barrier's ID, which is held in secure memory. If create is true, a secret is created for namespaces that do not have one yet.
+func (b *Barrier) itemKey(ctx context.Context, encKey *apiv1.EncryptionKey, key string, version uint32, create bool) (*apiv1.EncryptionKey, func(), error) {
+	namespace := namespaceOf(key)
+	if version < namespaceKeysVersion || len(namespace) == 0 {
+		return encKey, func() {}, nil
+	}
+
+	secret, release, err := b.namespaceSecret(ctx, namespace, create)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return b.deriveItemKey(ctx, encKey, namespace, secret)
+}
+
+// withItemKey calls fn with the key of the given BackendItem, which was encrypted by the given encryption key. Items in namespaces whose
+// items are being re-encrypted with a new secret may still be encrypted with the previous secret, so fn is called with the key derived from
+// the previous secret as well if it fails.
+func (b *Barrier) withItemKey(ctx context.Context, encKey *apiv1.EncryptionKey, bitem *apiv1.BackendItem, fn func(itemKey *apiv1.EncryptionKey) error) error {
+	itemKey, destroy, err := b.itemKey(ctx, encKey, bitem.Key, bitem.Version, false)
+	if err != nil {
+		return err
+	}
+	err = fn(itemKey)
+	destroy()
+
+	namespace := namespaceOf(bitem.Key)
+	if err == nil || bitem.Version < namespaceKeysVersion || len(namespace) == 0 {
+		return err
+	}
+
+	nsSecret, perr := b.retrieveNamespaceSecret(ctx, namespace)
+	if perr != nil || len(nsSecret.PreviousSecret) == 0 {
+		return err
+	}
+	defer securemem.Wipe(nsSecret.PreviousSecret)
+
+	previousKey, destroyPrevious, perr := b.deriveItemKey(ctx, encKey, namespace, nsSecret.PreviousSecret)
+	if perr != nil {
+		return err
+	}
+	defer destroyPrevious()
+
+	if fn(previousKey) != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deriveItemKey derives the key of items in the given namespace from the given encryption key and namespace secret.
+func (b *Barrier) deriveItemKey(ctx context.Context, encKey *apiv1.EncryptionKey, namespace string, secret []byte) (*apiv1.EncryptionKey, func(), error) {
+	id, err := b.barrierID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ikm := make([]byte, 0, len(encKey.Key)+len(secret))
+	ikm = append(ikm, encKey.Key...)
+	ikm = append(ikm, secret...)
+	defer securemem.Wipe(ikm)
+
+	derived, err := securemem.New(len(encKey.Key))
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, id, []byte(namespaceKeyInfoLabel+namespace)), derived.Bytes()); err != nil {
+		derived.Destroy()
+		return nil, nil, fmt.Errorf("unable to derive key for namespace %s: %w", namespace, err)
+	}
+
+	itemKey := &apiv1.EncryptionKey{
+		Id:   encKey.Id,
+		Type: encKey.Type,
+		Key:  derived.Bytes(),
+	}
+	destroy := func() {
+		encryption.Evict(itemKey.Type, itemKey.Key)
+		derived.Destroy()
+	}
+
+	return itemKey, destroy, nil
+}
+
+// namespaceSecret gets the secret of the given namespace, creating it if it does not exist and create is true.
+// The returned function must be called once the secret is no longer used, which zeroes it unless it is held by an unlocked namespace.
+func (b *Barrier) namespaceSecret(ctx context.Context, namespace string, create bool) ([]byte, func(), error) {
+	nsSecret, err := b.retrieveNamespaceSecret(ctx, namespace)
+	if err == nil {
+		return b.unwrappedNamespaceSecret(namespace, nsSecret)
+	}
+	if !storage.IsErrNotFound(err) {
+		return nil, nil, err
+	}
+	if !create {
+		return nil, nil, fmt.Errorf("%w: %s", ErrNamespaceShredded, namespace)
+	}
+
+	return b.createNamespaceSecret(ctx, namespace)
+}
+
+func (b *Barrier) createNamespaceSecret(ctx context.Context, namespace string) ([]byte, func(), error) {
+	unlock, err := b.lockNamespace(ctx, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+
+	// another replica may have created the secret while waiting for the lock
+	nsSecret, err := b.retrieveNamespaceSecret(ctx, namespace)
+	if err == nil {
+		return b.unwrappedNamespaceSecret(namespace, nsSecret)
+	}
+	if !storage.IsErrNotFound(err) {
+		return nil, nil, err
+	}
+
+	secret := make([]byte, namespaceSecretSize)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return nil, nil, fmt.Errorf("unable to generate secret for namespace %s: %w", namespace, err)
+	}
+
+	if err := b.persistNamespaceSecret(ctx, namespace, &apiv1.NamespaceSecret{Secret: secret}); err != nil {
+		securemem.Wipe(secret)
+		return nil, nil, err
+	}
+
+	return secret, func() { securemem.Wipe(secret) }, nil
+}
+
+// unwrappedNamespaceSecret returns the given namespace's secret, which must have been unlocked if it is wrapped by the tenant's wrapping key.
+// The secret of an unlocked namespace cannot be locked until the returned function is called.
+func (b *Barrier) unwrappedNamespaceSecret(namespace string, nsSecret *apiv1.NamespaceSecret) ([]byte, func(), error) {
+	if !nsSecret.TenantWrapped {
+		return nsSecret.Secret, func() { securemem.Wipe(nsSecret.Secret) }, nil
+	}
+
+	b.nsMu.RLock()
+	buf := b.unlocked[namespace]
+	if buf == nil {
+		b.nsMu.RUnlock()
+		return nil, nil, fmt.Errorf("%w: %s", ErrNamespaceLocked, namespace)
+	}
+
+	return buf.Bytes(), b.nsMu.RUnlock, nil
+}
+
+// retrieveNamespaceSecret gets and decrypts the stored secret of the given namespace. Returns `storage.ErrNotFound` if the namespace has no secret.
+func (b *Barrier) retrieveNamespaceSecret(ctx context.Context, namespace string) (*apiv1.NamespaceSecret, error) {
+	path := NamespaceSecretsPath + namespace
+
+	bitem, err := b.store.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// the secret may have been created by another replica with a key this replica has not seen yet
+	if !b.hasKey(bitem.EncryptionKeyID) {
+		if _, err := b.ReloadKeychain(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	kc, release, err := b.useKeychain()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	encKey, err := encryptionKey(kc, bitem)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := encryption.DecryptWithAD(encKey.Type, encKey.Key, bitem.Val, associatedData(path, encKey.Id))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt secret of namespace %s: %w", namespace, err)
+	}
+
+	// records written before wrapping keys were supported hold the raw secret
+	if bitem.Version < namespaceSecretVersion {
+		return &apiv1.NamespaceSecret{Secret: decrypted}, nil
+	}
+
+	nsSecret := &apiv1.NamespaceSecret{}
+	if err := proto.Unmarshal(decrypted, nsSecret); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal secret of namespace %s: %w", namespace, err)
+	}
+
+	return nsSecret, nil
+}
+
+// persistNamespaceSecret encrypts the given namespace secret with the active encryption key and stores it.
+func (b *Barrier) persistNamespaceSecret(ctx context.Context, namespace string, nsSecret *apiv1.NamespaceSecret) error {
+	path := NamespaceSecretsPath + namespace
+
+	bs, err := proto.Marshal(nsSecret)
+	if err != nil {
+		return fmt.Errorf("unable to marshal secret of namespace %s: %w", namespace, err)
+	}
+
+	kc, release, err := b.useKeychain()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	encKey := kc.ActiveKey()
+	encrypted, err := encryption.EncryptWithAD(encKey.Type, encKey.Key, bs, associatedData(path, encKey.Id))
+	if err != nil {
+		return fmt.Errorf("unable to encrypt secret of namespace %s: %w", namespace, err)
+	}
+
+	if err := b.store.Put(ctx, &apiv1.BackendItem{
+		Key:             path,
+		EncryptionKeyID: encKey.Id,
+		Val:             encrypted,
+		Version:         namespaceSecretVersion,
+	}); err != nil {
+		return fmt.Errorf("unable to write secret of namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
+
+func (b *Barrier) isNamespaceUnlocked(namespace string) bool {
+	b.nsMu.RLock()
+	defer b.nsMu.RUnlock()
+
+	return b.unlocked[namespace] != nil
+}
+
+// setUnlockedNamespace moves the unwrapped secret of the given namespace into secure memory, where it is kept until the barrier is sealed.
+// The given secret is zeroed. A nil secret locks the namespace. The secret it replaces is zeroed.
+func (b *Barrier) setUnlockedNamespace(namespace string, secret []byte) error {
+	var buf *securemem.Buffer
+	if secret != nil {
+		var err error
+		if buf, err = securemem.FromBytes(secret); err != nil {
+			securemem.Wipe(secret)
+			return fmt.Errorf("unable to unlock namespace %s: %w", namespace, err)
+		}
+	}
+
+	b.nsMu.Lock()
+	defer b.nsMu.Unlock()
+
+	if old := b.unlocked[namespace]; old != nil {
+		old.Destroy()
+		delete(b.unlocked, namespace)
+	}
+	if buf == nil {
+		return nil
+	}
+
+	if b.unlocked == nil {
+		b.unlocked = map[string]*securemem.Buffer{}
+	}
+	b.unlocked[namespace] = buf
+	return nil
+}
+
+// lockAllNamespaces zeroes the unwrapped secrets of every namespace.
+func (b *Barrier) lockAllNamespaces() {
+	b.nsMu.Lock()
+	defer b.nsMu.Unlock()
+
+	for _, buf := range b.unlocked {
+		buf.Destroy()
+	}
+	b.unlocked = nil
+}
+
+// lockNamespace locks the secret of the given namespace to prevent replicas from creating or destroying it concurrently.
+func (b *Barrier) lockNamespace(ctx context.Context, namespace string) (func(), error) {
+	// backends without distributed locking can only be used by a single node, and cannot lock keys that do not exist yet
+	if !b.store.Capabilities().Has(storage.CapabilityDistributedLocking) {
+		return func() {}, nil
+	}
+
+	mu, err := b.store.LockKey(ctx, NamespaceSecretsPath+namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mu.Lock(); err != nil {
+		return nil, err
+	}
+
+	return func() { mu.Unlock() }, nil
+}
+
+// barrierID gets the barrier's ID, which salts the derived namespace keys. The ID never changes once the barrier is initialized, so it is cached.
+func (b *Barrier) barrierID(ctx context.Context) ([]byte, error) {
+	b.idMu.Lock()
+	defer b.idMu.Unlock()
+
+	if b.id != nil {
+		return b.id, nil
+	}
+
+	bitem, err := b.store.Get(ctx, IDPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get barrier ID: %w", err)
+	}
+
+	b.id = bitem.Val
+	return b.id, nil
+}
+
+func unwrapNamespaceSecret(namespace string, wrappingKey, wrapped []byte) ([]byte, error) {
+	secret, err := encryption.DecryptWithAD(apiv1.CipherType_AES256_GCM, wrappingKey, wrapped, []byte(NamespaceSecretsPath+namespace))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWrappingKey, err)
+	}
+
+	return secret, nil
+}
+
+func validNamespace(namespace string) (string, error) {
+	namespace = strings.Trim(namespace, "/")
+	if len(namespace) == 0 || strings.Contains(namespace, "/") {
+		return "", ErrInvalidNamespace
+	}
+
+	return namespace, nil
+}
+
+// namespaceOf returns the namespace of the item stored at key, if any.
+func namespaceOf(key string) string {
+	key = storagePath(key)
+	if !strings.HasPrefix(key, namespacesPath) {
+		return ""
+	}
+
+	key = strings.TrimPrefix(key, namespacesPath)
+	i := strings.Index(key, "/")
+	if i <= 0 {
+		return ""
+	}
+
+	return key[:i]
+}
@@ -0,0 +1,85 @@
+// Package securemem provides buffers for key material that are locked into memory, surrounded by guard pages, and zeroed when they are destroyed.
+package securemem
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Buffer holds secret bytes outside of the Go heap. The memory is locked so it is never written to swap, and it is surrounded by
+// inaccessible guard pages, so reading or writing past either end of the buffer faults instead of leaking or corrupting other memory.
+// Buffers must be destroyed once they are no longer needed. Buffers that are garbage collected without being destroyed are destroyed by a finalizer.
+type Buffer struct {
+	data   []byte
+	mem    []byte
+	locked bool
+	mu     sync.Mutex
+}
+
+// New allocates a zeroed Buffer of the given size.
+func New(size int) (*Buffer, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("buffer size must be positive, not %d", size)
+	}
+
+	b := &Buffer{}
+	if err := b.alloc(size); err != nil {
+		return nil, err
+	}
+
+	runtime.SetFinalizer(b, (*Buffer).Destroy)
+	return b, nil
+}
+
+// FromBytes moves the given bytes into a new Buffer. The given slice is zeroed, so the only copy of the bytes is held by the Buffer.
+func FromBytes(src []byte) (*Buffer, error) {
+	b, err := New(len(src))
+	if err != nil {
+		return nil, err
+	}
+
+	copy(b.data, src)
+	Wipe(src)
+	return b, nil
+}
+
+// Bytes returns the contents of the Buffer. The returned slice must not be used after the Buffer is destroyed. Returns nil if the Buffer was destroyed.
+func (b *Buffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.data
+}
+
+// Locked reports whether the Buffer is locked into memory. Locking fails if the process exceeds its limit of locked memory,
+// in which case the Buffer is still guarded and zeroed when it is destroyed.
+func (b *Buffer) Locked() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.locked
+}
+
+// Destroy zeroes the Buffer and releases its memory. Destroying a Buffer more than once has no effect.
+func (b *Buffer) Destroy() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.data == nil {
+		return
+	}
+
+	Wipe(b.data)
+	b.free()
+	b.data = nil
+	runtime.SetFinalizer(b, nil)
+}
+
+// Wipe zeroes the given bytes.
+func Wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+	runtime.KeepAlive(b)
+}
@@ -0,0 +1,15 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd
+
+package securemem
+
+// alloc falls back to the Go heap on platforms without mlock and mprotect, so the data is only zeroed when the Buffer is destroyed.
+func (b *Buffer) alloc(size int) error {
+	b.mem = make([]byte, size)
+	b.data = b.mem
+	return nil
+}
+
+func (b *Buffer) free() {
+	b.mem = nil
+}
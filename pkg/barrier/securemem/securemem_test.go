@@ -0,0 +1,75 @@
+package securemem
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecureMem(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "securemem")
+}
+
+var _ = Describe("securemem", func() {
+	It("allocates zeroed buffers", func() {
+		buf, err := New(100)
+		Expect(err).NotTo(HaveOccurred())
+		defer buf.Destroy()
+
+		Expect(buf.Bytes()).To(Equal(make([]byte, 100)))
+		Expect(cap(buf.Bytes())).To(Equal(100))
+	})
+
+	It("rejects empty buffers", func() {
+		_, err := New(0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("moves bytes into a buffer", func() {
+		src := []byte("super secret key")
+		buf, err := FromBytes(src)
+		Expect(err).NotTo(HaveOccurred())
+		defer buf.Destroy()
+
+		Expect(buf.Bytes()).To(Equal([]byte("super secret key")))
+		Expect(src).To(Equal(make([]byte, len(src))))
+
+		buf.Bytes()[0] = 'S'
+		Expect(buf.Bytes()).To(Equal([]byte("Super secret key")))
+	})
+
+	It("spans multiple pages", func() {
+		pageSize := os.Getpagesize()
+		buf, err := New(pageSize + 1)
+		Expect(err).NotTo(HaveOccurred())
+		defer buf.Destroy()
+
+		for i := range buf.Bytes() {
+			buf.Bytes()[i] = byte(i)
+		}
+		Expect(buf.Bytes()[pageSize]).To(Equal(byte(pageSize)))
+	})
+
+	It("releases destroyed buffers", func() {
+		buf, err := New(32)
+		Expect(err).NotTo(HaveOccurred())
+
+		buf.Destroy()
+		Expect(buf.Bytes()).To(BeNil())
+		Expect(buf.Locked()).To(BeFalse())
+
+		// destroying twice has no effect
+		buf.Destroy()
+	})
+
+	It("wipes bytes", func() {
+		b := []byte("secret")
+		Wipe(b)
+		Expect(b).To(Equal(make([]byte, 6)))
+	})
+})
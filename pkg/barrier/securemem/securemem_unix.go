@@ -0,0 +1,47 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+// +build linux darwin freebsd netbsd openbsd
+
+package securemem
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+var pageSize = os.Getpagesize()
+
+// alloc maps the pages holding the data between two guard pages. The data ends right before the trailing guard page,
+// so overflows fault immediately.
+func (b *Buffer) alloc(size int) error {
+	dataPages := (size + pageSize - 1) / pageSize
+	mem, err := syscall.Mmap(-1, 0, (dataPages+2)*pageSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return fmt.Errorf("unable to allocate secure memory: %w", err)
+	}
+
+	if err := syscall.Mprotect(mem[:pageSize], syscall.PROT_NONE); err != nil {
+		syscall.Munmap(mem)
+		return fmt.Errorf("unable to protect guard page: %w", err)
+	}
+	if err := syscall.Mprotect(mem[(dataPages+1)*pageSize:], syscall.PROT_NONE); err != nil {
+		syscall.Munmap(mem)
+		return fmt.Errorf("unable to protect guard page: %w", err)
+	}
+
+	inner := mem[pageSize : (dataPages+1)*pageSize]
+	b.locked = syscall.Mlock(inner) == nil
+	b.mem = mem
+	b.data = inner[len(inner)-size : len(inner) : len(inner)]
+	return nil
+}
+
+func (b *Buffer) free() {
+	if b.locked {
+		syscall.Munlock(b.mem[pageSize : len(b.mem)-pageSize])
+		b.locked = false
+	}
+
+	syscall.Munmap(b.mem)
+	b.mem = nil
+}
@@ -2,14 +2,18 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"strconv"
 	"strings"
 
 	etcdclient "go.etcd.io/etcd/client/v3"
 
 	"github.com/go-logr/logr"
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
 	"github.com/slaskawi/vault-poc/pkg/gatekeeper"
+	"github.com/slaskawi/vault-poc/pkg/ha"
 	"github.com/slaskawi/vault-poc/pkg/storage"
 	"github.com/slaskawi/vault-poc/pkg/storage/etcd"
 	"github.com/slaskawi/vault-poc/pkg/storage/memory"
@@ -17,45 +21,60 @@ import (
 
 // Config object.
 type Config struct {
-	GrpcPort       string
-	RestPort       string
-	StorageBackend string
-	EtcdEndpoints  []string
-	EtcdUsername   string
-	EtcdPassword   string
+	GrpcPort           string
+	RestPort           string
+	StorageBackend     string
+	EtcdEndpoints      []string
+	EtcdUsername       string
+	EtcdPassword       string
+	HAEnabled          bool
+	HANodeID           string
+	HAAdvertiseAddress string
+	HARedirect         bool
+	EncryptionCipher   string
+	EnvelopeEncryption bool
+	ChunkSize          int
+	Compression        bool
+	MaxItemSize        int
+	NamespaceLimits    string
+	TrustedProxies     []string
 }
 
 // Get Config object from configuration file or environment variables.
 func Get() *Config {
+	hostname, _ := os.Hostname()
+	grpcPort := getEnv("GRPC_PORT", "8080")
+
 	return &Config{
-		GrpcPort:       getEnv("GRPC_PORT", "8080"),
-		RestPort:       getEnv("REST_PORT", "8081"),
-		StorageBackend: getEnv("STORAGE_BACKEND", "memory"),
-		EtcdEndpoints:  toSlice(getEnv("ETCD_ENDPOINTS", "http://127.0.0.1:2379")),
-		EtcdUsername:   getEnv("ETCD_USERNAME", ""),
-		EtcdPassword:   getEnv("ETCD_PASSWORD", ""),
+		GrpcPort:           grpcPort,
+		RestPort:           getEnv("REST_PORT", "8081"),
+		StorageBackend:     getEnv("STORAGE_BACKEND", "memory"),
+		EtcdEndpoints:      toSlice(getEnv("ETCD_ENDPOINTS", "http://127.0.0.1:2379")),
+		EtcdUsername:       getEnv("ETCD_USERNAME", ""),
+		EtcdPassword:       getEnv("ETCD_PASSWORD", ""),
+		HAEnabled:          toBool(getEnv("HA_ENABLED", "false")),
+		HANodeID:           getEnv("HA_NODE_ID", hostname),
+		HAAdvertiseAddress: getEnv("HA_ADVERTISE_ADDRESS", hostname+":"+grpcPort),
+		HARedirect:         toBool(getEnv("HA_REDIRECT", "false")),
+		EncryptionCipher:   getEnv("ENCRYPTION_CIPHER", apiv1.CipherType_AES256_GCM.String()),
+		EnvelopeEncryption: toBool(getEnv("ENVELOPE_ENCRYPTION", "false")),
+		ChunkSize:          toInt(getEnv("CHUNK_SIZE", strconv.Itoa(barrier.DefaultChunkSize))),
+		Compression:        toBool(getEnv("COMPRESSION", "false")),
+		MaxItemSize:        toInt(getEnv("MAX_ITEM_SIZE", "0")),
+		NamespaceLimits:    getEnv("NAMESPACE_SIZE_LIMITS", ""),
+		TrustedProxies:     toSlice(getEnv("TRUSTED_PROXIES", "127.0.0.0/8,::1/128")),
 	}
 }
 
 // Gatekeeper returns a new Gatekeeper instance from the config.
 func (c *Config) Gatekeeper(log logr.Logger) (*gatekeeper.Gatekeeper, error) {
-	var (
-		store storage.Storage
-		err   error
-	)
+	store, err := c.StorageConfig().Storage()
+	if err != nil {
+		return nil, err
+	}
 
-	switch c.StorageBackend {
-	case "memory":
-		store, err = memory.NewMemoryStorage(nil)
+	if c.StorageBackend == "memory" {
 		log.Info("WARNING: using in-memory storage backend, nothing will be persisted to disk")
-	case "etcd":
-		store, err = etcd.NewEtcdStorage(&etcdclient.Config{
-			Endpoints: c.EtcdEndpoints,
-			Username:  c.EtcdUsername,
-			Password:  c.EtcdPassword,
-		})
-	default:
-		return nil, fmt.Errorf("unknown storage backend: %s", c.StorageBackend)
 	}
 
 	capabilities := store.Capabilities()
@@ -66,18 +85,106 @@ func (c *Config) Gatekeeper(log logr.Logger) (*gatekeeper.Gatekeeper, error) {
 		log.Info("WARNING: the configured storage backend does not support watching for changes in keys, this functionality will be disabled")
 	}
 
+	barr, err := barrier.NewBarrier(store)
 	if err != nil {
 		return nil, err
 	}
 
-	barr, err := barrier.NewBarrier(store)
+	cipherType, ok := apiv1.CipherType_value[c.EncryptionCipher]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption cipher: %s", c.EncryptionCipher)
+	}
+	if err := barr.SetCipherType(apiv1.CipherType(cipherType)); err != nil {
+		return nil, err
+	}
+	barr.SetEnvelopeEncryption(c.EnvelopeEncryption)
+	barr.SetChunkSize(c.ChunkSize)
+	barr.SetCompression(c.Compression)
+
+	namespaceLimits, err := toSizeLimits(c.NamespaceLimits)
 	if err != nil {
 		return nil, err
 	}
+	barr.SetSizeLimits(c.MaxItemSize, namespaceLimits)
 
 	return gatekeeper.NewGatekeeper(store, barr)
 }
 
+// TrustedProxyNetworks returns the networks of the proxies whose X-Forwarded-For header is trusted to carry the address of a client.
+func (c *Config) TrustedProxyNetworks() ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range c.TrustedProxies {
+		if cidr = strings.TrimSpace(cidr); len(cidr) == 0 {
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy network: %s: %w", cidr, err)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// StorageConfig returns the configuration of the storage backend.
+func (c *Config) StorageConfig() *StorageConfig {
+	return &StorageConfig{
+		Backend:       c.StorageBackend,
+		EtcdEndpoints: c.EtcdEndpoints,
+		EtcdUsername:  c.EtcdUsername,
+		EtcdPassword:  c.EtcdPassword,
+	}
+}
+
+// HAManager returns a new HA Manager for the given Gatekeeper if high availability is enabled, otherwise nil is returned.
+func (c *Config) HAManager(log logr.Logger, gk *gatekeeper.Gatekeeper) *ha.Manager {
+	if !c.HAEnabled {
+		return nil
+	}
+
+	if !gk.Storage().Capabilities().Has(storage.CapabilityDistributedLocking) {
+		log.Info("WARNING: the configured storage backend does not support distributed locking, only a single node can be active")
+	}
+
+	return ha.NewManager(log, gk.Storage(), gk.Barrier(), c.HANodeID, c.HAAdvertiseAddress)
+}
+
+// StorageConfig object configures a storage backend.
+type StorageConfig struct {
+	Backend       string
+	EtcdEndpoints []string
+	EtcdUsername  string
+	EtcdPassword  string
+}
+
+// GetStorageConfig gets a StorageConfig object from environment variables that start with the given prefix, e.g. `<prefix>STORAGE_BACKEND`.
+func GetStorageConfig(prefix string) *StorageConfig {
+	return &StorageConfig{
+		Backend:       getEnv(prefix+"STORAGE_BACKEND", "memory"),
+		EtcdEndpoints: toSlice(getEnv(prefix+"ETCD_ENDPOINTS", "http://127.0.0.1:2379")),
+		EtcdUsername:  getEnv(prefix+"ETCD_USERNAME", ""),
+		EtcdPassword:  getEnv(prefix+"ETCD_PASSWORD", ""),
+	}
+}
+
+// Storage returns a new storage backend from the config.
+func (c *StorageConfig) Storage() (storage.Storage, error) {
+	switch c.Backend {
+	case "memory":
+		return memory.NewMemoryStorage(nil)
+	case "etcd":
+		return etcd.NewEtcdStorage(&etcdclient.Config{
+			Endpoints: c.EtcdEndpoints,
+			Username:  c.EtcdUsername,
+			Password:  c.EtcdPassword,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", c.Backend)
+	}
+}
+
 func getEnv(name, ifEmpty string) string {
 	val := os.Getenv(name)
 	if len(val) == 0 {
@@ -89,3 +196,36 @@ func getEnv(name, ifEmpty string) string {
 func toSlice(val string) []string {
 	return strings.Split(val, ",")
 }
+
+func toBool(val string) bool {
+	b, _ := strconv.ParseBool(val)
+	return b
+}
+
+func toInt(val string) int {
+	i, _ := strconv.Atoi(val)
+	return i
+}
+
+// toSizeLimits parses size limits of namespaces formatted as `<namespace>=<bytes>,...`.
+func toSizeLimits(val string) (map[string]int, error) {
+	limits := map[string]int{}
+	if len(val) == 0 {
+		return limits, nil
+	}
+
+	for _, limit := range toSlice(val) {
+		parts := strings.SplitN(limit, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid namespace size limit: %s", limit)
+		}
+
+		size, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace size limit: %s: %w", limit, err)
+		}
+		limits[strings.TrimSpace(parts[0])] = size
+	}
+
+	return limits, nil
+}
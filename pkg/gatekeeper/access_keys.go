@@ -20,15 +20,10 @@ func (g *Gatekeeper) RotateAccessKey(ctx context.Context, accessKey string) (str
 // NOTE: this functionality was removed from the API to maintain separation of concerns and prevent unseal key holders from granting themselves access tokens.
 // Discussion on this is pending, but this could be removed entirely in the future.
 func (g *Gatekeeper) RotateAccessKeyWithUnsealKeys(ctx context.Context, unsealKeys []string) (string, error) {
-	gatekeeperKey, err := g.gatekeeperKeyFromUnsealKeys(unsealKeys)
-	if err != nil {
+	if err := g.validateUnsealKeys(ctx, unsealKeys); err != nil {
 		return "", err
 	}
 
-	if err := g.b.ValidateGatekeeperKey(ctx, gatekeeperKey); err != nil {
-		return "", ErrInvalidUnsealKey
-	}
-
 	return g.generateAccessKey(ctx)
 }
 
@@ -47,6 +42,13 @@ func (g *Gatekeeper) SaveAccessTokenWithAccessKey(ctx context.Context, accessKey
 		return err
 	}
 
+	// policies are checked when a token is created to catch typos, but may be deleted later on.
+	for _, name := range token.Policies {
+		if _, err := g.pm.GetPolicy(ctx, name); err != nil {
+			return err
+		}
+	}
+
 	return g.tm.SaveToken(ctx, token)
 }
 
@@ -3,10 +3,16 @@ package gatekeeper
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/slaskawi/vault-poc/pkg/auth"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/lease"
+	"github.com/slaskawi/vault-poc/pkg/secret/database"
 	"github.com/slaskawi/vault-poc/pkg/secret/kv"
+	"github.com/slaskawi/vault-poc/pkg/secret/pki"
+	"github.com/slaskawi/vault-poc/pkg/secret/sshca"
+	"github.com/slaskawi/vault-poc/pkg/secret/transit"
 	"github.com/slaskawi/vault-poc/pkg/storage"
 )
 
@@ -17,31 +23,51 @@ const (
 )
 
 var (
-	ErrInvalidAccessKey       = fmt.Errorf("invalid access key")
-	ErrInvalidAccessToken     = fmt.Errorf("invalid access token")
-	ErrInvalidGatekeeperToken = fmt.Errorf("invalid gatekeeper token")
-	ErrInvalidUnsealKey       = fmt.Errorf("invalid unseal key(s)")
+	ErrInvalidAccessKey               = fmt.Errorf("invalid access key")
+	ErrInvalidAccessToken             = fmt.Errorf("invalid access token")
+	ErrInvalidGatekeeperToken         = fmt.Errorf("invalid gatekeeper token")
+	ErrInvalidGatekeeperTokenAccessor = fmt.Errorf("invalid gatekeeper token accessor")
+	ErrInvalidUnsealKey               = fmt.Errorf("invalid unseal key(s)")
 )
 
 // Gatekeeper object.
 type Gatekeeper struct {
-	store storage.Storage
-	am    *auth.ACLManager
-	b     *barrier.Barrier
-	kv    *kv.KV
-	tm    *auth.TokenManager
+	store   storage.Storage
+	am      *auth.ACLManager
+	b       *barrier.Barrier
+	db      *database.Database
+	kv      *kv.KV
+	lm      *lease.Manager
+	pki     *pki.PKI
+	pm      *auth.PolicyManager
+	sshca   *sshca.SSHCA
+	tm      *auth.TokenManager
+	transit *transit.Transit
+	// tokensMu guards the use counts of gatekeeper tokens, since not every storage backend locks keys.
+	tokensMu sync.Mutex
 }
 
 // NewGatekeeper creates a new Gatekeeper object.
 func NewGatekeeper(store storage.Storage, barrier *barrier.Barrier) (*Gatekeeper, error) {
+	lm := lease.NewManager(barrier)
+	pm := auth.NewPolicyManager(barrier)
 	g := &Gatekeeper{
-		store: store,
-		am:    auth.NewACLManager(),
-		b:     barrier,
-		kv:    kv.NewKV(barrier),
-		tm:    auth.NewTokenManager(barrier),
+		store:   store,
+		am:      auth.NewACLManager(pm),
+		b:       barrier,
+		db:      database.NewDatabase(barrier, lm),
+		kv:      kv.NewKV(barrier, lm),
+		lm:      lm,
+		pki:     pki.NewPKI(barrier),
+		pm:      pm,
+		sshca:   sshca.NewSSHCA(barrier),
+		tm:      auth.NewTokenManager(barrier),
+		transit: transit.NewTransit(barrier),
 	}
 
+	// revoking a token revokes the dynamic secrets it requested.
+	g.tm.OnRevoke(lm.RevokeByToken)
+
 	return g, nil
 }
 
@@ -55,12 +81,47 @@ func (g *Gatekeeper) Barrier() *barrier.Barrier {
 	return g.b
 }
 
+// Database returns the underlying Database object.
+func (g *Gatekeeper) Database() *database.Database {
+	return g.db
+}
+
 // KV returns the underlying KV object.
 func (g *Gatekeeper) KV() *kv.KV {
 	return g.kv
 }
 
+// LeaseManager returns the underlying lease Manager object.
+func (g *Gatekeeper) LeaseManager() *lease.Manager {
+	return g.lm
+}
+
+// PKI returns the underlying PKI object.
+func (g *Gatekeeper) PKI() *pki.PKI {
+	return g.pki
+}
+
+// PolicyManager returns the underlying PolicyManager object.
+func (g *Gatekeeper) PolicyManager() *auth.PolicyManager {
+	return g.pm
+}
+
+// SSHCA returns the underlying SSHCA object.
+func (g *Gatekeeper) SSHCA() *sshca.SSHCA {
+	return g.sshca
+}
+
+// Storage returns the underlying Storage object.
+func (g *Gatekeeper) Storage() storage.Storage {
+	return g.store
+}
+
 // TokenManager returns the underlying TokenManager object.
 func (g *Gatekeeper) TokenManager() *auth.TokenManager {
 	return g.tm
 }
+
+// Transit returns the underlying Transit object.
+func (g *Gatekeeper) Transit() *transit.Transit {
+	return g.transit
+}
@@ -1,14 +1,19 @@
 package gatekeeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage"
 	"github.com/slaskawi/vault-poc/pkg/storage/memory"
 )
 
@@ -38,6 +43,27 @@ func buildGatekeeper() (*barrier.Barrier, *Gatekeeper, error) {
 	return barr, gk, nil
 }
 
+var errUnreachable = fmt.Errorf("unreachable")
+
+// failingDeleteStorage is a storage backend that fails to delete items.
+type failingDeleteStorage struct {
+	storage.Storage
+}
+
+func (s *failingDeleteStorage) Delete(ctx context.Context, key string) error {
+	return errUnreachable
+}
+
+// slowPutStorage is a storage backend that takes a while to write items.
+type slowPutStorage struct {
+	storage.Storage
+}
+
+func (s *slowPutStorage) Put(ctx context.Context, item *apiv1.BackendItem) error {
+	time.Sleep(10 * time.Millisecond)
+	return s.Storage.Put(ctx, item)
+}
+
 var _ = Describe("gatekeeper", func() {
 	ctx := context.Background()
 
@@ -54,7 +80,7 @@ var _ = Describe("gatekeeper", func() {
 	var keys []string
 
 	It("should fail if barrier hasn't been initialized", func() {
-		_, err := gk.GenerateGatekeeperToken(ctx, nil)
+		_, err := gk.GenerateGatekeeperToken(ctx, nil, nil)
 		Expect(err).To(HaveOccurred())
 		Expect(err).To(MatchError(barrier.ErrBarrierNotInitialized))
 	})
@@ -63,7 +89,7 @@ var _ = Describe("gatekeeper", func() {
 		err := barr.Initialize(ctx, gatekeeperKey, nil)
 		Expect(err).NotTo(HaveOccurred())
 
-		token, err = gk.GenerateGatekeeperToken(ctx, gatekeeperKey)
+		token, err = gk.GenerateGatekeeperToken(ctx, gatekeeperKey, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(token).NotTo(BeEmpty())
 	})
@@ -108,7 +134,7 @@ var _ = Describe("gatekeeper", func() {
 	})
 
 	It("should renew gatekeeperTokens if requested", func() {
-		token, err = gk.GenerateGatekeeperToken(ctx, gatekeeperKey)
+		token, err = gk.GenerateGatekeeperToken(ctx, gatekeeperKey, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(token).NotTo(BeEmpty())
 
@@ -164,7 +190,7 @@ var _ = Describe("gatekeeper", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(keys).To(HaveLen(5))
 
-		token, err = gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys)
+		token, err = gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(token).NotTo(BeEmpty())
 
@@ -199,7 +225,7 @@ var _ = Describe("gatekeeper", func() {
 	})
 
 	It("should generate gatekeeper token from unseal keys", func() {
-		token, err = gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys)
+		token, err = gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		err = gk.UnsealWithGatekeeperToken(ctx, token, true)
@@ -214,12 +240,21 @@ var _ = Describe("gatekeeper", func() {
 	})
 
 	It("should rotate sharded unseal keys and remove existing gatekeeper tokens", func() {
-		keys, err := gk.RotateUnsealKeys(ctx, keys, 5, 3)
+		// the keychain of a sealed barrier cannot be stored under a new gatekeeper key, so no new unseal keys are handed out
+		_, err := gk.RotateUnsealKeys(ctx, keys, 5, 3)
+		Expect(err).To(MatchError(barrier.ErrBarrierSealed))
+
+		err = gk.UnsealWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+
+		keys, err = gk.RotateUnsealKeys(ctx, keys, 5, 3)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(keys).To(HaveLen(5))
 
-		err = gk.UnsealWithGatekeeperToken(ctx, token, true)
-		Expect(err).To(HaveOccurred())
+		err = gk.validateGatekeeperToken(ctx, token)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperToken))
+
+		barr.Seal()
 	})
 
 	It("should fail to initialize an already initialized barrier", func() {
@@ -291,3 +326,231 @@ var _ = Describe("gatekeeper", func() {
 		Expect(newAccessKey).NotTo(Equal(string(item.Raw)))
 	})
 })
+
+var _ = Describe("gatekeeper token metadata", func() {
+	ctx := context.Background()
+
+	barr, gk, err := buildGatekeeper()
+	Expect(err).NotTo(HaveOccurred())
+
+	keys, _, err := gk.InitializeBarrier(ctx, 5, 3)
+	Expect(err).NotTo(HaveOccurred())
+
+	var token string
+
+	It("should generate a labeled token with a use limit", func() {
+		token, err = gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, &GatekeeperTokenOptions{
+			TTL:     90 * 24 * time.Hour,
+			NumUses: 2,
+			Label:   "emergency",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).NotTo(BeEmpty())
+
+		accessor, err := gk.GatekeeperTokenAccessor(token)
+		Expect(err).NotTo(HaveOccurred())
+
+		tokens, err := gk.ListGatekeeperTokensWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].Accessor).To(Equal(accessor))
+		Expect(tokens[0].Label).To(Equal("emergency"))
+		Expect(tokens[0].Creator).To(Equal(creatorUnsealKeys))
+		Expect(tokens[0].NumUses).To(Equal(uint32(2)))
+		Expect(tokens[0].UsesRemaining).To(Equal(uint32(2)))
+		Expect(tokens[0].ExpiresAt).To(BeNumerically(">", time.Now().Add(89*24*time.Hour).Unix()))
+		Expect(tokens[0].Key).To(BeEmpty())
+	})
+
+	It("should keep the use limit when rotating", func() {
+		oldToken := token
+		token, err = gk.RotateGatekeeperToken(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = gk.UnsealWithGatekeeperToken(ctx, oldToken, true)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperToken))
+
+		tokens, err := gk.ListGatekeeperTokensWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].Label).To(Equal("emergency"))
+		Expect(tokens[0].Creator).To(HavePrefix(creatorGatekeeperToken))
+		Expect(tokens[0].UsesRemaining).To(Equal(uint32(2)))
+	})
+
+	It("should revoke a token once its uses are exhausted", func() {
+		err := gk.UnsealWithGatekeeperToken(ctx, token, true)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = gk.SealWithGatekeeperToken(ctx, token, true)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = gk.UnsealWithGatekeeperToken(ctx, token, true)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperToken))
+
+		sealed, err := barr.IsSealed(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sealed).To(BeTrue())
+	})
+
+	It("should not use a token more often than its limit when it is used concurrently", func() {
+		limited, err := gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, &GatekeeperTokenOptions{NumUses: 3})
+		Expect(err).NotTo(HaveOccurred())
+
+		// slow writes give concurrent uses the chance to read the same use count.
+		store := gk.store
+		gk.store = &slowPutStorage{Storage: store}
+		defer func() { gk.store = store }()
+
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			used int
+		)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				gatekeeperKey, _, err := gk.gatekeeperKeyFromToken(ctx, limited)
+				if err != nil {
+					Expect(err).To(MatchError(ErrInvalidGatekeeperToken))
+					return
+				}
+				gatekeeperKey.Destroy()
+
+				mu.Lock()
+				used++
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		Expect(used).To(Equal(3))
+	})
+
+	It("should reject and remove expired tokens", func() {
+		gatekeeperKey, err := gk.gatekeeperKeyFromUnsealKeys(keys)
+		Expect(err).NotTo(HaveOccurred())
+		defer gatekeeperKey.Destroy()
+
+		token, err = gk.GenerateGatekeeperToken(ctx, gatekeeperKey.Bytes(), &GatekeeperTokenOptions{TTL: time.Second})
+		Expect(err).NotTo(HaveOccurred())
+
+		time.Sleep(2 * time.Second)
+
+		// expired tokens that cannot be removed are reported rather than left behind silently.
+		store := gk.store
+		gk.store = &failingDeleteStorage{Storage: store}
+		err = gk.UnsealWithGatekeeperToken(ctx, token, true)
+		Expect(err).To(MatchError(errUnreachable))
+		_, err = gk.ListGatekeeperTokens(ctx)
+		Expect(err).To(MatchError(errUnreachable))
+		gk.store = store
+
+		err = gk.UnsealWithGatekeeperToken(ctx, token, true)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperToken))
+
+		tokens, err := gk.ListGatekeeperTokensWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens).To(BeEmpty())
+	})
+
+	It("should revoke a token by its accessor", func() {
+		token, err = gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		other, err := gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, &GatekeeperTokenOptions{Label: "other"})
+		Expect(err).NotTo(HaveOccurred())
+
+		accessor, err := gk.GatekeeperTokenAccessor(token)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = gk.RevokeGatekeeperTokenByAccessorWithGatekeeperToken(ctx, accessor, other, true)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = gk.UnsealWithGatekeeperToken(ctx, token, true)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperToken))
+
+		tokens, err := gk.ListGatekeeperTokensWithGatekeeperToken(ctx, other, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].Label).To(Equal("other"))
+	})
+
+	It("should fail to revoke an unknown accessor", func() {
+		err := gk.RevokeGatekeeperTokenByAccessorWithUnsealKeys(ctx, "0123456789", keys)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperTokenAccessor))
+
+		err = gk.RevokeGatekeeperTokenByAccessorWithUnsealKeys(ctx, "short", keys)
+		Expect(err).To(MatchError(ErrInvalidGatekeeperTokenAccessor))
+	})
+
+	It("should fail to list tokens with invalid unseal keys", func() {
+		_, err := gk.ListGatekeeperTokensWithUnsealKeys(ctx, keys[:2])
+		Expect(err).To(MatchError(ErrInvalidUnsealKey))
+	})
+})
+
+var _ = Describe("gatekeeper snapshots", func() {
+	ctx := context.Background()
+
+	barr, gk, err := buildGatekeeper()
+	Expect(err).NotTo(HaveOccurred())
+
+	keys, _, err := gk.InitializeBarrier(ctx, 5, 3)
+	Expect(err).NotTo(HaveOccurred())
+
+	var snapshot bytes.Buffer
+
+	It("should take a snapshot with a gatekeeper token and revoke the token", func() {
+		err := gk.UnsealWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = barr.Put(ctx, &apiv1.Item{Key: "/testing/snapshot", Raw: []byte("value")})
+		Expect(err).NotTo(HaveOccurred())
+
+		token, err := gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, keys, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		header, err := gk.SnapshotWithGatekeeperToken(ctx, &snapshot, token, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header.BarrierID).NotTo(BeEmpty())
+		Expect(snapshot.Len()).NotTo(BeZero())
+
+		tokens, err := gk.ListGatekeeperTokensWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens).To(BeEmpty())
+	})
+
+	It("should fail to take a snapshot with invalid unseal keys", func() {
+		_, err := gk.SnapshotWithUnsealKeys(ctx, &bytes.Buffer{}, keys[:2])
+		Expect(err).To(MatchError(ErrInvalidUnsealKey))
+	})
+
+	It("should fail to restore into an initialized barrier", func() {
+		_, _, err := gk.Restore(ctx, bytes.NewReader(snapshot.Bytes()))
+		Expect(err).To(MatchError(barrier.ErrBarrierAlreadyInitialized))
+	})
+
+	It("should restore into an empty backend that can be unsealed with the original unseal keys", func() {
+		restoredBarr, restored, err := buildGatekeeper()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, numItems, err := restored.Restore(ctx, bytes.NewReader(snapshot.Bytes()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(numItems).NotTo(BeZero())
+
+		sealed, err := restoredBarr.IsSealed(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sealed).To(BeTrue())
+
+		err = restored.UnsealWithUnsealKeys(ctx, keys)
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := restoredBarr.Get(ctx, "/testing/snapshot")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("value")))
+	})
+})
@@ -2,62 +2,99 @@ package gatekeeper
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/barrier/securemem"
 	"github.com/slaskawi/vault-poc/pkg/storage"
+	"google.golang.org/protobuf/proto"
 )
 
+const (
+	accessorLength         = 10
+	creatorUnsealKeys      = "unseal_keys"
+	creatorGatekeeperToken = "gatekeeper_token:"
+)
+
+// GatekeeperTokenOptions configures the lifetime and description of a generated gatekeeper token.
+type GatekeeperTokenOptions struct {
+	// TTL is how long the token is valid for. A zero TTL means the token does not expire.
+	TTL time.Duration
+
+	// NumUses is the number of times the token can be used. Zero means the token can be used until it is revoked.
+	NumUses uint32
+
+	// Label is a free-form description of the token, i.e. "on-call emergency token".
+	Label string
+
+	// Creator describes how the token was created. It is populated automatically when left empty.
+	Creator string
+}
+
 // GenerateGatekeeperTokenFromUnsealKeys generates a gatekeeper token that allows for unsealing without exposing the underlying gatekeeper key.
 // NOTE: Gatekeeper tokens should be considered secrets and should be used, rotated, or revoked as soon as possible.
-func (g *Gatekeeper) GenerateGatekeeperTokenFromUnsealKeys(ctx context.Context, keys []string) (string, error) {
+func (g *Gatekeeper) GenerateGatekeeperTokenFromUnsealKeys(ctx context.Context, keys []string, opts *GatekeeperTokenOptions) (string, error) {
 	gatekeeperKey, err := g.gatekeeperKeyFromUnsealKeys(keys)
 	if err != nil {
 		return "", err
 	}
+	defer gatekeeperKey.Destroy()
 
-	return g.GenerateGatekeeperToken(ctx, gatekeeperKey)
+	if opts == nil {
+		opts = &GatekeeperTokenOptions{}
+	}
+	if len(opts.Creator) == 0 {
+		opts.Creator = creatorUnsealKeys
+	}
+
+	return g.GenerateGatekeeperToken(ctx, gatekeeperKey.Bytes(), opts)
 }
 
 // GenerateGatekeeperToken generates a gatekeeper token that allows for unsealing without exposing the underlying gatekeeper key.
 // NOTE: Gatekeeper tokens should be considered secrets and should be used, rotated, or revoked as soon as possible.
-func (g *Gatekeeper) GenerateGatekeeperToken(ctx context.Context, gatekeeperKey []byte) (string, error) {
+func (g *Gatekeeper) GenerateGatekeeperToken(ctx context.Context, gatekeeperKey []byte, opts *GatekeeperTokenOptions) (string, error) {
 	if initialized, err := g.b.IsInitialized(ctx); err != nil {
 		return "", err
 	} else if !initialized {
 		return "", barrier.ErrBarrierNotInitialized
 	}
 
-	randomKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
-	if err != nil {
-		return "", err
+	if opts == nil {
+		opts = &GatekeeperTokenOptions{}
 	}
 
-	randomKeyHash := encryption.FromHash(randomKey)
-	token := randomKeyHash.Base32()[:encryption.AES256GCMSize]
-	keyHash, err := g.keyHashFromToken(token)
-	if err != nil {
-		return "", err
+	now := time.Now()
+	gt := &apiv1.GatekeeperToken{
+		Label:         opts.Label,
+		Creator:       opts.Creator,
+		CreatedAt:     now.Unix(),
+		NumUses:       opts.NumUses,
+		UsesRemaining: opts.NumUses,
 	}
-
-	encrypted, err := encryption.Encrypt(apiv1.CipherType_AES256_GCM, keyHash, gatekeeperKey)
-	if err != nil {
-		return "", err
+	if opts.TTL > 0 {
+		gt.ExpiresAt = now.Add(opts.TTL).Unix()
 	}
 
-	itemKey := itemKeyFromKeyHash(keyHash)
-	item := &apiv1.BackendItem{
-		Key: gatekeeperTokensPrefix + itemKey,
-		Val: encrypted,
+	return g.saveGatekeeperToken(ctx, gatekeeperKey, gt)
+}
+
+// GatekeeperTokenAccessor returns the accessor of the given gatekeeper token. The accessor identifies a token without granting its use.
+func (g *Gatekeeper) GatekeeperTokenAccessor(gatekeeperToken string) (string, error) {
+	gatekeeperToken = strings.ReplaceAll(gatekeeperToken, "-", "")
+	if len(gatekeeperToken) != encryption.AES256GCMSize {
+		return "", ErrInvalidGatekeeperToken
 	}
 
-	if err := g.store.Put(ctx, item); err != nil {
+	keyHash, err := g.keyHashFromToken(gatekeeperToken)
+	if err != nil {
 		return "", err
 	}
 
-	return formatToken(token), nil
+	return itemKeyFromKeyHash(keyHash), nil
 }
 
 // UnsealWithGatekeeperToken attempts to unseal the underlying Barrier object using a generated gatekeeper token.
@@ -70,24 +107,24 @@ func (g *Gatekeeper) UnsealWithGatekeeperToken(ctx context.Context, gatekeeperTo
 		return barrier.ErrBarrierUnsealed
 	}
 
-	gatekeeperKey, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
+	gatekeeperKey, _, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
 	if err != nil {
 		return err
 	}
+	defer gatekeeperKey.Destroy()
 
 	if !renew {
 		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
 	}
 
-	return g.b.Unseal(ctx, gatekeeperKey)
+	return g.b.Unseal(ctx, gatekeeperKey.Bytes())
 }
 
 // SealWithGatekeeperToken seals the barrier by validating the given gatekeeper token.
 // The token can only be used successfully once, unless explicitly renewed as part of this operation.
 // NOTE: Gatekeeper tokens should be considered secrets and should be used, rotated, or revoked as soon as possible.
 func (g *Gatekeeper) SealWithGatekeeperToken(ctx context.Context, gatekeeperToken string, renew bool) error {
-	_, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
-	if err != nil {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
 		return err
 	}
 
@@ -103,44 +140,93 @@ func (g *Gatekeeper) SealWithGatekeeperToken(ctx context.Context, gatekeeperToke
 // The token can only be used successfully once, but you can request a new one be generated as part of this process.
 // NOTE: Gatekeeper tokens should be considered secrets and should be used, rotated, or revoked as soon as possible.
 func (g *Gatekeeper) RotateEncryptionKeyWithGatekeeperToken(ctx context.Context, gatekeeperToken string, renew bool) error {
-	gatekeeperKey, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
+	gatekeeperKey, _, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
 	if err != nil {
 		return err
 	}
+	defer gatekeeperKey.Destroy()
 
 	if !renew {
 		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
 	}
 
-	return g.b.RotateEncryptionKey(ctx, gatekeeperKey)
+	return g.b.RotateEncryptionKey(ctx, gatekeeperKey.Bytes())
 }
 
 // RotateGatekeeperToken will revoke the given gatekeeper token and generate a new one. This helps prevent long-lived tokens.
+// The new token keeps the label, expiration, and remaining uses of the given token, so rotating cannot extend a token's lifetime.
 // NOTE: Gatekeeper tokens should be considered secrets and should be used, rotated, or revoked as soon as possible.
 func (g *Gatekeeper) RotateGatekeeperToken(ctx context.Context, gatekeeperToken string) (string, error) {
-	gatekeeperKey, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
+	gatekeeperKey, gt, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
 	if err != nil {
 		return "", err
 	}
+	defer gatekeeperKey.Destroy()
 
 	defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
-	return g.GenerateGatekeeperToken(ctx, gatekeeperKey)
+
+	newGT := &apiv1.GatekeeperToken{
+		Label:     gt.Label,
+		Creator:   creatorGatekeeperToken + gt.Accessor,
+		CreatedAt: time.Now().Unix(),
+		ExpiresAt: gt.ExpiresAt,
+		NumUses:   gt.NumUses,
+	}
+	if gt.NumUses > 0 {
+		// rotating does not count as a use, so the new token gets back the use consumed by validating the old one
+		newGT.UsesRemaining = gt.UsesRemaining + 1
+	}
+
+	return g.saveGatekeeperToken(ctx, gatekeeperKey.Bytes(), newGT)
 }
 
 // RevokeGatekeeperToken revokes a gatekeeper token to prevent its successful use.
 func (g *Gatekeeper) RevokeGatekeeperToken(ctx context.Context, gatekeeperToken string) error {
-	gatekeeperToken = strings.ReplaceAll(gatekeeperToken, "-", "")
-	if len(gatekeeperToken) != encryption.AES256GCMSize {
-		return ErrInvalidGatekeeperToken
+	accessor, err := g.GatekeeperTokenAccessor(gatekeeperToken)
+	if err != nil {
+		return err
 	}
 
-	keyHash, err := g.keyHashFromToken(gatekeeperToken)
-	if err != nil {
+	return g.store.Delete(ctx, gatekeeperTokensPrefix+accessor)
+}
+
+// RevokeGatekeeperTokenByAccessor revokes the gatekeeper token identified by the given accessor.
+func (g *Gatekeeper) RevokeGatekeeperTokenByAccessor(ctx context.Context, accessor string) error {
+	if len(accessor) != accessorLength || strings.Contains(accessor, "/") {
+		return ErrInvalidGatekeeperTokenAccessor
+	}
+
+	if _, err := g.store.Get(ctx, gatekeeperTokensPrefix+accessor); err != nil {
+		if storage.IsErrNotFound(err) {
+			return ErrInvalidGatekeeperTokenAccessor
+		}
 		return err
 	}
 
-	itemKey := itemKeyFromKeyHash(keyHash)
-	return g.store.Delete(ctx, gatekeeperTokensPrefix+itemKey)
+	return g.store.Delete(ctx, gatekeeperTokensPrefix+accessor)
+}
+
+// RevokeGatekeeperTokenByAccessorWithGatekeeperToken revokes the gatekeeper token identified by the given accessor after validating the given gatekeeper token.
+// The given token can only be used successfully once, unless explicitly renewed as part of this operation.
+func (g *Gatekeeper) RevokeGatekeeperTokenByAccessorWithGatekeeperToken(ctx context.Context, accessor, gatekeeperToken string, renew bool) error {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
+		return err
+	}
+
+	if !renew {
+		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
+	}
+
+	return g.RevokeGatekeeperTokenByAccessor(ctx, accessor)
+}
+
+// RevokeGatekeeperTokenByAccessorWithUnsealKeys revokes the gatekeeper token identified by the given accessor after validating the given unseal keys.
+func (g *Gatekeeper) RevokeGatekeeperTokenByAccessorWithUnsealKeys(ctx context.Context, accessor string, keys []string) error {
+	if err := g.validateUnsealKeys(ctx, keys); err != nil {
+		return err
+	}
+
+	return g.RevokeGatekeeperTokenByAccessor(ctx, accessor)
 }
 
 // RevokeAllGatekeeperTokens revokes all gatekeeper tokens.
@@ -159,38 +245,216 @@ func (g *Gatekeeper) RevokeAllGatekeeperTokens(ctx context.Context) error {
 	return nil
 }
 
-func (g *Gatekeeper) gatekeeperKeyFromToken(ctx context.Context, gatekeeperToken string) ([]byte, error) {
-	if initialized, err := g.b.IsInitialized(ctx); err != nil {
+// ListGatekeeperTokens lists the metadata of all valid gatekeeper tokens sorted by creation time. Expired tokens are removed as they are found.
+// The encrypted gatekeeper key is never included in the results.
+func (g *Gatekeeper) ListGatekeeperTokens(ctx context.Context) ([]*apiv1.GatekeeperToken, error) {
+	accessors, err := g.store.List(ctx, gatekeeperTokensPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := []*apiv1.GatekeeperToken{}
+	for _, accessor := range accessors {
+		if strings.HasSuffix(accessor, "/") {
+			continue
+		}
+
+		item, err := g.store.Get(ctx, gatekeeperTokensPrefix+accessor)
+		if err != nil {
+			if storage.IsErrNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		gt := gatekeeperTokenFromItem(accessor, item)
+		if isGatekeeperTokenExpired(gt) {
+			if err := g.store.Delete(ctx, gatekeeperTokensPrefix+accessor); err != nil && !storage.IsErrNotFound(err) {
+				return nil, err
+			}
+			continue
+		}
+
+		gt.Key = nil
+		tokens = append(tokens, gt)
+	}
+
+	sort.SliceStable(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt < tokens[j].CreatedAt
+	})
+
+	return tokens, nil
+}
+
+// ListGatekeeperTokensWithGatekeeperToken lists the metadata of all valid gatekeeper tokens after validating the given gatekeeper token.
+// The given token can only be used successfully once, unless explicitly renewed as part of this operation.
+func (g *Gatekeeper) ListGatekeeperTokensWithGatekeeperToken(ctx context.Context, gatekeeperToken string, renew bool) ([]*apiv1.GatekeeperToken, error) {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
 		return nil, err
+	}
+
+	if !renew {
+		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
+	}
+
+	return g.ListGatekeeperTokens(ctx)
+}
+
+// ListGatekeeperTokensWithUnsealKeys lists the metadata of all valid gatekeeper tokens after validating the given unseal keys.
+func (g *Gatekeeper) ListGatekeeperTokensWithUnsealKeys(ctx context.Context, keys []string) ([]*apiv1.GatekeeperToken, error) {
+	if err := g.validateUnsealKeys(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	return g.ListGatekeeperTokens(ctx)
+}
+
+// gatekeeperKeyFromToken decrypts the gatekeeper key held by the given gatekeeper token into secure memory. The returned buffer must be destroyed once the key has been used.
+// Expired tokens are revoked and rejected. Tokens with a use limit have their remaining uses decremented and are revoked once they have been used up.
+func (g *Gatekeeper) gatekeeperKeyFromToken(ctx context.Context, gatekeeperToken string) (*securemem.Buffer, *apiv1.GatekeeperToken, error) {
+	if initialized, err := g.b.IsInitialized(ctx); err != nil {
+		return nil, nil, err
 	} else if !initialized {
-		return nil, barrier.ErrBarrierNotInitialized
+		return nil, nil, barrier.ErrBarrierNotInitialized
 	}
 
 	gatekeeperToken = strings.ReplaceAll(gatekeeperToken, "-", "")
 	if len(gatekeeperToken) != encryption.AES256GCMSize {
-		return nil, ErrInvalidGatekeeperToken
+		return nil, nil, ErrInvalidGatekeeperToken
 	}
 
 	keyHash, err := g.keyHashFromToken(gatekeeperToken)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	// backends whose locks are not exclusive read the token when the key is locked, so the process-local lock is taken first.
+	g.tokensMu.Lock()
+	defer g.tokensMu.Unlock()
+
 	itemKey := itemKeyFromKeyHash(keyHash)
-	item, err := g.store.Get(ctx, gatekeeperTokensPrefix+itemKey)
+	mu, err := g.store.LockKey(ctx, gatekeeperTokensPrefix+itemKey)
 	if err != nil {
 		if storage.IsErrNotFound(err) {
-			return nil, ErrInvalidGatekeeperToken
+			return nil, nil, ErrInvalidGatekeeperToken
 		}
-		return nil, err
+		return nil, nil, err
+	}
+
+	if err := mu.Lock(); err != nil {
+		return nil, nil, err
 	}
+	defer mu.Unlock()
 
-	gatekeeperKey, err := encryption.Decrypt(apiv1.CipherType_AES256_GCM, keyHash, item.Val)
+	item, err := mu.Get()
 	if err != nil {
-		return nil, err
+		if storage.IsErrNotFound(err) {
+			return nil, nil, ErrInvalidGatekeeperToken
+		}
+		return nil, nil, err
 	}
 
-	return gatekeeperKey, nil
+	gt := gatekeeperTokenFromItem(itemKey, item)
+	if isGatekeeperTokenExpired(gt) {
+		if err := g.store.Delete(ctx, gatekeeperTokensPrefix+itemKey); err != nil && !storage.IsErrNotFound(err) {
+			return nil, nil, err
+		}
+		return nil, nil, ErrInvalidGatekeeperToken
+	}
+
+	// the key hash is only needed to decrypt this token, so its AEAD is not kept in the cache
+	decrypted, err := encryption.Decrypt(apiv1.CipherType_AES256_GCM, keyHash, gt.Key)
+	encryption.Evict(apiv1.CipherType_AES256_GCM, keyHash)
+	securemem.Wipe(keyHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gatekeeperKey, err := securemem.FromBytes(decrypted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if gt.NumUses > 0 {
+		gt.UsesRemaining--
+		if gt.UsesRemaining == 0 {
+			err = g.store.Delete(ctx, gatekeeperTokensPrefix+itemKey)
+		} else {
+			err = g.putGatekeeperToken(ctx, gt)
+		}
+		if err != nil {
+			gatekeeperKey.Destroy()
+			return nil, nil, err
+		}
+	}
+
+	return gatekeeperKey, gt, nil
+}
+
+// validateGatekeeperToken validates the given gatekeeper token without keeping the gatekeeper key it holds.
+// Like any use of the token, this counts against its use limit.
+func (g *Gatekeeper) validateGatekeeperToken(ctx context.Context, gatekeeperToken string) error {
+	gatekeeperKey, _, err := g.gatekeeperKeyFromToken(ctx, gatekeeperToken)
+	if err != nil {
+		return err
+	}
+
+	gatekeeperKey.Destroy()
+	return nil
+}
+
+// saveGatekeeperToken generates a new gatekeeper token, encrypts the gatekeeper key with it, and stores it along with the given metadata.
+func (g *Gatekeeper) saveGatekeeperToken(ctx context.Context, gatekeeperKey []byte, gt *apiv1.GatekeeperToken) (string, error) {
+	randomKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	if err != nil {
+		return "", err
+	}
+
+	randomKeyHash := encryption.FromHash(randomKey)
+	token := randomKeyHash.Base32()[:encryption.AES256GCMSize]
+	keyHash, err := g.keyHashFromToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	gt.Key, err = encryption.Encrypt(apiv1.CipherType_AES256_GCM, keyHash, gatekeeperKey)
+	encryption.Evict(apiv1.CipherType_AES256_GCM, keyHash)
+	if err != nil {
+		return "", err
+	}
+
+	gt.Accessor = itemKeyFromKeyHash(keyHash)
+	if err := g.putGatekeeperToken(ctx, gt); err != nil {
+		return "", err
+	}
+
+	return formatToken(token), nil
+}
+
+func (g *Gatekeeper) putGatekeeperToken(ctx context.Context, gt *apiv1.GatekeeperToken) error {
+	bs, err := proto.Marshal(gt)
+	if err != nil {
+		return err
+	}
+
+	return g.store.Put(ctx, &apiv1.BackendItem{
+		Key: gatekeeperTokensPrefix + gt.Accessor,
+		Val: bs,
+	})
+}
+
+func (g *Gatekeeper) validateUnsealKeys(ctx context.Context, keys []string) error {
+	gatekeeperKey, err := g.gatekeeperKeyFromUnsealKeys(keys)
+	if err != nil {
+		return err
+	}
+	defer gatekeeperKey.Destroy()
+
+	if err := g.b.ValidateGatekeeperKey(ctx, gatekeeperKey.Bytes()); err != nil {
+		return ErrInvalidUnsealKey
+	}
+
+	return nil
 }
 
 func (g *Gatekeeper) keyHashFromToken(token string) ([]byte, error) {
@@ -203,9 +467,25 @@ func (g *Gatekeeper) keyHashFromToken(token string) ([]byte, error) {
 	return encryption.BytesHash(keyString)[:encryption.AES256GCMSize], nil
 }
 
+// gatekeeperTokenFromItem unmarshals a stored gatekeeper token. Tokens stored before metadata was introduced only hold the encrypted
+// gatekeeper key, so they are treated as tokens without an expiration or use limit.
+func gatekeeperTokenFromItem(accessor string, item *apiv1.BackendItem) *apiv1.GatekeeperToken {
+	gt := &apiv1.GatekeeperToken{}
+	if err := proto.Unmarshal(item.Val, gt); err != nil || len(gt.Key) == 0 || gt.Accessor != accessor {
+		gt = &apiv1.GatekeeperToken{Key: item.Val}
+	}
+
+	gt.Accessor = accessor
+	return gt
+}
+
+func isGatekeeperTokenExpired(gt *apiv1.GatekeeperToken) bool {
+	return gt.ExpiresAt > 0 && time.Now().After(time.Unix(gt.ExpiresAt, 0))
+}
+
 func itemKeyFromKeyHash(keyHash []byte) string {
 	itemKey := encryption.FromHash(keyHash)
-	return itemKey.Base32()[:10]
+	return itemKey.Base32()[:accessorLength]
 }
 
 func formatToken(token string) string {
@@ -3,9 +3,7 @@ package gatekeeper
 import (
 	"context"
 
-	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
-	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
 )
 
 // InitializeBarrier will attempt to initialize the underlying barrier and provide the unseal keys along with a gatekeeper token, if requested.
@@ -22,18 +20,19 @@ func (g *Gatekeeper) InitializeBarrier(ctx context.Context, parts int, threshold
 		return nil, "", barrier.ErrBarrierAlreadyInitialized
 	}
 
-	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	gatekeeperKey, err := newGatekeeperKey()
 	if err != nil {
 		return nil, "", err
 	}
+	defer gatekeeperKey.Destroy()
 
-	unsealKeys, err := g.GenerateUnsealKeys(gatekeeperKey, parts, threshold)
+	unsealKeys, err := g.GenerateUnsealKeys(gatekeeperKey.Bytes(), parts, threshold)
 	if err != nil {
 		return nil, "", err
 	}
 
 	var accessKey string
-	err = g.b.Initialize(ctx, gatekeeperKey, func() error {
+	err = g.b.Initialize(ctx, gatekeeperKey.Bytes(), func() error {
 		accessKey, err = g.generateAccessKey(ctx)
 		return err
 	})
@@ -0,0 +1,28 @@
+package gatekeeper
+
+import (
+	"context"
+)
+
+// RekeyItemsWithGatekeeperToken moves every item onto the barrier's active encryption key after validating the given gatekeeper token.
+// The token can only be used successfully once, unless explicitly renewed as part of this operation.
+func (g *Gatekeeper) RekeyItemsWithGatekeeperToken(ctx context.Context, gatekeeperToken string, renew bool) (int, error) {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
+		return 0, err
+	}
+
+	if !renew {
+		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
+	}
+
+	return g.b.RekeyItems(ctx)
+}
+
+// RekeyItemsWithUnsealKeys moves every item onto the barrier's active encryption key after validating the given unseal keys.
+func (g *Gatekeeper) RekeyItemsWithUnsealKeys(ctx context.Context, keys []string) (int, error) {
+	if err := g.validateUnsealKeys(ctx, keys); err != nil {
+		return 0, err
+	}
+
+	return g.b.RekeyItems(ctx)
+}
@@ -0,0 +1,28 @@
+package gatekeeper
+
+import (
+	"context"
+)
+
+// ShredNamespaceWithGatekeeperToken destroys the key material and items of the given namespace after validating the given gatekeeper token.
+// The token can only be used successfully once, unless explicitly renewed as part of this operation.
+func (g *Gatekeeper) ShredNamespaceWithGatekeeperToken(ctx context.Context, namespace, gatekeeperToken string, renew bool) (int, error) {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
+		return 0, err
+	}
+
+	if !renew {
+		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
+	}
+
+	return g.b.ShredNamespace(ctx, namespace)
+}
+
+// ShredNamespaceWithUnsealKeys destroys the key material and items of the given namespace after validating the given unseal keys.
+func (g *Gatekeeper) ShredNamespaceWithUnsealKeys(ctx context.Context, namespace string, keys []string) (int, error) {
+	if err := g.validateUnsealKeys(ctx, keys); err != nil {
+		return 0, err
+	}
+
+	return g.b.ShredNamespace(ctx, namespace)
+}
@@ -0,0 +1,50 @@
+package gatekeeper
+
+import (
+	"context"
+	"io"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/snapshot"
+)
+
+// SnapshotWithGatekeeperToken writes a snapshot of the encrypted store to w after validating the given gatekeeper token.
+// Unless the token is renewed, it is revoked before the snapshot is taken, so it cannot be used against a deployment restored from the snapshot.
+func (g *Gatekeeper) SnapshotWithGatekeeperToken(ctx context.Context, w io.Writer, gatekeeperToken string, renew bool) (*apiv1.SnapshotHeader, error) {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
+		return nil, err
+	}
+
+	if !renew {
+		if err := g.RevokeGatekeeperToken(ctx, gatekeeperToken); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot.Save(ctx, g.store, w)
+}
+
+// SnapshotWithUnsealKeys writes a snapshot of the encrypted store to w after validating the given unseal keys.
+func (g *Gatekeeper) SnapshotWithUnsealKeys(ctx context.Context, w io.Writer, keys []string) (*apiv1.SnapshotHeader, error) {
+	if err := g.validateUnsealKeys(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	return snapshot.Save(ctx, g.store, w)
+}
+
+// Restore reads a snapshot from r into an uninitialized storage backend. The barrier is left initialized and sealed,
+// so it can be unsealed with the unseal keys of the deployment the snapshot was taken from.
+// Returns the snapshot's header and the number of restored items.
+func (g *Gatekeeper) Restore(ctx context.Context, r io.Reader) (*apiv1.SnapshotHeader, uint64, error) {
+	initialized, err := g.b.IsInitialized(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if initialized {
+		return nil, 0, barrier.ErrBarrierAlreadyInitialized
+	}
+
+	return snapshot.Restore(ctx, g.store, r)
+}
@@ -6,6 +6,7 @@ import (
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/barrier/securemem"
 	"github.com/slaskawi/vault-poc/pkg/gatekeeper/shamir"
 )
 
@@ -22,6 +23,7 @@ func (g *Gatekeeper) GenerateUnsealKeys(gatekeeperKey []byte, parts int, thresho
 	keys := []string{}
 	for _, kb := range keyBytes {
 		keys = append(keys, base64.RawStdEncoding.EncodeToString(kb))
+		securemem.Wipe(kb)
 	}
 
 	return keys, nil
@@ -36,22 +38,28 @@ func (g *Gatekeeper) RotateUnsealKeys(ctx context.Context, keys []string, parts
 	if err != nil {
 		return nil, err
 	}
+	defer gatekeeperKey.Destroy()
 
-	if err := g.b.ValidateGatekeeperKey(ctx, gatekeeperKey); err != nil {
+	if err := g.b.ValidateGatekeeperKey(ctx, gatekeeperKey.Bytes()); err != nil {
 		return nil, ErrInvalidUnsealKey
 	}
 
-	newGatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	newKey, err := newGatekeeperKey()
 	if err != nil {
 		return nil, err
 	}
+	defer newKey.Destroy()
 
-	unsealKeys, err := g.GenerateUnsealKeys(newGatekeeperKey, parts, threshold)
+	unsealKeys, err := g.GenerateUnsealKeys(newKey.Bytes(), parts, threshold)
 	if err != nil {
 		return nil, err
 	}
 
-	g.b.ChangeGatekeeperKey(ctx, newGatekeeperKey)
+	// the new unseal keys are only handed out once the keychain is stored under the new gatekeeper key, or they could not unseal the barrier
+	if err := g.b.ChangeGatekeeperKey(ctx, newKey.Bytes()); err != nil {
+		return nil, err
+	}
+
 	defer g.RevokeAllGatekeeperTokens(ctx)
 	return unsealKeys, nil
 }
@@ -62,11 +70,21 @@ func (g *Gatekeeper) UnsealWithUnsealKeys(ctx context.Context, keys []string) er
 	if err != nil {
 		return err
 	}
+	defer gatekeeperKey.Destroy()
 
-	return g.b.Unseal(ctx, gatekeeperKey)
+	return g.b.Unseal(ctx, gatekeeperKey.Bytes())
 }
 
-func (g *Gatekeeper) gatekeeperKeyFromUnsealKeys(keys []string) ([]byte, error) {
+// gatekeeperKeyFromUnsealKeys reconstructs the gatekeeper key from the given unseal keys. The decoded shares and the gatekeeper key
+// are held in secure memory, and the returned buffer must be destroyed once the key has been used.
+func (g *Gatekeeper) gatekeeperKeyFromUnsealKeys(keys []string) (*securemem.Buffer, error) {
+	shares := []*securemem.Buffer{}
+	defer func() {
+		for _, share := range shares {
+			share.Destroy()
+		}
+	}()
+
 	keyBytes := [][]byte{}
 	for _, key := range keys {
 		if len(key) < 42 || len(key) > 46 {
@@ -77,7 +95,12 @@ func (g *Gatekeeper) gatekeeperKeyFromUnsealKeys(keys []string) ([]byte, error)
 			return nil, ErrInvalidUnsealKey
 		}
 
-		keyBytes = append(keyBytes, kb)
+		share, err := securemem.FromBytes(kb)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+		keyBytes = append(keyBytes, share.Bytes())
 	}
 
 	key, err := shamir.Combine(keyBytes)
@@ -85,5 +108,15 @@ func (g *Gatekeeper) gatekeeperKeyFromUnsealKeys(keys []string) ([]byte, error)
 		return nil, ErrInvalidUnsealKey
 	}
 
-	return key, nil
+	return securemem.FromBytes(key)
+}
+
+// newGatekeeperKey generates a new gatekeeper key in secure memory.
+func newGatekeeperKey() (*securemem.Buffer, error) {
+	key, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return securemem.FromBytes(key)
 }
@@ -0,0 +1,28 @@
+package gatekeeper
+
+import (
+	"context"
+)
+
+// UpgradeItemsWithGatekeeperToken re-encrypts the items written by previous versions of the barrier after validating the given gatekeeper token.
+// The token can only be used successfully once, unless explicitly renewed as part of this operation.
+func (g *Gatekeeper) UpgradeItemsWithGatekeeperToken(ctx context.Context, gatekeeperToken string, renew bool) (int, error) {
+	if err := g.validateGatekeeperToken(ctx, gatekeeperToken); err != nil {
+		return 0, err
+	}
+
+	if !renew {
+		defer g.RevokeGatekeeperToken(ctx, gatekeeperToken)
+	}
+
+	return g.b.UpgradeItems(ctx)
+}
+
+// UpgradeItemsWithUnsealKeys re-encrypts the items written by previous versions of the barrier after validating the given unseal keys.
+func (g *Gatekeeper) UpgradeItemsWithUnsealKeys(ctx context.Context, keys []string) (int, error) {
+	if err := g.validateUnsealKeys(ctx, keys); err != nil {
+		return 0, err
+	}
+
+	return g.b.UpgradeItems(ctx)
+}
@@ -0,0 +1,222 @@
+// Package ha provides active/standby high availability by electing a leader through the storage backend's distributed locks.
+package ha
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	haPath        = "/kstash/ha/"
+	lockKey       = haPath + "lock"
+	leaderKey     = haPath + "leader"
+	checkInterval = time.Second
+	retryInterval = 5 * time.Second
+)
+
+var (
+	ErrNoLeader = fmt.Errorf("no active node has been elected")
+)
+
+// Manager campaigns for leadership and tracks whether this node is the active node.
+type Manager struct {
+	log     logr.Logger
+	store   storage.Storage
+	b       *barrier.Barrier
+	nodeID  string
+	address string
+	active  bool
+	mu      sync.RWMutex
+}
+
+// NewManager creates a new Manager object. The address is advertised to standbys so they can forward requests to this node when it is active.
+func NewManager(log logr.Logger, store storage.Storage, b *barrier.Barrier, nodeID, address string) *Manager {
+	return &Manager{
+		log:     log,
+		store:   store,
+		b:       b,
+		nodeID:  nodeID,
+		address: address,
+	}
+}
+
+// NodeID returns the ID of this node.
+func (m *Manager) NodeID() string {
+	return m.nodeID
+}
+
+// IsActive determines if this node is the active node.
+func (m *Manager) IsActive() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.active
+}
+
+// Leader returns information about the active node.
+func (m *Manager) Leader(ctx context.Context) (*apiv1.LeaderInfo, error) {
+	item, err := m.store.Get(ctx, leaderKey)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, ErrNoLeader
+		}
+		return nil, err
+	}
+
+	leader := &apiv1.LeaderInfo{}
+	if err := proto.Unmarshal(item.Val, leader); err != nil {
+		return nil, err
+	}
+
+	return leader, nil
+}
+
+// Run campaigns for leadership until the context is done. Only nodes that are unsealed, or that belong to an uninitialized deployment,
+// are allowed to become active. An active node steps down as soon as it is sealed.
+func (m *Manager) Run(ctx context.Context) {
+	for {
+		if err := m.campaign(ctx); err != nil {
+			m.log.Error(err, "leader election failed", "nodeID", m.nodeID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (m *Manager) campaign(ctx context.Context) error {
+	if err := m.waitUntilEligible(ctx); err != nil {
+		return nil
+	}
+
+	// backends without distributed locking can only be used by a single node, so it is always active
+	if m.store.Capabilities().Has(storage.CapabilityDistributedLocking) {
+		mu, err := m.store.LockKey(ctx, lockKey)
+		if err != nil {
+			return err
+		}
+
+		if err := mu.Lock(); err != nil {
+			return err
+		}
+		defer mu.Unlock()
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return m.lead(ctx, mu.Done())
+	}
+
+	return m.lead(ctx, nil)
+}
+
+func (m *Manager) lead(ctx context.Context, lost <-chan struct{}) error {
+	leader := &apiv1.LeaderInfo{
+		NodeID:    m.nodeID,
+		Address:   m.address,
+		ElectedAt: time.Now().Unix(),
+	}
+
+	bs, err := proto.Marshal(leader)
+	if err != nil {
+		return err
+	}
+
+	if err := m.store.Put(ctx, &apiv1.BackendItem{Key: leaderKey, Val: bs}); err != nil {
+		return err
+	}
+
+	m.setActive(true)
+	defer m.setActive(false)
+	defer m.resign()
+	m.log.Info("node is now active", "nodeID", m.nodeID, "address", m.address)
+
+	// catch up on any keys rotated by the previous active node before serving writes
+	if _, err := m.b.ReloadKeychain(ctx); err != nil && err != barrier.ErrBarrierSealed && err != barrier.ErrBarrierNotInitialized {
+		m.log.Error(err, "unable to reload keychain")
+	}
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-lost:
+			return fmt.Errorf("lost leadership lock")
+		case <-ticker.C:
+			if !m.isEligible(ctx) {
+				m.log.Info("node is stepping down", "nodeID", m.nodeID)
+				return nil
+			}
+		}
+	}
+}
+
+// resign deletes the leader record when this node steps down, so standbys do not forward requests to it. The record is kept if
+// another node was elected in the meantime, e.g. after this node lost the lock. The context of the campaign may be done already,
+// so the record is deleted with a context of its own.
+func (m *Manager) resign() {
+	ctx, cancel := context.WithTimeout(context.Background(), checkInterval)
+	defer cancel()
+
+	leader, err := m.Leader(ctx)
+	if err != nil {
+		if err != ErrNoLeader {
+			m.log.Error(err, "unable to get leader record", "nodeID", m.nodeID)
+		}
+		return
+	}
+	if leader.NodeID != m.nodeID {
+		return
+	}
+
+	if err := m.store.Delete(ctx, leaderKey); err != nil && !storage.IsErrNotFound(err) {
+		m.log.Error(err, "unable to delete leader record", "nodeID", m.nodeID)
+	}
+}
+
+func (m *Manager) setActive(active bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active = active
+}
+
+func (m *Manager) waitUntilEligible(ctx context.Context) error {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for !m.isEligible(ctx) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) isEligible(ctx context.Context) bool {
+	sealed, err := m.b.IsSealed(ctx)
+	if err == barrier.ErrBarrierNotInitialized {
+		return true
+	}
+
+	return err == nil && !sealed
+}
@@ -0,0 +1,106 @@
+package ha
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/zapr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestHA(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ha")
+}
+
+var _ = Describe("ha", func() {
+	zapLog, _ := zap.NewDevelopment()
+	log := zapr.NewLogger(zapLog)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	active, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	standby, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	m := NewManager(log, back, standby, "standby", "standby:8080")
+
+	It("reports no leader before campaigning", func() {
+		Expect(m.IsActive()).To(BeFalse())
+		Expect(m.NodeID()).To(Equal("standby"))
+
+		_, err := m.Leader(ctx)
+		Expect(err).To(MatchError(ErrNoLeader))
+	})
+
+	It("becomes active when eligible", func() {
+		err := active.Initialize(ctx, gatekeeperKey, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = active.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = standby.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		go m.Run(ctx)
+		Eventually(m.IsActive, 5*time.Second).Should(BeTrue())
+
+		leader, err := m.Leader(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leader.NodeID).To(Equal("standby"))
+		Expect(leader.Address).To(Equal("standby:8080"))
+		Expect(leader.ElectedAt).NotTo(BeZero())
+	})
+
+	It("reloads the keychain when another replica rotates the encryption key", func() {
+		err := active.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = active.Put(ctx, &apiv1.Item{Key: "rotated", Raw: []byte("value")})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() error {
+			_, err := standby.Get(ctx, "rotated")
+			return err
+		}, 5*time.Second).ShouldNot(HaveOccurred())
+	})
+
+	It("steps down when sealed", func() {
+		standby.Seal()
+		Eventually(m.IsActive, 5*time.Second).Should(BeFalse())
+
+		_, err := m.Leader(ctx)
+		Expect(err).To(MatchError(ErrNoLeader))
+		cancel()
+	})
+
+	It("keeps the leader record of another node when stepping down", func() {
+		bs, err := proto.Marshal(&apiv1.LeaderInfo{NodeID: "other", Address: "other:8080"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(back.Put(context.Background(), &apiv1.BackendItem{Key: leaderKey, Val: bs})).To(Succeed())
+
+		m.resign()
+		leader, err := m.Leader(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leader.NodeID).To(Equal("other"))
+	})
+})
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ACLExplain explains whether a token has a permission on a path of its namespace: which ACLs match, which of them apply,
+// whether a DENY applied, and the effective permissions. It explains the token with the given token ID, the token with the given
+// reference ID if a valid access key is provided, or otherwise the token of the request. Conditions of the ACLs are evaluated
+// against the explain request itself.
+func (s *KStash) ACLExplain(ctx context.Context, req *apiv1.ACLExplainRequest) (*apiv1.ACLExplainResponse, error) {
+	resp := &apiv1.ACLExplainResponse{}
+
+	if req.Permission == apiv1.Permission_DENY {
+		return resp, status.Error(codes.InvalidArgument, "a permission to explain is required")
+	}
+
+	var token *apiv1.AccessToken
+	var err error
+
+	if len(req.TokenID) > 0 {
+		token, err = s.gk.TokenManager().GetToken(ctx, req.TokenID)
+	} else if len(req.TokenReferenceID) > 0 {
+		if err := s.gk.CompareAccessKey(ctx, req.AccessKey); err != nil {
+			return resp, err
+		}
+		token, err = s.gk.TokenManager().GetTokenByReferenceID(ctx, req.TokenReferenceID)
+	} else {
+		token, err = s.GetToken(ctx)
+	}
+
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Explanation, err = s.gk.ACLManager().Explain(s.requestContext(ctx), token, req.Permission, req.Path)
+	return resp, err
+}
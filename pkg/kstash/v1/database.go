@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+const (
+	databaseConfigPath = "engines/database/config/"
+	databaseCredsPath  = "engines/database/creds/"
+	databaseRolesPath  = "engines/database/roles/"
+)
+
+// DatabaseConfigureConnection creates or replaces a connection to a database whose users are managed by the database engine.
+// The token must be allowed to update `engines/database/config/<name>`.
+func (s *KStash) DatabaseConfigureConnection(ctx context.Context, req *apiv1.DatabaseConfigureConnectionRequest) (*apiv1.DatabaseConfigureConnectionResponse, error) {
+	resp := &apiv1.DatabaseConfigureConnectionResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, databaseConfigPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	conn := &apiv1.DatabaseConnection{
+		Name:          req.Name,
+		Plugin:        req.Plugin,
+		Driver:        req.Driver,
+		ConnectionURL: req.ConnectionURL,
+		AllowedRoles:  req.AllowedRoles,
+	}
+
+	err = s.gk.Database().ConfigureConnection(ctx, token.Namespace, conn)
+	return resp, err
+}
+
+// DatabaseDeleteConnection deletes a connection of the database engine. The token must be allowed to delete `engines/database/config/<name>`.
+func (s *KStash) DatabaseDeleteConnection(ctx context.Context, req *apiv1.DatabaseDeleteConnectionRequest) (*apiv1.DatabaseDeleteConnectionResponse, error) {
+	resp := &apiv1.DatabaseDeleteConnectionResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_DELETE, databaseConfigPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Database().DeleteConnection(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// DatabasePutRole creates or replaces a role that describes the users created by the database engine.
+// The token must be allowed to update `engines/database/roles/<name>`.
+func (s *KStash) DatabasePutRole(ctx context.Context, req *apiv1.DatabasePutRoleRequest) (*apiv1.DatabasePutRoleResponse, error) {
+	resp := &apiv1.DatabasePutRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, databaseRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	role := &apiv1.DatabaseRole{
+		Name:                 req.Name,
+		Connection:           req.Connection,
+		CreationStatements:   req.CreationStatements,
+		RevocationStatements: req.RevocationStatements,
+	}
+	if len(req.Ttl) > 0 {
+		ttl, err := s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+		role.Ttl = int64(ttl.Seconds())
+	}
+	if len(req.MaxTTL) > 0 {
+		maxTTL, err := s.ParseTTL(req.MaxTTL)
+		if err != nil {
+			return resp, err
+		}
+		role.MaxTTL = int64(maxTTL.Seconds())
+	}
+
+	err = s.gk.Database().PutRole(ctx, token.Namespace, role)
+	return resp, err
+}
+
+// DatabaseGetRole returns a role of the database engine. The token must be allowed to read `engines/database/roles/<name>`.
+func (s *KStash) DatabaseGetRole(ctx context.Context, req *apiv1.DatabaseGetRoleRequest) (*apiv1.DatabaseGetRoleResponse, error) {
+	resp := &apiv1.DatabaseGetRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, databaseRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Role, err = s.gk.Database().GetRole(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// DatabaseDeleteRole deletes a role of the database engine. The token must be allowed to delete `engines/database/roles/<name>`.
+func (s *KStash) DatabaseDeleteRole(ctx context.Context, req *apiv1.DatabaseDeleteRoleRequest) (*apiv1.DatabaseDeleteRoleResponse, error) {
+	resp := &apiv1.DatabaseDeleteRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_DELETE, databaseRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Database().DeleteRole(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// DatabaseGenerateCredentials creates a database user for a role with a lease owned by the token. The user is dropped once the lease
+// expires or the token is revoked. The token must be allowed to read `engines/database/creds/<role>`.
+func (s *KStash) DatabaseGenerateCredentials(ctx context.Context, req *apiv1.DatabaseGenerateCredentialsRequest) (*apiv1.DatabaseGenerateCredentialsResponse, error) {
+	resp := &apiv1.DatabaseGenerateCredentialsResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, databaseCredsPath+req.Role)
+	if err != nil {
+		return resp, err
+	}
+
+	var ttl time.Duration
+	if len(req.Ttl) > 0 {
+		ttl, err = s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	creds, err := s.gk.Database().GenerateCredentials(ctx, token.Namespace, req.Role, ttl, token)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Username = creds.Username
+	resp.Password = creds.Password
+	resp.LeaseID = creds.Lease.Id
+	resp.ExpiresAt = creds.Lease.ExpiresAt
+	return resp, nil
+}
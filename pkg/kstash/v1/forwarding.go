@@ -0,0 +1,268 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+const (
+	forwardedHeader     = "kstash-forwarded-by"
+	leaderAddressHeader = "kstash-leader-address"
+	servicePrefix       = "/kstash.v1.KStash/"
+)
+
+// standbyMethods can be served by a standby node. Sealing and unsealing are always local since every node has its own seal state,
+// and so are locking and unlocking namespaces. Transit operations that do not change their key are served locally as well.
+var standbyMethods = map[string]struct{}{
+	servicePrefix + "ACLExplain":      {},
+	servicePrefix + "AuthTokenLookup": {},
+	servicePrefix + "DatabaseGetRole": {},
+	servicePrefix + "KVGet":           {},
+	servicePrefix + "KVGetMetadata":   {},
+	servicePrefix + "KVList":          {},
+	servicePrefix + "KVSearch":        {},
+	servicePrefix + "NamespaceLock":   {},
+	servicePrefix + "NamespaceStatus": {},
+	servicePrefix + "NamespaceUnlock": {},
+	servicePrefix + "PKIGetCA":        {},
+	servicePrefix + "PKIGetCRL":       {},
+	servicePrefix + "PKIGetRole":      {},
+	servicePrefix + "PolicyGet":       {},
+	servicePrefix + "PolicyList":      {},
+	servicePrefix + "SSHGetCA":        {},
+	servicePrefix + "SSHGetRole":      {},
+	servicePrefix + "SignSSHKey":      {},
+	servicePrefix + "SystemSeal":      {},
+	servicePrefix + "SystemStatus":    {},
+	servicePrefix + "SystemUnseal":    {},
+	servicePrefix + "TransitDecrypt":  {},
+	servicePrefix + "TransitEncrypt":  {},
+	servicePrefix + "TransitGetKey":   {},
+	servicePrefix + "TransitHMAC":     {},
+	servicePrefix + "TransitRewrap":   {},
+	servicePrefix + "TransitSign":     {},
+	servicePrefix + "TransitVerify":   {},
+}
+
+// WithUnaryForwarding adds middleware that forwards requests from a standby node to the active node.
+func WithUnaryForwarding(server apiv1.KStashServer) grpc.ServerOption {
+	s, ok := server.(*KStash)
+	if !ok {
+		return grpc.EmptyServerOption{}
+	}
+
+	return grpc.ChainUnaryInterceptor(s.UnaryServerForwarder())
+}
+
+// WithStreamForwarding adds middleware that forwards streaming requests, such as snapshots, from a standby node to the active node.
+func WithStreamForwarding(server apiv1.KStashServer) grpc.ServerOption {
+	s, ok := server.(*KStash)
+	if !ok {
+		return grpc.EmptyServerOption{}
+	}
+
+	return grpc.ChainStreamInterceptor(s.StreamServerForwarder())
+}
+
+// UnaryServerForwarder is the middleware that forwards requests which must be handled by the active node when running as a standby.
+// If redirects are configured, the request is rejected instead and the client is told the address of the active node.
+func (s *KStash) UnaryServerForwarder() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if s.ha == nil || s.ha.IsActive() {
+			return handler(ctx, req)
+		}
+		if _, ok := standbyMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		return s.forward(ctx, info.FullMethod, req)
+	}
+}
+
+// StreamServerForwarder is the streaming counterpart of UnaryServerForwarder.
+func (s *KStash) StreamServerForwarder() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if s.ha == nil || s.ha.IsActive() {
+			return handler(srv, ss)
+		}
+		if _, ok := standbyMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		return s.forwardStream(ss, info)
+	}
+}
+
+func (s *KStash) forward(ctx context.Context, method string, req interface{}) (interface{}, error) {
+	_, respType, err := messageTypes(method)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, ctx, err := s.leaderContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := respType.New().Interface()
+	if err := conn.Invoke(ctx, method, req, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// forwardStream relays the messages of a stream between the client and the active node until the active node ends the stream.
+func (s *KStash) forwardStream(ss grpc.ServerStream, info *grpc.StreamServerInfo) error {
+	reqType, respType, err := messageTypes(info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+
+	conn, ctx, err := s.leaderContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	desc := &grpc.StreamDesc{
+		StreamName:    strings.TrimPrefix(info.FullMethod, servicePrefix),
+		ServerStreams: info.IsServerStream,
+		ClientStreams: info.IsClientStream,
+	}
+	cs, err := conn.NewStream(ctx, desc, info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	// requests are relayed in the background. Errors of the active node are returned by cs.RecvMsg, while a failed client stream cancels it.
+	go func() {
+		for {
+			req := reqType.New().Interface()
+			if err := ss.RecvMsg(req); err != nil {
+				if err == io.EOF {
+					cs.CloseSend()
+				} else {
+					cancel()
+				}
+				return
+			}
+
+			if err := cs.SendMsg(req); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		resp := respType.New().Interface()
+		if err := cs.RecvMsg(resp); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if err := ss.SendMsg(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// leaderContext returns a connection to the active node and the context to forward a request with. The address of the active node is sent
+// to the client in a header, and the request is rejected if redirects are configured, or if it was forwarded to this node already.
+func (s *KStash) leaderContext(ctx context.Context) (*grpc.ClientConn, context.Context, error) {
+	meta, _ := metadata.FromIncomingContext(ctx)
+	if len(meta.Get(forwardedHeader)) > 0 {
+		return nil, nil, status.Error(codes.Unavailable, "forwarded request reached a node that is not active")
+	}
+
+	leader, err := s.ha.Leader(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "this node is a standby and the active node is unknown: %v", err)
+	}
+
+	grpc.SetHeader(ctx, metadata.Pairs(leaderAddressHeader, leader.Address))
+	if s.haRedirect {
+		return nil, nil, status.Errorf(codes.Unavailable, "this node is a standby, retry the request against the active node at %s", leader.Address)
+	}
+
+	conn, err := s.leaderConn(leader.Address)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "unable to connect to the active node at %s: %v", leader.Address, err)
+	}
+
+	// the metadata and the address of the client are forwarded, so the conditions of ACLs can be evaluated by the active node.
+	outMeta := meta.Copy()
+	outMeta.Set(forwardedHeader, s.ha.NodeID())
+	outMeta.Delete("x-forwarded-for")
+	if p, ok := peer.FromContext(ctx); ok {
+		if ip := s.clientIP(p.Addr, meta.Get("x-forwarded-for")); ip != nil {
+			outMeta.Set("x-forwarded-for", ip.String())
+		}
+	}
+
+	return conn, metadata.NewOutgoingContext(ctx, outMeta), nil
+}
+
+func (s *KStash) leaderConn(address string) (*grpc.ClientConn, error) {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+
+	if conn, ok := s.conns[address]; ok {
+		return conn, nil
+	}
+
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	s.conns[address] = conn
+	return conn, nil
+}
+
+// newResponse creates an empty response message for the given full gRPC method name.
+func newResponse(method string) (proto.Message, error) {
+	_, respType, err := messageTypes(method)
+	if err != nil {
+		return nil, err
+	}
+
+	return respType.New().Interface(), nil
+}
+
+// messageTypes returns the types of the request and response messages of the given full gRPC method name.
+func messageTypes(method string) (protoreflect.MessageType, protoreflect.MessageType, error) {
+	name := strings.TrimPrefix(method, servicePrefix)
+	md := apiv1.File_kstash_proto.Services().ByName("KStash").Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, nil, status.Errorf(codes.Unimplemented, "unknown method: %s", method)
+	}
+
+	reqType, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "unknown request type for method: %s", method)
+	}
+
+	respType, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "unknown response type for method: %s", method)
+	}
+
+	return reqType, respType, nil
+}
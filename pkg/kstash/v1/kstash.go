@@ -3,18 +3,23 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
 	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/auth"
 	"github.com/slaskawi/vault-poc/pkg/config"
 	"github.com/slaskawi/vault-poc/pkg/gatekeeper"
+	"github.com/slaskawi/vault-poc/pkg/ha"
 	"github.com/slaskawi/vault-poc/pkg/secret/kv"
 )
 
@@ -23,8 +28,13 @@ var (
 )
 
 type KStash struct {
-	log logr.Logger
-	gk  *gatekeeper.Gatekeeper
+	log            logr.Logger
+	gk             *gatekeeper.Gatekeeper
+	ha             *ha.Manager
+	haRedirect     bool
+	conns          map[string]*grpc.ClientConn
+	connsMu        sync.Mutex
+	trustedProxies []*net.IPNet
 
 	apiv1.UnimplementedKStashServer
 }
@@ -35,10 +45,27 @@ func NewKStash(log logr.Logger, conf *config.Config) (apiv1.KStashServer, error)
 		return nil, err
 	}
 
-	return &KStash{
-		log: log,
-		gk:  gk,
-	}, nil
+	trustedProxies, err := conf.TrustedProxyNetworks()
+	if err != nil {
+		return nil, err
+	}
+
+	haManager := conf.HAManager(log, gk)
+	if haManager != nil {
+		go haManager.Run(context.Background())
+	}
+
+	s := &KStash{
+		log:            log,
+		gk:             gk,
+		ha:             haManager,
+		haRedirect:     conf.HARedirect,
+		conns:          map[string]*grpc.ClientConn{},
+		trustedProxies: trustedProxies,
+	}
+	go gk.LeaseManager().Run(context.Background(), log, s.leaseManagerActive)
+
+	return s, nil
 }
 
 // GetTokenID from the given context. Returns an error if the token ID could not be found.
@@ -78,7 +105,67 @@ func (s *KStash) CanToken(ctx context.Context, perm apiv1.Permission, path strin
 		return token, kv.ErrNoNamespace
 	}
 
-	return token, s.gk.ACLManager().CanPerform(token.Acls, perm, token.Namespace, path)
+	return token, s.canPerform(ctx, token, perm, path)
+}
+
+// canPerform determines if a token may perform an operation on a path in its namespace, based on its ACLs and the ACLs of its policies.
+func (s *KStash) canPerform(ctx context.Context, token *apiv1.AccessToken, perm apiv1.Permission, path string) error {
+	acls, err := s.gk.ACLManager().TokenACLs(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return s.gk.ACLManager().CanPerform(s.requestContext(ctx), acls, perm, token.Namespace, path)
+}
+
+// requestContext returns a context that carries the request for the evaluation of the conditions of ACLs.
+func (s *KStash) requestContext(ctx context.Context) context.Context {
+	req := &auth.Request{Time: time.Now()}
+	req.Metadata, _ = metadata.FromIncomingContext(ctx)
+
+	if p, ok := peer.FromContext(ctx); ok {
+		req.ClientIP = s.clientIP(p.Addr, req.Metadata["x-forwarded-for"])
+	}
+
+	return auth.NewRequestContext(ctx, req)
+}
+
+// clientIP returns the address of the client of a request. The X-Forwarded-For header is only trusted if the request was
+// received from a trusted proxy, such as the REST gateway, in which case the last address the header has not been forwarded
+// by a trusted proxy is the client.
+func (s *KStash) clientIP(addr net.Addr, forwardedFor []string) net.IP {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip = a.IP
+	default:
+		host, _, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			return nil
+		}
+		ip = net.ParseIP(host)
+	}
+
+	var forwarded []string
+	for _, header := range forwardedFor {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+
+	for i := len(forwarded) - 1; i >= 0 && ip != nil && s.trustedProxy(ip); i-- {
+		ip = net.ParseIP(strings.TrimSpace(forwarded[i]))
+	}
+
+	return ip
+}
+
+func (s *KStash) trustedProxy(ip net.IP) bool {
+	for _, network := range s.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ParseTTL attempts to parse the given TTL string. An example string to represent one hour would be `1h`.
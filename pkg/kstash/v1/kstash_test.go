@@ -2,6 +2,12 @@ package v1
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,14 +15,26 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/auth"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
 	"github.com/slaskawi/vault-poc/pkg/config"
 	"github.com/slaskawi/vault-poc/pkg/gatekeeper"
+	"github.com/slaskawi/vault-poc/pkg/ha"
+	"github.com/slaskawi/vault-poc/pkg/secret/database/databasetest"
 	"github.com/slaskawi/vault-poc/pkg/secret/kv"
+	"github.com/slaskawi/vault-poc/pkg/secret/pki"
+	"github.com/slaskawi/vault-poc/pkg/secret/transit"
 	"github.com/slaskawi/vault-poc/pkg/storage"
 )
 
@@ -219,6 +237,43 @@ var _ = Describe("kstash", func() {
 		Expect(resp).NotTo(BeNil())
 	})
 
+	It("can generate, list, and revoke labeled gatekeeper tokens", func() {
+		genReq := &apiv1.SystemGenerateGatekeeperTokenRequest{
+			UnsealKeys: unsealKeys,
+			Ttl:        "2160h",
+			NumUses:    3,
+			Label:      "emergency",
+		}
+
+		genResp, err := server.SystemGenerateGatekeeperToken(ctx, genReq)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(genResp.GatekeeperToken).NotTo(BeEmpty())
+		Expect(genResp.Accessor).NotTo(BeEmpty())
+
+		listReq := &apiv1.SystemListGatekeeperTokensRequest{
+			UnsealKeys: unsealKeys,
+		}
+
+		listResp, err := server.SystemListGatekeeperTokens(ctx, listReq)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listResp.Tokens).To(HaveLen(1))
+		Expect(listResp.Tokens[0].Accessor).To(Equal(genResp.Accessor))
+		Expect(listResp.Tokens[0].Label).To(Equal("emergency"))
+		Expect(listResp.Tokens[0].UsesRemaining).To(Equal(uint32(3)))
+
+		revokeReq := &apiv1.SystemRevokeGatekeeperTokenAccessorRequest{
+			Accessor:   genResp.Accessor,
+			UnsealKeys: unsealKeys,
+		}
+
+		_, err = server.SystemRevokeGatekeeperTokenAccessor(ctx, revokeReq)
+		Expect(err).NotTo(HaveOccurred())
+
+		listResp, err = server.SystemListGatekeeperTokens(ctx, listReq)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listResp.Tokens).To(BeEmpty())
+	})
+
 	It("can rotate the access key", func() {
 		req := &apiv1.SystemRotateAccessKeyRequest{
 			AccessKey: accessKey,
@@ -269,6 +324,15 @@ var _ = Describe("kstash", func() {
 						apiv1.Permission_DENY,
 					},
 				},
+				{
+					Path: "engines/*",
+					Permissions: []apiv1.Permission{
+						apiv1.Permission_READ,
+						apiv1.Permission_CREATE,
+						apiv1.Permission_UPDATE,
+						apiv1.Permission_DELETE,
+					},
+				},
 			},
 		}
 
@@ -285,11 +349,11 @@ var _ = Describe("kstash", func() {
 		err := ks.gk.TokenManager().IsTokenValid(token)
 		Expect(err).NotTo(HaveOccurred())
 
-		am := auth.NewACLManager()
+		am := auth.NewACLManager(nil)
 		err = am.ValidateACLs(token.Acls)
 		Expect(err).NotTo(HaveOccurred())
 
-		err = am.CanPerform(token.Acls, apiv1.Permission_CREATE, "test", "folder1/key1")
+		err = am.CanPerform(ctx, token.Acls, apiv1.Permission_CREATE, "test", "folder1/key1")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -430,6 +494,802 @@ var _ = Describe("kstash", func() {
 		Expect(resp).NotTo(BeNil())
 	})
 
+	It("can put an expiring item in the kv", func() {
+		_, err := server.KVPut(ctx, &apiv1.KVPutRequest{
+			Item: &apiv1.Item{Key: "temporary/vpn", Raw: []byte("the-password"), ExpiresAt: time.Now().Add(time.Hour).Unix()},
+			Ttl:  "10m",
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = server.KVPut(ctx, &apiv1.KVPutRequest{
+			Item: &apiv1.Item{Key: "temporary/vpn", Raw: []byte("the-password"), ExpiresAt: 1},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = server.KVPut(ctx, &apiv1.KVPutRequest{
+			Item: &apiv1.Item{Key: "temporary/vpn", Raw: []byte("the-password")},
+			Ttl:  "10m",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		getResp, err := server.KVGet(ctx, &apiv1.KVGetRequest{Path: "temporary/vpn"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(time.Unix(getResp.Item.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(10*time.Minute), 2*time.Second))
+
+		listResp, err := server.KVList(ctx, &apiv1.KVListRequest{Path: "temporary"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listResp.Paths).To(Equal([]string{"vpn"}))
+		Expect(listResp.Entries).To(HaveLen(1))
+		Expect(listResp.Entries[0].ExpiresAt).To(Equal(getResp.Item.ExpiresAt))
+
+		_, err = server.KVDelete(ctx, &apiv1.KVDeleteRequest{Path: "temporary/vpn"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("can lock a namespace with a tenant wrapping key", func() {
+		wrappingKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.NamespaceConfigureKey(ctx, &apiv1.NamespaceConfigureKeyRequest{WrappingKey: wrappingKey})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "tenant/item1", Raw: []byte("tenant-data")}})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.NamespaceLock(ctx, &apiv1.NamespaceLockRequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		status, err := server.NamespaceStatus(ctx, &apiv1.NamespaceStatusRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.TenantKeyed).To(BeTrue())
+		Expect(status.Locked).To(BeTrue())
+
+		_, err = server.KVGet(ctx, &apiv1.KVGetRequest{Path: "tenant/item1"})
+		Expect(err).To(MatchError(barrier.ErrNamespaceLocked))
+
+		_, err = server.NamespaceUnlock(ctx, &apiv1.NamespaceUnlockRequest{WrappingKey: []byte("wrong-wrapping-key-0000000000000")})
+		Expect(err).To(MatchError(barrier.ErrInvalidWrappingKey))
+
+		_, err = server.NamespaceUnlock(ctx, &apiv1.NamespaceUnlockRequest{WrappingKey: wrappingKey})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := server.KVGet(ctx, &apiv1.KVGetRequest{Path: "tenant/item1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Item.Raw).To(Equal([]byte("tenant-data")))
+	})
+
+	It("requires permission to update the namespace root to lock or unlock it", func() {
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_READ, apiv1.Permission_LIST},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+resp.Token.Id))
+		_, err = server.NamespaceLock(ctx, &apiv1.NamespaceLockRequest{})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.NamespaceUnlock(ctx, &apiv1.NamespaceUnlockRequest{WrappingKey: []byte("wrong-wrapping-key-0000000000000")})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		status, err := server.NamespaceStatus(ctx, &apiv1.NamespaceStatusRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Locked).To(BeFalse())
+	})
+
+	It("rejects items larger than the size limit of the namespace", func() {
+		// the limit applies to the metadata of items as well, which takes about a hundred bytes.
+		ks.gk.Barrier().SetSizeLimits(0, map[string]int{token.Namespace: 160})
+		defer ks.gk.Barrier().SetSizeLimits(0, nil)
+
+		_, err := server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "large", Raw: make([]byte, 256)}})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "small", Raw: make([]byte, 8)}})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVPutMetadata(ctx, &apiv1.KVPutMetadataRequest{Path: "small", Description: string(make([]byte, 256))})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("can encrypt and decrypt data with a transit key", func() {
+		_, err := server.TransitCreateKey(ctx, &apiv1.TransitCreateKeyRequest{Name: "key1"})
+		Expect(err).NotTo(HaveOccurred())
+
+		encrypted, err := server.TransitEncrypt(ctx, &apiv1.TransitEncryptRequest{Name: "key1", Plaintext: []byte("the-data")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encrypted.KeyVersion).To(Equal(uint32(1)))
+
+		rotated, err := server.TransitRotateKey(ctx, &apiv1.TransitRotateKeyRequest{Name: "key1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotated.KeyVersion).To(Equal(uint32(2)))
+
+		rewrapped, err := server.TransitRewrap(ctx, &apiv1.TransitRewrapRequest{Name: "key1", Ciphertext: encrypted.Ciphertext})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rewrapped.KeyVersion).To(Equal(uint32(2)))
+
+		decrypted, err := server.TransitDecrypt(ctx, &apiv1.TransitDecryptRequest{Name: "key1", Ciphertext: rewrapped.Ciphertext})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted.Plaintext).To(Equal([]byte("the-data")))
+	})
+
+	It("can sign data and compute HMACs with a transit key", func() {
+		signed, err := server.TransitSign(ctx, &apiv1.TransitSignRequest{Name: "key1", Input: []byte("the-data")})
+		Expect(err).NotTo(HaveOccurred())
+
+		verified, err := server.TransitVerify(ctx, &apiv1.TransitVerifyRequest{Name: "key1", Input: []byte("the-data"), Signature: signed.Signature})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verified.Valid).To(BeTrue())
+
+		hmac, err := server.TransitHMAC(ctx, &apiv1.TransitHMACRequest{Name: "key1", Input: []byte("the-data")})
+		Expect(err).NotTo(HaveOccurred())
+
+		verified, err = server.TransitVerify(ctx, &apiv1.TransitVerifyRequest{Name: "key1", Input: []byte("the-data"), Hmac: hmac.Hmac})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verified.Valid).To(BeTrue())
+
+		_, err = server.TransitVerify(ctx, &apiv1.TransitVerifyRequest{Name: "key1", Input: []byte("the-data")})
+		Expect(err).To(MatchError(transit.ErrVerifyInputMissing))
+	})
+
+	It("restricts transit keys with ACLs", func() {
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "engines/transit/key1",
+					Permissions: []apiv1.Permission{apiv1.Permission_READ},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		encrypted, err := server.TransitEncrypt(ctx, &apiv1.TransitEncryptRequest{Name: "key1", Plaintext: []byte("the-data")})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+resp.Token.Id))
+		decrypted, err := server.TransitDecrypt(ctx, &apiv1.TransitDecryptRequest{Name: "key1", Ciphertext: encrypted.Ciphertext})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted.Plaintext).To(Equal([]byte("the-data")))
+
+		_, err = server.TransitEncrypt(ctx, &apiv1.TransitEncryptRequest{Name: "key1", Plaintext: []byte("the-data")})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.TransitRotateKey(ctx, &apiv1.TransitRotateKeyRequest{Name: "key1"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.TransitGetKey(ctx, &apiv1.TransitGetKeyRequest{Name: "key2"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+	})
+
+	It("can issue and revoke certificates", func() {
+		_, err := server.PKIGenerateCA(ctx, &apiv1.PKIGenerateCARequest{CommonName: "Test CA", Ttl: "1h"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.PKIPutRole(ctx, &apiv1.PKIPutRoleRequest{Name: "web", AllowedDomains: []string{"example.com"}, AllowSubdomains: true, MaxTTL: "10m"})
+		Expect(err).NotTo(HaveOccurred())
+
+		role, err := server.PKIGetRole(ctx, &apiv1.PKIGetRoleRequest{Name: "web"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role.Role.MaxTTL).To(Equal(int64(600)))
+
+		_, err = server.PKIIssue(ctx, &apiv1.PKIIssueRequest{Role: "web", CommonName: "www.example.com", Ttl: "1h"})
+		Expect(err).To(MatchError(pki.ErrTTLTooLong))
+
+		issued, err := server.PKIIssue(ctx, &apiv1.PKIIssueRequest{Role: "web", CommonName: "www.example.com"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issued.Certificate.Certificate).NotTo(BeEmpty())
+
+		_, err = server.PKIRevoke(ctx, &apiv1.PKIRevokeRequest{SerialNumber: issued.Certificate.SerialNumber})
+		Expect(err).NotTo(HaveOccurred())
+
+		crl, err := server.PKIGetCRL(ctx, &apiv1.PKIGetCRLRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crl.Crl).To(HavePrefix("-----BEGIN X509 CRL-----"))
+	})
+
+	It("restricts the PKI engine with ACLs", func() {
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "engines/pki/issue/web",
+					Permissions: []apiv1.Permission{apiv1.Permission_UPDATE},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+resp.Token.Id))
+		_, err = server.PKIIssue(ctx, &apiv1.PKIIssueRequest{Role: "web", CommonName: "api.example.com"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.PKIIssue(ctx, &apiv1.PKIIssueRequest{Role: "other", CommonName: "api.example.com"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.PKIRevoke(ctx, &apiv1.PKIRevokeRequest{SerialNumber: "01"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.PKIGenerateCA(ctx, &apiv1.PKIGenerateCARequest{CommonName: "Other CA"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+	})
+
+	It("keeps the ACLs of KV items apart from the secret engines", func() {
+		outerCtx := ctx
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_READ, apiv1.Permission_CREATE, apiv1.Permission_UPDATE},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+resp.Token.Id))
+		_, err = server.PKIGenerateCA(ctx, &apiv1.PKIGenerateCARequest{CommonName: "Other CA"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.PKIGetCA(ctx, &apiv1.PKIGetCARequest{})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "engines/pki/ca", Raw: []byte("value")}})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		// KV keys cannot be stored under the engines even with a grant on them, so their ACL paths are never ambiguous.
+		_, err = server.KVPut(outerCtx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "engines/pki/ca", Raw: []byte("value")}})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("can sign SSH keys", func() {
+		caResp, err := server.SSHGenerateCA(ctx, &apiv1.SSHGenerateCARequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.SSHPutRole(ctx, &apiv1.SSHPutRoleRequest{Name: "oncall", AllowedPrincipals: []string{"oncall"}, MaxTTL: "1h"})
+		Expect(err).NotTo(HaveOccurred())
+
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		sshPub, err := ssh.NewPublicKey(pub)
+		Expect(err).NotTo(HaveOccurred())
+
+		req := &apiv1.SignSSHKeyRequest{Role: "oncall", PublicKey: string(ssh.MarshalAuthorizedKey(sshPub)), Principals: []string{"oncall"}, Ttl: "10m"}
+		resp, err := server.SignSSHKey(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		signed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(resp.SignedKey))
+		Expect(err).NotTo(HaveOccurred())
+		cert := signed.(*ssh.Certificate)
+		Expect(cert.KeyId).To(Equal(token.ReferenceID))
+		Expect(cert.Serial).To(Equal(resp.SerialNumber))
+		Expect(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert.SignatureKey)))).To(Equal(caResp.PublicKey))
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "engines/ssh/ca",
+					Permissions: []apiv1.Permission{apiv1.Permission_READ},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		_, err = server.SSHGetCA(ctx, &apiv1.SSHGetCARequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.SignSSHKey(ctx, req)
+		Expect(err).To(MatchError(auth.ErrForbidden))
+	})
+
+	It("revokes database credentials along with the token that requested them", func() {
+		db := databasetest.Open("postgres://kstash-test")
+
+		_, err := server.DatabaseConfigureConnection(ctx, &apiv1.DatabaseConfigureConnectionRequest{
+			Name:          "pg",
+			Plugin:        "postgresql",
+			Driver:        databasetest.DriverName,
+			ConnectionURL: "postgres://kstash-test",
+			AllowedRoles:  []string{"*"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.DatabasePutRole(ctx, &apiv1.DatabasePutRoleRequest{Name: "readonly", Connection: "pg", Ttl: "10m", MaxTTL: "1h"})
+		Expect(err).NotTo(HaveOccurred())
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "engines/database/creds/readonly",
+					Permissions: []apiv1.Permission{apiv1.Permission_READ},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		creds, err := server.DatabaseGenerateCredentials(ctx, &apiv1.DatabaseGenerateCredentialsRequest{Role: "readonly"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.LeaseID).To(HavePrefix("database/creds/readonly/"))
+		Expect(creds.ExpiresAt).To(Equal(tokenResp.Token.ExpiresAt))
+		Expect(db.Users()).To(Equal([]string{creds.Username}))
+
+		_, err = server.DatabaseGetRole(ctx, &apiv1.DatabaseGetRoleRequest{Name: "readonly"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		renewed, err := server.LeaseRenew(ctx, &apiv1.LeaseRenewRequest{LeaseID: creds.LeaseID, Increment: "1h"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renewed.Lease.ExpiresAt).To(Equal(tokenResp.Token.ExpiresAt))
+
+		other, err := server.DatabaseGenerateCredentials(ctx, &apiv1.DatabaseGenerateCredentialsRequest{Role: "readonly"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = server.LeaseRevoke(ctx, &apiv1.LeaseRevokeRequest{LeaseID: other.LeaseID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Users()).To(Equal([]string{creds.Username}))
+
+		_, err = server.LeaseRevokePrefix(ctx, &apiv1.LeaseRevokePrefixRequest{Prefix: "database/creds/readonly/"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.AuthTokenRevoke(ctx, &apiv1.AuthTokenRevokeRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Users()).To(BeEmpty())
+	})
+
+	It("manages the leases of other tokens with ACLs", func() {
+		db := databasetest.Open("postgres://kstash-test")
+
+		creds, err := server.DatabaseGenerateCredentials(ctx, &apiv1.DatabaseGenerateCredentialsRequest{Role: "readonly"})
+		Expect(err).NotTo(HaveOccurred())
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "leases/database/creds/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_UPDATE},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		otherCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		_, err = server.LeaseRenew(otherCtx, &apiv1.LeaseRenewRequest{LeaseID: creds.LeaseID})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.LeaseRevoke(otherCtx, &apiv1.LeaseRevokeRequest{LeaseID: creds.LeaseID})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.LeaseRevoke(otherCtx, &apiv1.LeaseRevokeRequest{LeaseID: "database/creds/readonly/00000000000000000000000000000000"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		resp, err := server.LeaseRevokePrefix(ctx, &apiv1.LeaseRevokePrefixRequest{Prefix: "database/creds/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Revoked).To(Equal(uint32(1)))
+		Expect(db.Users()).To(BeEmpty())
+	})
+
+	It("lists pages of the entries the token can list", func() {
+		for _, key := range []string{"team/a", "team/b", "team/private/c", "team/z/d"} {
+			_, err := server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: key, Raw: []byte("secret")}})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "team/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+				},
+				{
+					Path:        "team/private/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_DENY},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		listCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		resp, err := server.KVList(listCtx, &apiv1.KVListRequest{Path: "team/", Recursive: true, PageSize: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(Equal([]string{"a", "b"}))
+		Expect(resp.NextPageToken).NotTo(BeEmpty())
+
+		resp, err = server.KVList(listCtx, &apiv1.KVListRequest{Path: "team/", Recursive: true, PageSize: 2, PageToken: resp.NextPageToken})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(Equal([]string{"z/d"}))
+		Expect(resp.NextPageToken).To(BeEmpty())
+
+		_, err = server.KVList(listCtx, &apiv1.KVListRequest{Path: "team/", PageToken: "%"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("hides the names of items the token cannot see", func() {
+		err := ks.gk.KV().Put(ctx, "test", &apiv1.Item{Key: "deny/customer-name", Raw: []byte("secret")}, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := server.KVList(ctx, &apiv1.KVListRequest{Path: "/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(ContainElement("team/"))
+		Expect(resp.Paths).NotTo(ContainElement("deny/"))
+
+		resp, err = server.KVList(ctx, &apiv1.KVListRequest{Path: "/", Recursive: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(ContainElement("team/a"))
+		Expect(resp.Paths).NotTo(ContainElement("deny/customer-name"))
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "team/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+				},
+				{
+					Path:        "team/a",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		listCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		resp, err = server.KVList(listCtx, &apiv1.KVListRequest{Path: "team/", Readable: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(Equal([]string{"a"}))
+
+		resp, err = server.KVList(listCtx, &apiv1.KVListRequest{Path: "team/", Recursive: true, Readable: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(Equal([]string{"a"}))
+	})
+
+	It("hides folders without readable items from readable listings", func() {
+		for _, key := range []string{"a/x", "a/y/z", "b/x"} {
+			err := ks.gk.KV().Put(ctx, "test", &apiv1.Item{Key: key, Raw: []byte("secret")}, "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+				},
+				{
+					Path:        "a/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		listCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		resp, err := server.KVList(listCtx, &apiv1.KVListRequest{Path: "/", Readable: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(ContainElement("a/"))
+		Expect(resp.Paths).NotTo(ContainElement("b/"))
+
+		resp, err = server.KVList(listCtx, &apiv1.KVListRequest{Path: "a/", Readable: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(Equal([]string{"x", "y/"}))
+
+		resp, err = server.KVList(listCtx, &apiv1.KVListRequest{Path: "/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(ContainElements("a/", "b/"))
+	})
+
+	It("reads and searches metadata with permission to list", func() {
+		_, err := server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "payments/db", Raw: []byte("secret")}})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "payments/api", Raw: []byte("secret")}})
+		Expect(err).NotTo(HaveOccurred())
+
+		md, err := server.KVPutMetadata(ctx, &apiv1.KVPutMetadataRequest{
+			Path:          "payments/db",
+			Owner:         "team-payments",
+			Labels:        map[string]string{"team": "payments"},
+			RotationDueAt: time.Now().Add(time.Hour).Unix(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md.Metadata.CreatedBy).To(Equal(token.ReferenceID))
+
+		_, err = server.KVPutMetadata(ctx, &apiv1.KVPutMetadataRequest{Path: "payments/api", Labels: map[string]string{"team": "payments"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVSearch(ctx, &apiv1.KVSearchRequest{Selector: "team in payments"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "payments/",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+				},
+				{
+					Path:        "payments/db",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		listCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		_, err = server.KVGet(listCtx, &apiv1.KVGetRequest{Path: "payments/db"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		resp, err := server.KVGetMetadata(listCtx, &apiv1.KVGetMetadataRequest{Path: "payments/db"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Metadata.Owner).To(Equal("team-payments"))
+
+		// folders the token cannot list cannot be searched.
+		_, err = server.KVSearch(listCtx, &apiv1.KVSearchRequest{Selector: "team=payments"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		// items the token cannot list are left out of the results.
+		search, err := server.KVSearch(listCtx, &apiv1.KVSearchRequest{Path: "payments/", Selector: "team=payments", RotationDueBefore: time.Now().Add(2 * time.Hour).Unix()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(search.Results).To(HaveLen(1))
+		Expect(search.Results[0].Path).To(Equal("payments/db"))
+
+		_, err = server.KVPutMetadata(listCtx, &apiv1.KVPutMetadataRequest{Path: "payments/db", Owner: "someone-else"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+	})
+
+	It("grants the permissions of named policies to the tokens that reference them", func() {
+		policy := &apiv1.Policy{
+			Name: "team-readers",
+			Acls: []*apiv1.ACL{
+				{Path: "team/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ}},
+			},
+		}
+		_, err := server.PolicyPut(ctx, &apiv1.PolicyPutRequest{AccessKey: "wrong", Policy: policy})
+		Expect(err).To(MatchError(gatekeeper.ErrInvalidAccessKey))
+
+		_, err = server.PolicyPut(ctx, &apiv1.PolicyPutRequest{AccessKey: accessKey, Policy: policy})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := server.PolicyList(ctx, &apiv1.PolicyListRequest{AccessKey: accessKey})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Names).To(Equal([]string{"team-readers"}))
+
+		_, err = server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			Policies:  []string{"missing"},
+		})
+		Expect(err).To(MatchError(auth.ErrPolicyNotFound))
+
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Policies:  []string{"team-readers"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		policyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		_, err = server.KVGet(policyCtx, &apiv1.KVGetRequest{Path: "team/a"})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := server.KVList(policyCtx, &apiv1.KVListRequest{Path: "team/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Paths).To(ContainElement("a"))
+
+		// the token is not reissued for the change to apply.
+		policy.Acls[0].Permissions = []apiv1.Permission{apiv1.Permission_LIST}
+		_, err = server.PolicyPut(ctx, &apiv1.PolicyPutRequest{AccessKey: accessKey, Policy: policy})
+		Expect(err).NotTo(HaveOccurred())
+
+		get, err := server.PolicyGet(ctx, &apiv1.PolicyGetRequest{AccessKey: accessKey, Name: "team-readers"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(get.Policy.Acls[0].Permissions).To(Equal([]apiv1.Permission{apiv1.Permission_LIST}))
+
+		_, err = server.KVGet(policyCtx, &apiv1.KVGetRequest{Path: "team/a"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.PolicyDelete(ctx, &apiv1.PolicyDeleteRequest{AccessKey: accessKey, Name: "team-readers"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVList(policyCtx, &apiv1.KVListRequest{Path: "team/"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+	})
+
+	It("matches wildcards and templates of token metadata in ACL paths", func() {
+		for _, path := range []string{"apps/web/db-password", "apps/api/db-password", "apps/api/api-key", "teams/red/a", "teams/blue/a"} {
+			_, err := server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: path, Raw: []byte("value")}})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Metadata:  map[string]string{"team": "red"},
+			Acls: []*apiv1.ACL{
+				{Path: "apps/+/db-password", Permissions: []apiv1.Permission{apiv1.Permission_READ}},
+				{Path: "apps/web/db-password", Permissions: []apiv1.Permission{apiv1.Permission_DENY}},
+				{Path: "teams/{{token.metadata.team}}/*", Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		tokenCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+resp.Token.Id))
+		_, err = server.KVGet(tokenCtx, &apiv1.KVGetRequest{Path: "apps/api/db-password"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVGet(tokenCtx, &apiv1.KVGetRequest{Path: "apps/web/db-password"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.KVGet(tokenCtx, &apiv1.KVGetRequest{Path: "apps/api/api-key"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		list, err := server.KVList(tokenCtx, &apiv1.KVListRequest{Path: "teams/red/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Paths).To(Equal([]string{"a"}))
+
+		_, err = server.KVGet(tokenCtx, &apiv1.KVGetRequest{Path: "teams/blue/a"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			Acls: []*apiv1.ACL{
+				{Path: "teams/{{token.id}}/*", Permissions: []apiv1.Permission{apiv1.Permission_READ}},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("evaluates the conditions of ACLs with the client address and metadata of requests", func() {
+		_, err := server.KVPut(ctx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "conditional/a", Raw: []byte("value")}})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "conditional/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_LIST, apiv1.Permission_READ},
+					Conditions:  &apiv1.ACLConditions{Cidrs: []string{"10.0.0.0/8"}},
+				},
+				{
+					Path:        "conditional/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_UPDATE},
+					Conditions:  &apiv1.ACLConditions{RequiredMetadata: map[string]string{"x-change-ticket": ""}},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		from := func(addr string, pairs ...string) context.Context {
+			ctx := metadata.NewIncomingContext(ctx, metadata.Pairs(append([]string{"authorization", "Bearer " + resp.Token.Id}, pairs...)...))
+			return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 50000}})
+		}
+
+		_, err = server.KVGet(from("10.1.2.3"), &apiv1.KVGetRequest{Path: "conditional/a"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVGet(from("192.168.1.1"), &apiv1.KVGetRequest{Path: "conditional/a"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+		st, _ := status.FromError(err)
+		Expect(st.Code()).To(Equal(codes.PermissionDenied))
+		Expect(st.Details()).To(HaveLen(1))
+		Expect(st.Details()[0].(*errdetails.ErrorInfo).Metadata).To(HaveKeyWithValue("condition", auth.ConditionCIDR))
+
+		// the X-Forwarded-For header is only trusted from trusted proxies, such as the REST gateway on the loopback address.
+		_, err = server.KVGet(from("127.0.0.1", "x-forwarded-for", "10.1.2.3"), &apiv1.KVGetRequest{Path: "conditional/a"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.KVGet(from("127.0.0.1", "x-forwarded-for", "10.1.2.3, 192.168.1.1"), &apiv1.KVGetRequest{Path: "conditional/a"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.KVGet(from("192.168.1.1", "x-forwarded-for", "10.1.2.3"), &apiv1.KVGetRequest{Path: "conditional/a"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		list, err := server.KVList(from("10.1.2.3"), &apiv1.KVListRequest{Path: "conditional/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Paths).To(Equal([]string{"a"}))
+
+		_, err = server.KVList(from("192.168.1.1"), &apiv1.KVListRequest{Path: "conditional/"})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+
+		_, err = server.KVPut(from("192.168.1.1"), &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "conditional/a", Raw: []byte("new")}})
+		Expect(err).To(MatchError(auth.ErrForbidden))
+		st, _ = status.FromError(err)
+		Expect(st.Details()[0].(*errdetails.ErrorInfo).Metadata).To(HaveKeyWithValue("condition", auth.ConditionMetadata))
+
+		_, err = server.KVPut(from("192.168.1.1", "x-change-ticket", "CHG-1"), &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "conditional/a", Raw: []byte("new")}})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			Acls: []*apiv1.ACL{
+				{
+					Path:        "conditional/*",
+					Permissions: []apiv1.Permission{apiv1.Permission_READ},
+					Conditions:  &apiv1.ACLConditions{Cidrs: []string{"10.0.0.1"}},
+				},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("explains the permissions of tokens", func() {
+		e, err := server.ACLExplain(ctx, &apiv1.ACLExplainRequest{Path: "deny/item", Permission: apiv1.Permission_READ})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Explanation.Allowed).To(BeFalse())
+		Expect(e.Explanation.Deny.Acl.Path).To(Equal("deny/*"))
+		Expect(e.Explanation.EffectivePermissions).To(BeEmpty())
+
+		_, err = server.ACLExplain(ctx, &apiv1.ACLExplainRequest{Path: "deny/item"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		resp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{Path: "apps/+/db-password", Permissions: []apiv1.Permission{apiv1.Permission_READ}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		e, err = server.ACLExplain(ctx, &apiv1.ACLExplainRequest{TokenID: resp.Token.Id, Path: "apps/api/db-password", Permission: apiv1.Permission_READ})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Explanation.Allowed).To(BeTrue())
+		Expect(e.Explanation.MatchedRule.Acl.Path).To(Equal("apps/+/db-password"))
+
+		_, err = server.ACLExplain(ctx, &apiv1.ACLExplainRequest{TokenReferenceID: resp.Token.ReferenceID, Path: "apps/api/db-password", Permission: apiv1.Permission_READ})
+		Expect(err).To(MatchError(gatekeeper.ErrInvalidAccessKey))
+
+		e, err = server.ACLExplain(ctx, &apiv1.ACLExplainRequest{
+			TokenReferenceID: resp.Token.ReferenceID,
+			AccessKey:        accessKey,
+			Path:             "apps/api/api-key",
+			Permission:       apiv1.Permission_READ,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Explanation.Allowed).To(BeFalse())
+		Expect(e.Explanation.Reason).To(Equal("no acl matches the path"))
+		Expect(e.Explanation.Rules).To(HaveLen(1))
+		Expect(e.Explanation.Rules[0].Matched).To(BeFalse())
+	})
+
 	It("can revoke token", func() {
 		req := &apiv1.AuthTokenRevokeRequest{
 			TokenID: token.Id,
@@ -484,6 +1344,168 @@ var _ = Describe("kstash", func() {
 		Expect(resp).NotTo(BeNil())
 	})
 
+	It("creates response messages for forwarded requests", func() {
+		resp, err := newResponse("/kstash.v1.KStash/KVPut")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp).To(BeAssignableToTypeOf(&apiv1.KVPutResponse{}))
+
+		_, err = newResponse("/kstash.v1.KStash/DoesNotExist")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("forwards requests from a standby node to the active node", func() {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// the active node records the metadata of the requests it receives.
+		var (
+			forwardedMu   sync.Mutex
+			forwarded     []string
+			forwardedMeta metadata.MD
+		)
+		record := func(ctx context.Context, method string) {
+			forwardedMu.Lock()
+			defer forwardedMu.Unlock()
+
+			forwarded = append(forwarded, method)
+			forwardedMeta, _ = metadata.FromIncomingContext(ctx)
+		}
+
+		activeListener := bufconn.Listen(1 << 20)
+		activeServer := grpc.NewServer(
+			grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				record(ctx, info.FullMethod)
+				return handler(ctx, req)
+			}),
+			grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				record(ss.Context(), info.FullMethod)
+				return handler(srv, ss)
+			}),
+			WithUnaryForwarding(server),
+			WithStreamForwarding(server),
+		)
+		apiv1.RegisterKStashServer(activeServer, server)
+		go activeServer.Serve(activeListener)
+		defer activeServer.Stop()
+
+		activeHA := ha.NewManager(log, ks.gk.Storage(), ks.gk.Barrier(), "active", "active:8080")
+		go activeHA.Run(ctx)
+		Eventually(activeHA.IsActive).Should(BeTrue())
+
+		// the standby shares the storage backend of the active node, and reaches the advertised address over the in-memory listener.
+		activeConn, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return activeListener.Dial()
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		defer activeConn.Close()
+
+		standby := &KStash{
+			log:            log,
+			gk:             ks.gk,
+			ha:             ha.NewManager(log, ks.gk.Storage(), ks.gk.Barrier(), "standby", "standby:8080"),
+			conns:          map[string]*grpc.ClientConn{"active:8080": activeConn},
+			trustedProxies: ks.trustedProxies,
+		}
+
+		standbyListener := bufconn.Listen(1 << 20)
+		standbyServer := grpc.NewServer(
+			// clients of the standby connect through the REST gateway on the loopback address, which is a trusted proxy.
+			grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return handler(peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000}}), req)
+			}),
+			WithUnaryForwarding(standby),
+			WithStreamForwarding(standby),
+		)
+		apiv1.RegisterKStashServer(standbyServer, standby)
+		go standbyServer.Serve(standbyListener)
+		defer standbyServer.Stop()
+
+		standbyConn, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return standbyListener.Dial()
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		defer standbyConn.Close()
+		client := apiv1.NewKStashClient(standbyConn)
+
+		By("forwarding writes with the metadata and address of the client")
+		tokenResp, err := server.SystemGenerateAccessToken(ctx, &apiv1.SystemGenerateAccessTokenRequest{
+			AccessKey: accessKey,
+			Namespace: "test",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Acls: []*apiv1.ACL{
+				{Path: "forwarded/*", Permissions: []apiv1.Permission{apiv1.Permission_READ, apiv1.Permission_CREATE, apiv1.Permission_UPDATE, apiv1.Permission_DELETE}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tokenResp.Token.Id))
+		outCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tokenResp.Token.Id, "x-change-ticket", "CHG-1", "x-forwarded-for", "10.1.2.3")
+		var header metadata.MD
+		_, err = client.KVPut(outCtx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "forwarded/a", Raw: []byte("value")}}, grpc.Header(&header))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header.Get(leaderAddressHeader)).To(Equal([]string{"active:8080"}))
+
+		forwardedMu.Lock()
+		Expect(forwarded).To(Equal([]string{servicePrefix + "KVPut"}))
+		Expect(forwardedMeta.Get(forwardedHeader)).To(Equal([]string{"standby"}))
+		Expect(forwardedMeta.Get("x-change-ticket")).To(Equal([]string{"CHG-1"}))
+		Expect(forwardedMeta.Get("x-forwarded-for")).To(Equal([]string{"10.1.2.3"}))
+		forwardedMu.Unlock()
+
+		item, err := server.KVGet(ctx, &apiv1.KVGetRequest{Path: "forwarded/a"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Item.Raw).To(Equal([]byte("value")))
+
+		By("serving reads locally")
+		_, err = client.KVGet(outCtx, &apiv1.KVGetRequest{Path: "forwarded/a"})
+		Expect(err).NotTo(HaveOccurred())
+		forwardedMu.Lock()
+		Expect(forwarded).To(HaveLen(1))
+		forwardedMu.Unlock()
+
+		By("forwarding streams")
+		snapshot, err := client.SystemSnapshot(ctx, &apiv1.SystemSnapshotRequest{UnsealKeys: unsealKeys})
+		Expect(err).NotTo(HaveOccurred())
+		size := 0
+		for {
+			resp, err := snapshot.Recv()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+			size += len(resp.Data)
+		}
+		Expect(size).To(BeNumerically(">", 0))
+
+		restore, err := client.SystemRestore(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.Send(&apiv1.SystemRestoreRequest{Data: []byte("snapshot")})).To(Succeed())
+		_, err = restore.CloseAndRecv()
+		Expect(err).To(HaveOccurred())
+
+		forwardedMu.Lock()
+		Expect(forwarded).To(Equal([]string{servicePrefix + "KVPut", servicePrefix + "SystemSnapshot", servicePrefix + "SystemRestore"}))
+		forwardedMu.Unlock()
+
+		By("rejecting requests that were forwarded already")
+		_, err = client.KVPut(metadata.AppendToOutgoingContext(outCtx, forwardedHeader, "other"), &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "forwarded/a", Raw: []byte("loop")}})
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+
+		By("redirecting clients to the active node")
+		standby.haRedirect = true
+		header = nil
+		_, err = client.KVPut(outCtx, &apiv1.KVPutRequest{Item: &apiv1.Item{Key: "forwarded/a", Raw: []byte("redirected")}}, grpc.Header(&header))
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		Expect(header.Get(leaderAddressHeader)).To(Equal([]string{"active:8080"}))
+
+		forwardedMu.Lock()
+		Expect(forwarded).To(HaveLen(3))
+		forwardedMu.Unlock()
+
+		_, err = server.KVDelete(ctx, &apiv1.KVDeleteRequest{Path: "forwarded/a"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
 	It("can parse the TTL", func() {
 		d, err := ks.ParseTTL("")
 		Expect(err).To(MatchError(ErrInvalidTTL))
@@ -2,11 +2,20 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/secret/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// KVList will list the contents of the provided path.
+// KVList will list the contents of the provided path along with the expiry of its items. Expired items are not listed,
+// and neither are entries the token is not permitted to list, so the names in denied folders are hidden completely.
+// If the request is readable, only items the token is permitted to read are listed. Large folders can be listed in pages.
 func (s *KStash) KVList(ctx context.Context, req *apiv1.KVListRequest) (*apiv1.KVListResponse, error) {
 	resp := &apiv1.KVListResponse{}
 
@@ -15,15 +24,35 @@ func (s *KStash) KVList(ctx context.Context, req *apiv1.KVListRequest) (*apiv1.K
 		return resp, err
 	}
 
-	resp.Paths, err = s.gk.KV().List(ctx, token.Namespace, req.Path)
+	acls, err := s.gk.ACLManager().TokenACLs(ctx, token)
 	if err != nil {
 		return resp, err
 	}
 
+	reqCtx := s.requestContext(ctx)
+	resp.Entries, resp.NextPageToken, err = s.gk.KV().ListPage(ctx, token.Namespace, req.Path, kv.ListOptions{
+		Recursive: req.Recursive,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+		Filter: func(path string) bool {
+			return s.canSee(reqCtx, acls, token.Namespace, path, req.Readable)
+		},
+	})
+	if err != nil {
+		if errors.Is(err, kv.ErrInvalidPageToken) {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return resp, err
+	}
+
+	for _, entry := range resp.Entries {
+		resp.Paths = append(resp.Paths, entry.Path)
+	}
+
 	return resp, nil
 }
 
-// KVGet will get an item from the provided path.
+// KVGet will get an item from the provided path. Expired items are not found.
 func (s *KStash) KVGet(ctx context.Context, req *apiv1.KVGetRequest) (*apiv1.KVGetResponse, error) {
 	resp := &apiv1.KVGetResponse{}
 
@@ -40,7 +69,8 @@ func (s *KStash) KVGet(ctx context.Context, req *apiv1.KVGetRequest) (*apiv1.KVG
 	return resp, nil
 }
 
-// KVPut will put an item in the provided path.
+// KVPut will put an item in the provided path. Items larger than the size limit of the token's namespace are rejected as invalid arguments.
+// Items expire after the TTL of the request or at their expiresAt, after which they are deleted.
 func (s *KStash) KVPut(ctx context.Context, req *apiv1.KVPutRequest) (*apiv1.KVPutResponse, error) {
 	resp := &apiv1.KVPutResponse{}
 
@@ -49,7 +79,23 @@ func (s *KStash) KVPut(ctx context.Context, req *apiv1.KVPutRequest) (*apiv1.KVP
 		return resp, err
 	}
 
-	err = s.gk.KV().Put(ctx, token.Namespace, req.Item)
+	if len(req.Ttl) > 0 {
+		if req.Item.ExpiresAt != 0 {
+			return resp, status.Error(codes.InvalidArgument, "either a TTL or an expiry can be specified")
+		}
+
+		ttl, err := s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+		req.Item.ExpiresAt = time.Now().Add(ttl).Unix()
+	}
+
+	err = s.gk.KV().Put(ctx, token.Namespace, req.Item, token.ReferenceID)
+	if errors.Is(err, barrier.ErrItemTooLarge) || errors.Is(err, kv.ErrInvalidExpiry) || errors.Is(err, kv.ErrReservedPath) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	return resp, err
 }
 
@@ -65,3 +111,106 @@ func (s *KStash) KVDelete(ctx context.Context, req *apiv1.KVDeleteRequest) (*api
 	err = s.gk.KV().Delete(ctx, token.Namespace, req.Path)
 	return resp, err
 }
+
+// KVGetMetadata will get the metadata of the item at the provided path. Metadata only requires permission to list the item, not to read it.
+func (s *KStash) KVGetMetadata(ctx context.Context, req *apiv1.KVGetMetadataRequest) (*apiv1.KVGetMetadataResponse, error) {
+	resp := &apiv1.KVGetMetadataResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_LIST, req.Path)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Metadata, err = s.gk.KV().GetMetadata(ctx, token.Namespace, req.Path)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// KVPutMetadata will set the owner, description, labels and rotation due date of the item at the provided path.
+func (s *KStash) KVPutMetadata(ctx context.Context, req *apiv1.KVPutMetadataRequest) (*apiv1.KVPutMetadataResponse, error) {
+	resp := &apiv1.KVPutMetadataResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, req.Path)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Metadata, err = s.gk.KV().PutMetadata(ctx, token.Namespace, &apiv1.KVMetadata{
+		Path:          req.Path,
+		Owner:         req.Owner,
+		Description:   req.Description,
+		Labels:        req.Labels,
+		RotationDueAt: req.RotationDueAt,
+	})
+	if errors.Is(err, barrier.ErrItemTooLarge) || errors.Is(err, kv.ErrInvalidMetadata) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, err
+}
+
+// KVSearch will search the items of the token's namespace by the labels of their metadata.
+// Only items the token is permitted to list are returned.
+func (s *KStash) KVSearch(ctx context.Context, req *apiv1.KVSearchRequest) (*apiv1.KVSearchResponse, error) {
+	resp := &apiv1.KVSearchResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_LIST, req.Path)
+	if err != nil {
+		return resp, err
+	}
+
+	results, err := s.gk.KV().Search(ctx, token.Namespace, req.Path, req.Selector, req.RotationDueBefore)
+	if err != nil {
+		if errors.Is(err, kv.ErrInvalidSelector) {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return resp, err
+	}
+
+	acls, err := s.gk.ACLManager().TokenACLs(ctx, token)
+	if err != nil {
+		return resp, err
+	}
+
+	reqCtx := s.requestContext(ctx)
+	for _, md := range results {
+		if s.canSee(reqCtx, acls, token.Namespace, md.Path, false) {
+			resp.Results = append(resp.Results, md)
+		}
+	}
+
+	return resp, nil
+}
+
+// canSee determines if an entry of a listing is visible to a token with the given ACLs. Entries must be permitted to be listed,
+// or to be read if readable is set. Folders cannot be read, so if readable is set they are only visible if they are permitted
+// to be listed and hold an item that is permitted to be read.
+// The context must carry the request, so that the conditions of the ACLs are evaluated.
+func (s *KStash) canSee(ctx context.Context, acls []*apiv1.ACL, namespace, path string, readable bool) bool {
+	acl := s.gk.ACLManager()
+	if !readable || strings.HasSuffix(path, "/") {
+		if acl.CanPerform(ctx, acls, apiv1.Permission_LIST, namespace, path) != nil {
+			return false
+		}
+		return !readable || s.hasReadable(ctx, acls, namespace, path)
+	}
+
+	return acl.CanPerform(ctx, acls, apiv1.Permission_READ, namespace, path) == nil
+}
+
+// hasReadable determines if a folder holds an item that is permitted to be read by a token with the given ACLs. The folder is only
+// listed until the first such item is found. Folders that cannot be listed are treated as holding no readable items.
+func (s *KStash) hasReadable(ctx context.Context, acls []*apiv1.ACL, namespace, folder string) bool {
+	entries, _, err := s.gk.KV().ListPage(ctx, namespace, folder, kv.ListOptions{
+		Recursive: true,
+		PageSize:  1,
+		Filter: func(path string) bool {
+			return s.gk.ACLManager().CanPerform(ctx, acls, apiv1.Permission_READ, namespace, path) == nil
+		},
+	})
+
+	return err == nil && len(entries) > 0
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/lease"
+	"github.com/slaskawi/vault-poc/pkg/secret/kv"
+)
+
+const leasesPath = "leases/"
+
+// LeaseRenew extends a lease, but never past its max TTL or the expiry of the token that created it. Tokens may renew their own leases,
+// and other leases of their namespace if they are allowed to update `leases/<lease ID>`.
+func (s *KStash) LeaseRenew(ctx context.Context, req *apiv1.LeaseRenewRequest) (*apiv1.LeaseRenewResponse, error) {
+	resp := &apiv1.LeaseRenewResponse{}
+
+	l, err := s.canLease(ctx, apiv1.Permission_UPDATE, req.LeaseID)
+	if err != nil {
+		return resp, err
+	}
+
+	var increment time.Duration
+	if len(req.Increment) > 0 {
+		increment, err = s.ParseTTL(req.Increment)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	// leases that do not belong to a token, such as those of expiring items, are only limited by their max expiry.
+	var notAfter time.Time
+	if len(l.TokenReferenceID) > 0 {
+		owner, err := s.gk.TokenManager().GetTokenByReferenceID(ctx, l.TokenReferenceID)
+		if err != nil {
+			return resp, err
+		}
+		notAfter = time.Unix(owner.ExpiresAt, 0)
+	}
+
+	resp.Lease, err = s.gk.LeaseManager().Renew(ctx, l.Id, increment, notAfter)
+	return resp, err
+}
+
+// LeaseRevoke immediately revokes a lease and its secret. Tokens may revoke their own leases,
+// and other leases of their namespace if they are allowed to delete `leases/<lease ID>`.
+func (s *KStash) LeaseRevoke(ctx context.Context, req *apiv1.LeaseRevokeRequest) (*apiv1.LeaseRevokeResponse, error) {
+	resp := &apiv1.LeaseRevokeResponse{}
+
+	l, err := s.canLease(ctx, apiv1.Permission_DELETE, req.LeaseID)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.LeaseManager().Revoke(ctx, l.Id)
+	return resp, err
+}
+
+// LeaseRevokePrefix revokes all leases of the token's namespace whose ID starts with a prefix, e.g. `database/creds/readonly/`.
+// The token must be allowed to delete `leases/<prefix>`.
+func (s *KStash) LeaseRevokePrefix(ctx context.Context, req *apiv1.LeaseRevokePrefixRequest) (*apiv1.LeaseRevokePrefixResponse, error) {
+	resp := &apiv1.LeaseRevokePrefixResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_DELETE, leasesPath+req.Prefix)
+	if err != nil {
+		return resp, err
+	}
+
+	revoked, err := s.gk.LeaseManager().RevokePrefix(ctx, token.Namespace, req.Prefix)
+	resp.Revoked = uint32(revoked)
+	return resp, err
+}
+
+// canLease returns a lease if the token stored in the given context may perform the operation on it. Tokens may always manage
+// the leases they created. Leases of other namespaces are reported as not found, and the existence of leases is only revealed
+// to tokens that are allowed to manage them.
+func (s *KStash) canLease(ctx context.Context, perm apiv1.Permission, id string) (*apiv1.Lease, error) {
+	token, err := s.GetToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(token.Namespace) == 0 {
+		return nil, kv.ErrNoNamespace
+	}
+
+	aclErr := s.canPerform(ctx, token, perm, leasesPath+id)
+
+	l, err := s.gk.LeaseManager().Get(ctx, id)
+	if err == nil && l.Namespace != token.Namespace {
+		err = fmt.Errorf("%w: %s", lease.ErrLeaseNotFound, id)
+	}
+	if err != nil {
+		if aclErr != nil {
+			return nil, aclErr
+		}
+		return nil, err
+	}
+
+	if len(l.TokenReferenceID) > 0 && l.TokenReferenceID == token.ReferenceID {
+		return l, nil
+	}
+
+	return l, aclErr
+}
+
+// leaseManagerActive determines if this node is responsible for revoking expired leases, which is the unsealed active node.
+func (s *KStash) leaseManagerActive() bool {
+	if s.ha != nil && !s.ha.IsActive() {
+		return false
+	}
+
+	sealed, err := s.gk.Barrier().IsSealed(context.Background())
+	return err == nil && !sealed
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/secret/kv"
+)
+
+// NamespaceConfigureKey wraps the key material of the token's namespace with a wrapping key supplied by the tenant.
+// The token must be allowed to update the root of the namespace.
+func (s *KStash) NamespaceConfigureKey(ctx context.Context, req *apiv1.NamespaceConfigureKeyRequest) (*apiv1.NamespaceConfigureKeyResponse, error) {
+	resp := &apiv1.NamespaceConfigureKeyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, "/")
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Barrier().ConfigureNamespaceKey(ctx, token.Namespace, req.CurrentWrappingKey, req.WrappingKey)
+	return resp, err
+}
+
+// NamespaceUnlock unlocks the token's namespace on this node with the tenant's wrapping key.
+// The token must be allowed to update the root of the namespace.
+func (s *KStash) NamespaceUnlock(ctx context.Context, req *apiv1.NamespaceUnlockRequest) (*apiv1.NamespaceUnlockResponse, error) {
+	resp := &apiv1.NamespaceUnlockResponse{}
+
+	namespace, err := s.updatableNamespace(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Barrier().UnlockNamespace(ctx, namespace, req.WrappingKey)
+	return resp, err
+}
+
+// NamespaceLock locks the token's namespace on this node until the tenant's wrapping key is supplied again.
+// The token must be allowed to update the root of the namespace, since locking it denies access to every other token.
+func (s *KStash) NamespaceLock(ctx context.Context, req *apiv1.NamespaceLockRequest) (*apiv1.NamespaceLockResponse, error) {
+	resp := &apiv1.NamespaceLockResponse{}
+
+	namespace, err := s.updatableNamespace(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Barrier().LockNamespace(namespace)
+	return resp, err
+}
+
+// NamespaceStatus gets the status of the token's namespace on this node.
+func (s *KStash) NamespaceStatus(ctx context.Context, req *apiv1.NamespaceStatusRequest) (*apiv1.NamespaceStatusResponse, error) {
+	resp := &apiv1.NamespaceStatusResponse{}
+
+	namespace, err := s.tokenNamespace(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.TenantKeyed, resp.Locked, err = s.gk.Barrier().NamespaceStatus(ctx, namespace)
+	return resp, err
+}
+
+func (s *KStash) tokenNamespace(ctx context.Context) (string, error) {
+	token, err := s.GetToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(token.Namespace) == 0 {
+		return "", kv.ErrNoNamespace
+	}
+
+	return token.Namespace, nil
+}
+
+// updatableNamespace returns the namespace of a token that is allowed to update the root of its namespace.
+func (s *KStash) updatableNamespace(ctx context.Context) (string, error) {
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, "/")
+	if err != nil {
+		return "", err
+	}
+
+	if len(token.Namespace) == 0 {
+		return "", kv.ErrNoNamespace
+	}
+
+	return token.Namespace, nil
+}
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+const (
+	pkiCAPath     = "engines/pki/ca"
+	pkiCRLPath    = "engines/pki/crl"
+	pkiIssuePath  = "engines/pki/issue/"
+	pkiRevokePath = "engines/pki/revoke"
+	pkiRolesPath  = "engines/pki/roles/"
+	pkiSignPath   = "engines/pki/sign/"
+)
+
+// PKIGenerateCA generates a self-signed CA for the token's namespace. The token must be allowed to create `engines/pki/ca`.
+func (s *KStash) PKIGenerateCA(ctx context.Context, req *apiv1.PKIGenerateCARequest) (*apiv1.PKIGenerateCAResponse, error) {
+	resp := &apiv1.PKIGenerateCAResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_CREATE, pkiCAPath)
+	if err != nil {
+		return resp, err
+	}
+
+	var ttl time.Duration
+	if len(req.Ttl) > 0 {
+		ttl, err = s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	resp.Certificate, err = s.gk.PKI().GenerateCA(ctx, token.Namespace, req.CommonName, req.KeyType, ttl)
+	return resp, err
+}
+
+// PKIImportCA imports a CA certificate and its private key into the token's namespace. The token must be allowed to create `engines/pki/ca`.
+func (s *KStash) PKIImportCA(ctx context.Context, req *apiv1.PKIImportCARequest) (*apiv1.PKIImportCAResponse, error) {
+	resp := &apiv1.PKIImportCAResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_CREATE, pkiCAPath)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.PKI().ImportCA(ctx, token.Namespace, req.Certificate, req.PrivateKey)
+	return resp, err
+}
+
+// PKIGetCA returns the CA certificate of the token's namespace. The token must be allowed to read `engines/pki/ca`.
+func (s *KStash) PKIGetCA(ctx context.Context, req *apiv1.PKIGetCARequest) (*apiv1.PKIGetCAResponse, error) {
+	resp := &apiv1.PKIGetCAResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, pkiCAPath)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Certificate, err = s.gk.PKI().GetCA(ctx, token.Namespace)
+	return resp, err
+}
+
+// PKIGetCRL generates the certificate revocation list of the token's namespace. The token must be allowed to read `engines/pki/crl`.
+func (s *KStash) PKIGetCRL(ctx context.Context, req *apiv1.PKIGetCRLRequest) (*apiv1.PKIGetCRLResponse, error) {
+	resp := &apiv1.PKIGetCRLResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, pkiCRLPath)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Crl, err = s.gk.PKI().CRL(ctx, token.Namespace)
+	return resp, err
+}
+
+// PKIPutRole creates or replaces a role that restricts the certificates issued by the PKI engine.
+// The token must be allowed to update `engines/pki/roles/<name>`.
+func (s *KStash) PKIPutRole(ctx context.Context, req *apiv1.PKIPutRoleRequest) (*apiv1.PKIPutRoleResponse, error) {
+	resp := &apiv1.PKIPutRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, pkiRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	role := &apiv1.PKIRole{
+		Name:             req.Name,
+		AllowedDomains:   req.AllowedDomains,
+		AllowBareDomains: req.AllowBareDomains,
+		AllowSubdomains:  req.AllowSubdomains,
+		AllowIPSANs:      req.AllowIPSANs,
+		KeyType:          req.KeyType,
+	}
+	if len(req.Ttl) > 0 {
+		ttl, err := s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+		role.Ttl = int64(ttl.Seconds())
+	}
+	if len(req.MaxTTL) > 0 {
+		maxTTL, err := s.ParseTTL(req.MaxTTL)
+		if err != nil {
+			return resp, err
+		}
+		role.MaxTTL = int64(maxTTL.Seconds())
+	}
+
+	err = s.gk.PKI().PutRole(ctx, token.Namespace, role)
+	return resp, err
+}
+
+// PKIGetRole returns a role of the PKI engine. The token must be allowed to read `engines/pki/roles/<name>`.
+func (s *KStash) PKIGetRole(ctx context.Context, req *apiv1.PKIGetRoleRequest) (*apiv1.PKIGetRoleResponse, error) {
+	resp := &apiv1.PKIGetRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, pkiRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Role, err = s.gk.PKI().GetRole(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// PKIDeleteRole deletes a role of the PKI engine. The token must be allowed to delete `engines/pki/roles/<name>`.
+func (s *KStash) PKIDeleteRole(ctx context.Context, req *apiv1.PKIDeleteRoleRequest) (*apiv1.PKIDeleteRoleResponse, error) {
+	resp := &apiv1.PKIDeleteRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_DELETE, pkiRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.PKI().DeleteRole(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// PKIIssue generates a private key and issues a certificate for it that is allowed by a role.
+// The token must be allowed to update `engines/pki/issue/<role>`.
+func (s *KStash) PKIIssue(ctx context.Context, req *apiv1.PKIIssueRequest) (*apiv1.PKIIssueResponse, error) {
+	resp := &apiv1.PKIIssueResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, pkiIssuePath+req.Role)
+	if err != nil {
+		return resp, err
+	}
+
+	var ttl time.Duration
+	if len(req.Ttl) > 0 {
+		ttl, err = s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	resp.Certificate, err = s.gk.PKI().Issue(ctx, token.Namespace, req.Role, req.CommonName, req.AltNames, req.IpSANs, ttl)
+	return resp, err
+}
+
+// PKISign issues a certificate for a certificate signing request that is allowed by a role.
+// The token must be allowed to update `engines/pki/sign/<role>`.
+func (s *KStash) PKISign(ctx context.Context, req *apiv1.PKISignRequest) (*apiv1.PKISignResponse, error) {
+	resp := &apiv1.PKISignResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, pkiSignPath+req.Role)
+	if err != nil {
+		return resp, err
+	}
+
+	var ttl time.Duration
+	if len(req.Ttl) > 0 {
+		ttl, err = s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	resp.Certificate, err = s.gk.PKI().Sign(ctx, token.Namespace, req.Role, req.Csr, ttl)
+	return resp, err
+}
+
+// PKIRevoke revokes a certificate issued by the PKI engine, which adds it to the CRL. The token must be allowed to update `engines/pki/revoke`.
+func (s *KStash) PKIRevoke(ctx context.Context, req *apiv1.PKIRevokeRequest) (*apiv1.PKIRevokeResponse, error) {
+	resp := &apiv1.PKIRevokeResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, pkiRevokePath)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.RevokedAt, err = s.gk.PKI().Revoke(ctx, token.Namespace, req.SerialNumber)
+	return resp, err
+}
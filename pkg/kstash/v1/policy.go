@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// PolicyPut creates or replaces a named policy with a valid access key. Changes apply immediately to all tokens that reference the policy.
+func (s *KStash) PolicyPut(ctx context.Context, req *apiv1.PolicyPutRequest) (*apiv1.PolicyPutResponse, error) {
+	resp := &apiv1.PolicyPutResponse{}
+
+	if err := s.gk.CompareAccessKey(ctx, req.AccessKey); err != nil {
+		return resp, err
+	}
+
+	return resp, s.gk.PolicyManager().PutPolicy(ctx, req.Policy)
+}
+
+// PolicyGet gets a named policy with a valid access key.
+func (s *KStash) PolicyGet(ctx context.Context, req *apiv1.PolicyGetRequest) (*apiv1.PolicyGetResponse, error) {
+	resp := &apiv1.PolicyGetResponse{}
+
+	if err := s.gk.CompareAccessKey(ctx, req.AccessKey); err != nil {
+		return resp, err
+	}
+
+	var err error
+	resp.Policy, err = s.gk.PolicyManager().GetPolicy(ctx, req.Name)
+	return resp, err
+}
+
+// PolicyList lists the names of all policies with a valid access key.
+func (s *KStash) PolicyList(ctx context.Context, req *apiv1.PolicyListRequest) (*apiv1.PolicyListResponse, error) {
+	resp := &apiv1.PolicyListResponse{}
+
+	if err := s.gk.CompareAccessKey(ctx, req.AccessKey); err != nil {
+		return resp, err
+	}
+
+	var err error
+	resp.Names, err = s.gk.PolicyManager().ListPolicies(ctx)
+	return resp, err
+}
+
+// PolicyDelete deletes a named policy with a valid access key. Tokens that reference the policy lose the permissions it granted.
+func (s *KStash) PolicyDelete(ctx context.Context, req *apiv1.PolicyDeleteRequest) (*apiv1.PolicyDeleteResponse, error) {
+	resp := &apiv1.PolicyDeleteResponse{}
+
+	if err := s.gk.CompareAccessKey(ctx, req.AccessKey); err != nil {
+		return resp, err
+	}
+
+	return resp, s.gk.PolicyManager().DeletePolicy(ctx, req.Name)
+}
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+const (
+	sshCAPath    = "engines/ssh/ca"
+	sshRolesPath = "engines/ssh/roles/"
+	sshSignPath  = "engines/ssh/sign/"
+)
+
+// SSHGenerateCA generates an SSH CA for the token's namespace. The token must be allowed to create `engines/ssh/ca`.
+func (s *KStash) SSHGenerateCA(ctx context.Context, req *apiv1.SSHGenerateCARequest) (*apiv1.SSHGenerateCAResponse, error) {
+	resp := &apiv1.SSHGenerateCAResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_CREATE, sshCAPath)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.PublicKey, err = s.gk.SSHCA().GenerateCA(ctx, token.Namespace)
+	return resp, err
+}
+
+// SSHImportCA imports the private key of an SSH CA into the token's namespace. The token must be allowed to create `engines/ssh/ca`.
+func (s *KStash) SSHImportCA(ctx context.Context, req *apiv1.SSHImportCARequest) (*apiv1.SSHImportCAResponse, error) {
+	resp := &apiv1.SSHImportCAResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_CREATE, sshCAPath)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.PublicKey, err = s.gk.SSHCA().ImportCA(ctx, token.Namespace, req.PrivateKey)
+	return resp, err
+}
+
+// SSHGetCA returns the public key of the SSH CA of the token's namespace. The token must be allowed to read `engines/ssh/ca`.
+func (s *KStash) SSHGetCA(ctx context.Context, req *apiv1.SSHGetCARequest) (*apiv1.SSHGetCAResponse, error) {
+	resp := &apiv1.SSHGetCAResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, sshCAPath)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.PublicKey, err = s.gk.SSHCA().GetCA(ctx, token.Namespace)
+	return resp, err
+}
+
+// SSHPutRole creates or replaces a role that restricts the keys signed by the SSH CA. The token must be allowed to update `engines/ssh/roles/<name>`.
+func (s *KStash) SSHPutRole(ctx context.Context, req *apiv1.SSHPutRoleRequest) (*apiv1.SSHPutRoleResponse, error) {
+	resp := &apiv1.SSHPutRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, sshRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	role := &apiv1.SSHRole{
+		Name:              req.Name,
+		CertType:          req.CertType,
+		AllowedPrincipals: req.AllowedPrincipals,
+		DefaultPrincipals: req.DefaultPrincipals,
+		Extensions:        req.Extensions,
+		CriticalOptions:   req.CriticalOptions,
+	}
+	if len(req.Ttl) > 0 {
+		ttl, err := s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+		role.Ttl = int64(ttl.Seconds())
+	}
+	if len(req.MaxTTL) > 0 {
+		maxTTL, err := s.ParseTTL(req.MaxTTL)
+		if err != nil {
+			return resp, err
+		}
+		role.MaxTTL = int64(maxTTL.Seconds())
+	}
+
+	err = s.gk.SSHCA().PutRole(ctx, token.Namespace, role)
+	return resp, err
+}
+
+// SSHGetRole returns a role of the SSH CA. The token must be allowed to read `engines/ssh/roles/<name>`.
+func (s *KStash) SSHGetRole(ctx context.Context, req *apiv1.SSHGetRoleRequest) (*apiv1.SSHGetRoleResponse, error) {
+	resp := &apiv1.SSHGetRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, sshRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Role, err = s.gk.SSHCA().GetRole(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// SSHDeleteRole deletes a role of the SSH CA. The token must be allowed to delete `engines/ssh/roles/<name>`.
+func (s *KStash) SSHDeleteRole(ctx context.Context, req *apiv1.SSHDeleteRoleRequest) (*apiv1.SSHDeleteRoleResponse, error) {
+	resp := &apiv1.SSHDeleteRoleResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_DELETE, sshRolesPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.SSHCA().DeleteRole(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// SignSSHKey signs an SSH public key for principals allowed by a role. The reference ID of the token is used as the key ID of the certificate,
+// so logins can be traced back to the token. The token must be allowed to update `engines/ssh/sign/<role>`.
+func (s *KStash) SignSSHKey(ctx context.Context, req *apiv1.SignSSHKeyRequest) (*apiv1.SignSSHKeyResponse, error) {
+	resp := &apiv1.SignSSHKeyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, sshSignPath+req.Role)
+	if err != nil {
+		return resp, err
+	}
+
+	var ttl time.Duration
+	if len(req.Ttl) > 0 {
+		ttl, err = s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	cert, err := s.gk.SSHCA().SignKey(ctx, token.Namespace, req.Role, req.PublicKey, req.Principals, ttl, token.ReferenceID)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.SignedKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert)))
+	resp.SerialNumber = cert.Serial
+	resp.ValidBefore = int64(cert.ValidBefore)
+	return resp, nil
+}
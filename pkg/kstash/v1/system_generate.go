@@ -4,6 +4,7 @@ import (
 	"context"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/gatekeeper"
 )
 
 // SystemGenerateAccessToken generates a new AccessToken from the given access key.
@@ -19,16 +20,34 @@ func (s *KStash) SystemGenerateAccessToken(ctx context.Context, req *apiv1.Syste
 	token.ExpiresAt = req.ExpiresAt
 	token.Metadata = req.Metadata
 	token.Namespace = req.Namespace
+	token.Policies = req.Policies
 
 	resp.Token = token
 	err = s.gk.SaveAccessTokenWithAccessKey(ctx, req.AccessKey, resp.Token)
 	return resp, err
 }
 
-// SystemGenerateGatekeeperToken generates a new gatekeeper token from unseal keys.
+// SystemGenerateGatekeeperToken generates a new gatekeeper token from unseal keys. An optional TTL, number of uses, and label can be provided.
 func (s *KStash) SystemGenerateGatekeeperToken(ctx context.Context, req *apiv1.SystemGenerateGatekeeperTokenRequest) (*apiv1.SystemGenerateGatekeeperTokenResponse, error) {
 	var err error
 	resp := &apiv1.SystemGenerateGatekeeperTokenResponse{}
-	resp.GatekeeperToken, err = s.gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, req.UnsealKeys)
+
+	opts := &gatekeeper.GatekeeperTokenOptions{
+		NumUses: req.NumUses,
+		Label:   req.Label,
+	}
+	if len(req.Ttl) > 0 {
+		opts.TTL, err = s.ParseTTL(req.Ttl)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	resp.GatekeeperToken, err = s.gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, req.UnsealKeys, opts)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Accessor, err = s.gk.GatekeeperTokenAccessor(resp.GatekeeperToken)
 	return resp, err
 }
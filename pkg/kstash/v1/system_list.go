@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// SystemListGatekeeperTokens lists the accessors and metadata of all gatekeeper tokens with a valid gatekeeper token or set of unseal keys.
+func (s *KStash) SystemListGatekeeperTokens(ctx context.Context, req *apiv1.SystemListGatekeeperTokensRequest) (*apiv1.SystemListGatekeeperTokensResponse, error) {
+	var err error
+	resp := &apiv1.SystemListGatekeeperTokensResponse{}
+
+	if len(req.GatekeeperToken) > 0 {
+		resp.Tokens, err = s.gk.ListGatekeeperTokensWithGatekeeperToken(ctx, req.GatekeeperToken, req.Renew)
+	} else {
+		resp.Tokens, err = s.gk.ListGatekeeperTokensWithUnsealKeys(ctx, req.UnsealKeys)
+	}
+
+	return resp, err
+}
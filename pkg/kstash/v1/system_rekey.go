@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// SystemRekeyItems moves every item onto the active encryption key with a valid gatekeeper token or set of unseal keys.
+func (s *KStash) SystemRekeyItems(ctx context.Context, req *apiv1.SystemRekeyItemsRequest) (*apiv1.SystemRekeyItemsResponse, error) {
+	var (
+		numItems int
+		err      error
+	)
+
+	if len(req.GatekeeperToken) > 0 {
+		numItems, err = s.gk.RekeyItemsWithGatekeeperToken(ctx, req.GatekeeperToken, req.Renew)
+	} else {
+		numItems, err = s.gk.RekeyItemsWithUnsealKeys(ctx, req.UnsealKeys)
+	}
+
+	return &apiv1.SystemRekeyItemsResponse{NumItems: uint64(numItems)}, err
+}
@@ -11,3 +11,14 @@ func (s *KStash) SystemRevokeGatekeeperToken(ctx context.Context, req *apiv1.Sys
 	resp := &apiv1.SystemRevokeGatekeeperTokenResponse{}
 	return resp, s.gk.RevokeGatekeeperToken(ctx, req.GatekeeperToken)
 }
+
+// SystemRevokeGatekeeperTokenAccessor revokes the gatekeeper token identified by an accessor with a valid gatekeeper token or set of unseal keys.
+func (s *KStash) SystemRevokeGatekeeperTokenAccessor(ctx context.Context, req *apiv1.SystemRevokeGatekeeperTokenAccessorRequest) (*apiv1.SystemRevokeGatekeeperTokenAccessorResponse, error) {
+	resp := &apiv1.SystemRevokeGatekeeperTokenAccessorResponse{}
+
+	if len(req.GatekeeperToken) > 0 {
+		return resp, s.gk.RevokeGatekeeperTokenByAccessorWithGatekeeperToken(ctx, req.Accessor, req.GatekeeperToken, req.Renew)
+	}
+
+	return resp, s.gk.RevokeGatekeeperTokenByAccessorWithUnsealKeys(ctx, req.Accessor, req.UnsealKeys)
+}
@@ -26,6 +26,11 @@ func (s *KStash) SystemRotateGatekeeperToken(ctx context.Context, req *apiv1.Sys
 	var err error
 	resp := &apiv1.SystemRotateGatekeeperTokenResponse{}
 	resp.GatekeeperToken, err = s.gk.RotateGatekeeperToken(ctx, req.GatekeeperToken)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Accessor, err = s.gk.GatekeeperTokenAccessor(resp.GatekeeperToken)
 	return resp, err
 }
 
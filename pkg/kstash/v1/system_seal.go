@@ -22,7 +22,7 @@ func (s *KStash) SystemInitialize(ctx context.Context, req *apiv1.SystemInitiali
 	}
 
 	if req.GenerateGatekeeperToken {
-		resp.GatekeeperToken, err = s.gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, resp.UnsealKeys)
+		resp.GatekeeperToken, err = s.gk.GenerateGatekeeperTokenFromUnsealKeys(ctx, resp.UnsealKeys, nil)
 	}
 
 	return resp, err
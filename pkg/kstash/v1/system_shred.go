@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// SystemShredNamespace destroys the key material and items of a namespace with a valid gatekeeper token or set of unseal keys.
+func (s *KStash) SystemShredNamespace(ctx context.Context, req *apiv1.SystemShredNamespaceRequest) (*apiv1.SystemShredNamespaceResponse, error) {
+	var (
+		numItems int
+		err      error
+	)
+
+	if len(req.GatekeeperToken) > 0 {
+		numItems, err = s.gk.ShredNamespaceWithGatekeeperToken(ctx, req.Namespace, req.GatekeeperToken, req.Renew)
+	} else {
+		numItems, err = s.gk.ShredNamespaceWithUnsealKeys(ctx, req.Namespace, req.UnsealKeys)
+	}
+
+	return &apiv1.SystemShredNamespaceResponse{NumItems: uint64(numItems)}, err
+}
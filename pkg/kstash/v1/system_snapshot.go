@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"bufio"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+)
+
+// snapshotChunkSize is the maximum size of the snapshot data sent in a single stream message.
+const snapshotChunkSize = 64 * 1024
+
+// SystemSnapshot streams a snapshot of the encrypted store with a valid gatekeeper token or set of unseal keys.
+func (s *KStash) SystemSnapshot(req *apiv1.SystemSnapshotRequest, stream apiv1.KStash_SystemSnapshotServer) error {
+	ctx := stream.Context()
+	w := bufio.NewWriterSize(&snapshotStreamWriter{stream: stream}, snapshotChunkSize)
+
+	var err error
+	if len(req.GatekeeperToken) > 0 {
+		_, err = s.gk.SnapshotWithGatekeeperToken(ctx, w, req.GatekeeperToken, req.Renew)
+	} else {
+		_, err = s.gk.SnapshotWithUnsealKeys(ctx, w, req.UnsealKeys)
+	}
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// SystemRestore restores a streamed snapshot into an uninitialized storage backend. The barrier is left sealed.
+func (s *KStash) SystemRestore(stream apiv1.KStash_SystemRestoreServer) error {
+	header, numItems, err := s.gk.Restore(stream.Context(), &restoreStreamReader{stream: stream})
+	if err != nil {
+		return err
+	}
+
+	id := encryption.FromHash(header.BarrierID)
+	return stream.SendAndClose(&apiv1.SystemRestoreResponse{
+		BarrierID: id.Base64(),
+		NumItems:  numItems,
+	})
+}
+
+type snapshotStreamWriter struct {
+	stream apiv1.KStash_SystemSnapshotServer
+}
+
+func (w *snapshotStreamWriter) Write(p []byte) (int, error) {
+	// the stream may retain the message, so the buffer cannot be reused
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	if err := w.stream.Send(&apiv1.SystemSnapshotResponse{Data: data}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+type restoreStreamReader struct {
+	stream apiv1.KStash_SystemRestoreServer
+	buf    []byte
+}
+
+func (r *restoreStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = req.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
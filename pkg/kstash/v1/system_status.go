@@ -4,10 +4,12 @@ import (
 	"context"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/ha"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // SystemStatus provides system status information and is an unauthenticated endpoint.
+// When high availability is enabled, it also reports whether this node is active along with the current leader.
 func (s *KStash) SystemStatus(ctx context.Context, req *apiv1.SystemStatusRequest) (*apiv1.SystemStatusResponse, error) {
 	var err error
 	resp := &apiv1.SystemStatusResponse{
@@ -20,6 +22,17 @@ func (s *KStash) SystemStatus(ctx context.Context, req *apiv1.SystemStatusReques
 		return nil, err
 	}
 
+	if s.ha != nil {
+		resp.HaEnabled = true
+		resp.Active = s.ha.IsActive()
+		resp.NodeID = s.ha.NodeID()
+
+		resp.Leader, err = s.ha.Leader(ctx)
+		if err != nil && err != ha.ErrNoLeader {
+			return nil, err
+		}
+	}
+
 	if !resp.Initialized {
 		return resp, nil
 	}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+)
+
+// SystemUpgradeItems re-encrypts the items written by previous versions of K-Stash with a valid gatekeeper token or set of unseal keys.
+func (s *KStash) SystemUpgradeItems(ctx context.Context, req *apiv1.SystemUpgradeItemsRequest) (*apiv1.SystemUpgradeItemsResponse, error) {
+	var (
+		numItems int
+		err      error
+	)
+
+	if len(req.GatekeeperToken) > 0 {
+		numItems, err = s.gk.UpgradeItemsWithGatekeeperToken(ctx, req.GatekeeperToken, req.Renew)
+	} else {
+		numItems, err = s.gk.UpgradeItemsWithUnsealKeys(ctx, req.UnsealKeys)
+	}
+
+	return &apiv1.SystemUpgradeItemsResponse{NumItems: uint64(numItems)}, err
+}
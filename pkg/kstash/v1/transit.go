@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/secret/transit"
+)
+
+const transitPath = "engines/transit/"
+
+// TransitCreateKey creates a named transit key. The token must be allowed to create `engines/transit/<name>`.
+func (s *KStash) TransitCreateKey(ctx context.Context, req *apiv1.TransitCreateKeyRequest) (*apiv1.TransitCreateKeyResponse, error) {
+	resp := &apiv1.TransitCreateKeyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_CREATE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Transit().CreateKey(ctx, token.Namespace, req.Name, req.Type)
+	return resp, err
+}
+
+// TransitDeleteKey deletes a named transit key. The token must be allowed to delete `engines/transit/<name>`.
+func (s *KStash) TransitDeleteKey(ctx context.Context, req *apiv1.TransitDeleteKeyRequest) (*apiv1.TransitDeleteKeyResponse, error) {
+	resp := &apiv1.TransitDeleteKeyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_DELETE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	err = s.gk.Transit().DeleteKey(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// TransitGetKey returns the versions and public keys of a named transit key. The token must be allowed to read `engines/transit/<name>`.
+func (s *KStash) TransitGetKey(ctx context.Context, req *apiv1.TransitGetKeyRequest) (*apiv1.TransitGetKeyResponse, error) {
+	resp := &apiv1.TransitGetKeyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Key, err = s.gk.Transit().GetKey(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// TransitRotateKey adds a new version to a named transit key. The token must be allowed to update `engines/transit/<name>`.
+func (s *KStash) TransitRotateKey(ctx context.Context, req *apiv1.TransitRotateKeyRequest) (*apiv1.TransitRotateKeyResponse, error) {
+	resp := &apiv1.TransitRotateKeyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.KeyVersion, err = s.gk.Transit().RotateKey(ctx, token.Namespace, req.Name)
+	return resp, err
+}
+
+// TransitEncrypt encrypts data with a named transit key. The token must be allowed to update `engines/transit/<name>`.
+func (s *KStash) TransitEncrypt(ctx context.Context, req *apiv1.TransitEncryptRequest) (*apiv1.TransitEncryptResponse, error) {
+	resp := &apiv1.TransitEncryptResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Ciphertext, resp.KeyVersion, err = s.gk.Transit().Encrypt(ctx, token.Namespace, req.Name, req.Plaintext, req.AssociatedData)
+	return resp, err
+}
+
+// TransitDecrypt decrypts data with a named transit key. The token must be allowed to read `engines/transit/<name>`.
+func (s *KStash) TransitDecrypt(ctx context.Context, req *apiv1.TransitDecryptRequest) (*apiv1.TransitDecryptResponse, error) {
+	resp := &apiv1.TransitDecryptResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Plaintext, err = s.gk.Transit().Decrypt(ctx, token.Namespace, req.Name, req.Ciphertext, req.AssociatedData)
+	return resp, err
+}
+
+// TransitRewrap encrypts a ciphertext again with the latest version of a named transit key.
+// The token must be allowed to update `engines/transit/<name>`.
+func (s *KStash) TransitRewrap(ctx context.Context, req *apiv1.TransitRewrapRequest) (*apiv1.TransitRewrapResponse, error) {
+	resp := &apiv1.TransitRewrapResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Ciphertext, resp.KeyVersion, err = s.gk.Transit().Rewrap(ctx, token.Namespace, req.Name, req.Ciphertext, req.AssociatedData)
+	return resp, err
+}
+
+// TransitSign signs data with a named transit key. The token must be allowed to update `engines/transit/<name>`.
+func (s *KStash) TransitSign(ctx context.Context, req *apiv1.TransitSignRequest) (*apiv1.TransitSignResponse, error) {
+	resp := &apiv1.TransitSignResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Signature, resp.KeyVersion, err = s.gk.Transit().Sign(ctx, token.Namespace, req.Name, req.Input)
+	return resp, err
+}
+
+// TransitVerify verifies a signature or an HMAC made with a named transit key. The token must be allowed to read `engines/transit/<name>`.
+func (s *KStash) TransitVerify(ctx context.Context, req *apiv1.TransitVerifyRequest) (*apiv1.TransitVerifyResponse, error) {
+	resp := &apiv1.TransitVerifyResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_READ, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case len(req.Signature) > 0 && len(req.Hmac) == 0:
+		resp.Valid, err = s.gk.Transit().Verify(ctx, token.Namespace, req.Name, req.Input, req.Signature)
+	case len(req.Hmac) > 0 && len(req.Signature) == 0:
+		resp.Valid, err = s.gk.Transit().VerifyHMAC(ctx, token.Namespace, req.Name, req.Input, req.Hmac)
+	default:
+		err = transit.ErrVerifyInputMissing
+	}
+
+	return resp, err
+}
+
+// TransitHMAC computes an HMAC of data with a named transit key. The token must be allowed to update `engines/transit/<name>`.
+func (s *KStash) TransitHMAC(ctx context.Context, req *apiv1.TransitHMACRequest) (*apiv1.TransitHMACResponse, error) {
+	resp := &apiv1.TransitHMACResponse{}
+
+	token, err := s.CanToken(ctx, apiv1.Permission_UPDATE, transitPath+req.Name)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Hmac, resp.KeyVersion, err = s.gk.Transit().HMAC(ctx, token.Namespace, req.Name, req.Input)
+	return resp, err
+}
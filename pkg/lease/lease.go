@@ -0,0 +1,523 @@
+// Package lease tracks the lifetime of dynamic secrets and revokes them once they expire or the token that requested them is revoked.
+// Leases are stored in the barrier and scheduled on a timer wheel, which is rebuilt from the stored leases whenever a node becomes
+// responsible for revoking them, so revocations survive restarts and failovers.
+package lease
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	leasesPrefix = ".leases/"
+
+	// leasesByTokenPrefix indexes the leases of every token, e.g. `.leases-by-token/<token reference ID>/<lease key suffix>`.
+	leasesByTokenPrefix = ".leases-by-token/"
+
+	// leaseIDLength is the number of random bytes in the unique suffix of a lease ID.
+	leaseIDLength = 16
+
+	// wheelTick is the resolution of the timer wheel, which is how late a lease may be revoked after it expires.
+	wheelTick = time.Second
+
+	// wheelSize is the number of slots of the timer wheel.
+	wheelSize = 512
+
+	// retryInterval is how long to wait before revoking an expired lease again if its secret could not be revoked.
+	retryInterval = 30 * time.Second
+)
+
+var (
+	ErrLeaseNotFound   = fmt.Errorf("lease not found")
+	ErrInvalidLeaseID  = fmt.Errorf("invalid lease ID")
+	ErrUnknownEngine   = fmt.Errorf("no revoker registered for engine")
+	ErrLeaseNoEngine   = fmt.Errorf("lease has no engine")
+	ErrLeaseNoDuration = fmt.Errorf("lease must expire after it was issued")
+	ErrLeaseExpired    = fmt.Errorf("lease has expired")
+)
+
+// Revoker revokes the secret of a lease. Revoking a secret that no longer exists must succeed.
+type Revoker interface {
+	Revoke(ctx context.Context, lease *apiv1.Lease) error
+}
+
+// Renewer is implemented by Revokers whose secrets must be extended when their lease is renewed, e.g. database users that expire on their own.
+type Renewer interface {
+	Renew(ctx context.Context, lease *apiv1.Lease) error
+}
+
+// Manager object. Manager stores leases in the barrier and revokes their secrets with the Revoker registered for their engine.
+type Manager struct {
+	b        *barrier.Barrier
+	mu       sync.Mutex
+	renewMu  sync.Mutex
+	revokers map[string]Revoker
+	// wheel schedules the revocation of expired leases. It is nil while this node is not responsible for revoking leases.
+	wheel *wheel
+}
+
+// NewManager creates a new Manager object.
+func NewManager(b *barrier.Barrier) *Manager {
+	return &Manager{
+		b:        b,
+		revokers: map[string]Revoker{},
+	}
+}
+
+// RegisterRevoker registers the Revoker of an engine's leases.
+func (m *Manager) RegisterRevoker(engine string, revoker Revoker) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.revokers[engine] = revoker
+}
+
+// Run revokes leases as they expire until the context is done. Only a single node must revoke leases, so the timer wheel is
+// dropped while active returns false, and rebuilt from the stored leases once it returns true again.
+func (m *Manager) Run(ctx context.Context, log logr.Logger, active func() bool) {
+	ticker := time.NewTicker(wheelTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !active() {
+			m.mu.Lock()
+			m.wheel = nil
+			m.mu.Unlock()
+			continue
+		}
+
+		if err := m.revokeDue(ctx, time.Now()); err != nil {
+			log.Error(err, "unable to revoke expired leases")
+		}
+	}
+}
+
+// Create stores a new lease. Its ID is prefix followed by a unique suffix, so related leases can be identified by their ID.
+func (m *Manager) Create(ctx context.Context, prefix string, lease *apiv1.Lease) error {
+	if len(lease.Engine) == 0 {
+		return ErrLeaseNoEngine
+	}
+	if lease.ExpiresAt <= lease.IssuedAt {
+		return ErrLeaseNoDuration
+	}
+
+	suffix := make([]byte, leaseIDLength)
+	if _, err := io.ReadFull(rand.Reader, suffix); err != nil {
+		return err
+	}
+
+	lease.Id = strings.TrimSuffix(prefix, "/") + "/" + hex.EncodeToString(suffix)
+
+	// the index is written first, so a lease can always be found by its token.
+	if len(lease.TokenReferenceID) > 0 {
+		if err := m.b.Put(ctx, &apiv1.Item{Key: getTokenIndexKey(lease.TokenReferenceID, lease.Id), Raw: []byte(lease.Id)}); err != nil {
+			return err
+		}
+	}
+
+	if err := m.put(ctx, lease); err != nil {
+		return err
+	}
+
+	m.schedule(lease)
+	return nil
+}
+
+// Get returns a lease by its ID.
+func (m *Manager) Get(ctx context.Context, id string) (*apiv1.Lease, error) {
+	key, err := getLeaseKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := m.getByKey(ctx, key)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrLeaseNotFound, id)
+		}
+		return nil, err
+	}
+	if lease.Id != id {
+		return nil, fmt.Errorf("%w: %s", ErrLeaseNotFound, id)
+	}
+
+	return lease, nil
+}
+
+// List returns all leases.
+func (m *Manager) List(ctx context.Context) ([]*apiv1.Lease, error) {
+	keys, err := m.b.List(ctx, leasesPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getAll(ctx, leasesPrefix, keys)
+}
+
+// Revoke revokes the secret of a lease and deletes the lease. The lease is kept if its secret could not be revoked.
+func (m *Manager) Revoke(ctx context.Context, id string) error {
+	lease, err := m.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return m.revoke(ctx, lease)
+}
+
+// RevokeByToken revokes all leases created by the token with the given reference ID. The leases are found through an index by token,
+// so only the leases of the token are read. Leases whose secrets cannot be revoked are expired instead, so they are revoked
+// by the timer wheel once their secrets can be revoked.
+func (m *Manager) RevokeByToken(ctx context.Context, tokenReferenceID string) error {
+	if len(tokenReferenceID) == 0 || strings.Contains(tokenReferenceID, "/") {
+		return nil
+	}
+
+	indexPrefix := leasesByTokenPrefix + tokenReferenceID + "/"
+	keys, err := m.b.List(ctx, indexPrefix)
+	if err != nil {
+		return err
+	}
+
+	leases, err := m.getAll(ctx, leasesPrefix, keys)
+	if err != nil {
+		return err
+	}
+
+	// index entries of leases that were revoked before are removed along the way.
+	found := map[string]struct{}{}
+	for _, lease := range leases {
+		found[lease.Id[strings.LastIndex(lease.Id, "/")+1:]] = struct{}{}
+	}
+	for _, key := range keys {
+		if _, ok := found[key]; !ok {
+			if err := m.b.Delete(ctx, indexPrefix+key); err != nil && !storage.IsErrNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	_, err = m.revokeLeases(ctx, leases, func(lease *apiv1.Lease) bool {
+		return lease.TokenReferenceID == tokenReferenceID
+	})
+	return err
+}
+
+// RevokePrefix revokes all leases of a namespace whose ID starts with prefix and returns the number of revoked leases.
+// Like RevokeByToken, leases whose secrets cannot be revoked are expired and retried by the timer wheel.
+func (m *Manager) RevokePrefix(ctx context.Context, namespace, prefix string) (int, error) {
+	return m.revokeAll(ctx, func(lease *apiv1.Lease) bool {
+		return lease.Namespace == namespace && strings.HasPrefix(lease.Id, prefix)
+	})
+}
+
+// Renew extends a lease to expire increment from now, or the TTL it was last issued or renewed with if increment is zero.
+// The lease never expires after its max expiry or notAfter, unless it is zero, which callers use to keep it from outliving its token.
+func (m *Manager) Renew(ctx context.Context, id string, increment time.Duration, notAfter time.Time) (*apiv1.Lease, error) {
+	m.renewMu.Lock()
+	defer m.renewMu.Unlock()
+
+	lease, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if lease.ExpiresAt <= now.Unix() {
+		return nil, fmt.Errorf("%w: %s", ErrLeaseExpired, id)
+	}
+
+	if increment <= 0 {
+		lastTTL := lease.ExpiresAt - lease.IssuedAt
+		if lease.RenewedAt > 0 {
+			lastTTL = lease.ExpiresAt - lease.RenewedAt
+		}
+		increment = time.Duration(lastTTL) * time.Second
+	}
+	expiresAt := now.Add(increment)
+	if lease.MaxExpiresAt > 0 && expiresAt.Unix() > lease.MaxExpiresAt {
+		expiresAt = time.Unix(lease.MaxExpiresAt, 0)
+	}
+	if !notAfter.IsZero() && expiresAt.After(notAfter) {
+		expiresAt = notAfter
+	}
+	if expiresAt.Unix() <= now.Unix() {
+		return nil, fmt.Errorf("%w: %s", ErrLeaseExpired, id)
+	}
+	lease.ExpiresAt = expiresAt.Unix()
+	lease.RenewedAt = now.Unix()
+
+	m.mu.Lock()
+	revoker := m.revokers[lease.Engine]
+	m.mu.Unlock()
+
+	if renewer, ok := revoker.(Renewer); ok {
+		if err := renewer.Renew(ctx, lease); err != nil {
+			return nil, fmt.Errorf("renewing lease %s: %w", lease.Id, err)
+		}
+	}
+
+	if err := m.put(ctx, lease); err != nil {
+		return nil, err
+	}
+
+	m.schedule(lease)
+	return lease, nil
+}
+
+// revokeDue revokes the leases whose deadline on the timer wheel has passed, building the wheel first if necessary.
+// Leases whose secrets could not be revoked are tried again later.
+func (m *Manager) revokeDue(ctx context.Context, now time.Time) error {
+	if err := m.load(ctx, now); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	var due []string
+	if m.wheel != nil {
+		due = m.wheel.advance(now)
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, id := range due {
+		lease, err := m.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrLeaseNotFound) {
+				continue
+			}
+			m.retry(id, now)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		// the lease may have been renewed since it was scheduled.
+		if lease.ExpiresAt > now.Unix() {
+			m.schedule(lease)
+			continue
+		}
+
+		if err := m.revoke(ctx, lease); err != nil {
+			m.retry(id, now)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// load builds the timer wheel from the stored leases unless it already exists.
+func (m *Manager) load(ctx context.Context, now time.Time) error {
+	m.mu.Lock()
+	loaded := m.wheel != nil
+	m.mu.Unlock()
+	if loaded {
+		return nil
+	}
+
+	// the wheel exists before the leases are listed, so leases created in the meantime are scheduled as well.
+	w := newWheel(wheelTick, wheelSize, now)
+	m.mu.Lock()
+	m.wheel = w
+	m.mu.Unlock()
+
+	leases, err := m.List(ctx)
+	if err != nil {
+		m.mu.Lock()
+		m.wheel = nil
+		m.mu.Unlock()
+		return err
+	}
+
+	for _, lease := range leases {
+		m.schedule(lease)
+	}
+
+	return nil
+}
+
+func (m *Manager) schedule(lease *apiv1.Lease) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.wheel != nil {
+		m.wheel.schedule(lease.Id, time.Unix(lease.ExpiresAt, 0))
+	}
+}
+
+func (m *Manager) retry(id string, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.wheel != nil {
+		m.wheel.schedule(id, now.Add(retryInterval))
+	}
+}
+
+func (m *Manager) revokeAll(ctx context.Context, match func(lease *apiv1.Lease) bool) (int, error) {
+	leases, err := m.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.revokeLeases(ctx, leases, match)
+}
+
+func (m *Manager) revokeLeases(ctx context.Context, leases []*apiv1.Lease, match func(lease *apiv1.Lease) bool) (int, error) {
+	// keep revoking the remaining leases if one fails, so a single unreachable database does not keep all other secrets alive.
+	// Leases that fail are expired, so the timer wheel keeps retrying them.
+	var revoked int
+	var firstErr error
+	now := time.Now()
+	for _, lease := range leases {
+		if !match(lease) {
+			continue
+		}
+		if err := m.revoke(ctx, lease); err != nil {
+			if expireErr := m.expire(ctx, lease, now); expireErr != nil {
+				err = fmt.Errorf("%v, and expiring it failed: %w", err, expireErr)
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		revoked++
+	}
+
+	return revoked, firstErr
+}
+
+// expire makes a lease expire now if it expires later, so the timer wheel of whichever node revokes leases retries revoking it.
+func (m *Manager) expire(ctx context.Context, lease *apiv1.Lease, now time.Time) error {
+	if lease.ExpiresAt > now.Unix() {
+		lease.ExpiresAt = now.Unix()
+		if err := m.put(ctx, lease); err != nil {
+			return err
+		}
+	}
+
+	m.retry(lease.Id, now)
+	return nil
+}
+
+func (m *Manager) revoke(ctx context.Context, lease *apiv1.Lease) error {
+	m.mu.Lock()
+	revoker, ok := m.revokers[lease.Engine]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownEngine, lease.Engine)
+	}
+
+	if err := revoker.Revoke(ctx, lease); err != nil {
+		return fmt.Errorf("revoking lease %s: %w", lease.Id, err)
+	}
+
+	key, err := getLeaseKey(lease.Id)
+	if err != nil {
+		return err
+	}
+	if err := m.b.Delete(ctx, key); err != nil {
+		return err
+	}
+	if len(lease.TokenReferenceID) > 0 {
+		if err := m.b.Delete(ctx, getTokenIndexKey(lease.TokenReferenceID, lease.Id)); err != nil && !storage.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.wheel != nil {
+		m.wheel.cancel(lease.Id)
+	}
+
+	return nil
+}
+
+func (m *Manager) getByKey(ctx context.Context, key string) (*apiv1.Lease, error) {
+	item, err := m.b.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	lease := &apiv1.Lease{}
+	if err := proto.Unmarshal(item.Raw, lease); err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// getAll returns the leases stored at the keys below prefix. Leases that no longer exist are skipped.
+func (m *Manager) getAll(ctx context.Context, prefix string, keys []string) ([]*apiv1.Lease, error) {
+	leases := make([]*apiv1.Lease, 0, len(keys))
+	for _, key := range keys {
+		lease, err := m.getByKey(ctx, prefix+key)
+		if err != nil {
+			if storage.IsErrNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		leases = append(leases, lease)
+	}
+
+	return leases, nil
+}
+
+func (m *Manager) put(ctx context.Context, lease *apiv1.Lease) error {
+	key, err := getLeaseKey(lease.Id)
+	if err != nil {
+		return err
+	}
+
+	bs, err := proto.Marshal(lease)
+	if err != nil {
+		return err
+	}
+
+	return m.b.Put(ctx, &apiv1.Item{Key: key, Raw: bs})
+}
+
+// getLeaseKey returns the barrier key of a lease, which is the unique suffix of its ID.
+func getLeaseKey(id string) (string, error) {
+	suffix := id[strings.LastIndex(id, "/")+1:]
+	if len(suffix) != hex.EncodedLen(leaseIDLength) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLeaseID, id)
+	}
+	if _, err := hex.DecodeString(suffix); err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLeaseID, id)
+	}
+
+	return leasesPrefix + suffix, nil
+}
+
+// getTokenIndexKey returns the barrier key that indexes a lease by the reference ID of its token.
+func getTokenIndexKey(tokenReferenceID, id string) string {
+	return leasesByTokenPrefix + tokenReferenceID + "/" + id[strings.LastIndex(id, "/")+1:]
+}
@@ -0,0 +1,266 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestLease(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "lease")
+}
+
+type testRevoker struct {
+	revoked []string
+	err     error
+}
+
+func (r *testRevoker) Revoke(ctx context.Context, lease *apiv1.Lease) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.revoked = append(r.revoked, lease.Id)
+	return nil
+}
+
+var _ = Describe("lease", func() {
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	barr, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx := context.Background()
+	err = barr.Initialize(ctx, gatekeeperKey, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Unseal(ctx, gatekeeperKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	m := NewManager(barr)
+	revoker := &testRevoker{}
+
+	newLease := func(token string, ttl time.Duration) *apiv1.Lease {
+		now := time.Now()
+		l := &apiv1.Lease{
+			Namespace:        "namespace",
+			Engine:           "test",
+			TokenReferenceID: token,
+			IssuedAt:         now.Unix(),
+			ExpiresAt:        now.Add(ttl).Unix(),
+		}
+		Expect(m.Create(ctx, "test/creds/role", l)).To(Succeed())
+		return l
+	}
+
+	It("can create and get a lease", func() {
+		err := m.Create(ctx, "test/creds/role", &apiv1.Lease{Engine: "test"})
+		Expect(err).To(MatchError(ErrLeaseNoDuration))
+
+		l := newLease("token-1", time.Hour)
+		Expect(l.Id).To(MatchRegexp(`^test/creds/role/[0-9a-f]{32}$`))
+
+		stored, err := m.Get(ctx, l.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.TokenReferenceID).To(Equal("token-1"))
+
+		_, err = m.Get(ctx, "test/creds/other/"+l.Id[len(l.Id)-32:])
+		Expect(err).To(MatchError(ErrLeaseNotFound))
+
+		_, err = m.Get(ctx, "test/creds/role/not-a-lease")
+		Expect(err).To(MatchError(ErrInvalidLeaseID))
+	})
+
+	It("keeps leases whose secrets cannot be revoked", func() {
+		l := newLease("token-1", time.Hour)
+
+		err := m.Revoke(ctx, l.Id)
+		Expect(err).To(MatchError(ErrUnknownEngine))
+
+		m.RegisterRevoker("test", revoker)
+		revoker.err = fmt.Errorf("unreachable")
+		err = m.Revoke(ctx, l.Id)
+		Expect(err).To(HaveOccurred())
+
+		_, err = m.Get(ctx, l.Id)
+		Expect(err).NotTo(HaveOccurred())
+		revoker.err = nil
+
+		Expect(m.Revoke(ctx, l.Id)).To(Succeed())
+		Expect(revoker.revoked).To(ContainElement(l.Id))
+
+		_, err = m.Get(ctx, l.Id)
+		Expect(err).To(MatchError(ErrLeaseNotFound))
+	})
+
+	It("revokes the leases of a token", func() {
+		other := newLease("token-2", time.Hour)
+
+		Expect(m.RevokeByToken(ctx, "token-1")).To(Succeed())
+
+		leases, err := m.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leases).To(HaveLen(1))
+		Expect(leases[0].Id).To(Equal(other.Id))
+	})
+
+	It("expires the leases of a token whose secrets cannot be revoked", func() {
+		l := newLease("token-6", time.Hour)
+
+		revoker.err = fmt.Errorf("unreachable")
+		Expect(m.RevokeByToken(ctx, "token-6")).NotTo(Succeed())
+		revoker.err = nil
+
+		expired, err := m.Get(ctx, l.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired.ExpiresAt).To(BeNumerically("<=", time.Now().Unix()))
+
+		Expect(m.RevokeByToken(ctx, "token-6")).To(Succeed())
+		_, err = m.Get(ctx, l.Id)
+		Expect(err).To(MatchError(ErrLeaseNotFound))
+	})
+
+	It("finds the leases of a token through its index", func() {
+		l := newLease("token-7", time.Hour)
+
+		keys, err := barr.List(ctx, leasesByTokenPrefix+"token-7/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]string{l.Id[len(l.Id)-32:]}))
+
+		// a lease that is not in the index of the token is left alone.
+		unindexed := newLease("token-7", time.Hour)
+		Expect(barr.Delete(ctx, getTokenIndexKey("token-7", unindexed.Id))).To(Succeed())
+
+		Expect(m.RevokeByToken(ctx, "token-7")).To(Succeed())
+		_, err = m.Get(ctx, l.Id)
+		Expect(err).To(MatchError(ErrLeaseNotFound))
+		_, err = m.Get(ctx, unindexed.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		keys, err = barr.List(ctx, leasesByTokenPrefix+"token-7/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(BeEmpty())
+
+		Expect(m.Revoke(ctx, unindexed.Id)).To(Succeed())
+	})
+
+	It("revokes expired leases on the timer wheel", func() {
+		now := time.Now()
+		expired := &apiv1.Lease{Engine: "test", IssuedAt: now.Add(-time.Hour).Unix(), ExpiresAt: now.Add(-time.Minute).Unix()}
+		Expect(m.Create(ctx, "test/creds/role", expired)).To(Succeed())
+
+		Expect(m.revokeDue(ctx, now)).To(Succeed())
+		Expect(m.wheel.len()).To(Equal(2))
+		Expect(m.revokeDue(ctx, now.Add(wheelTick))).To(Succeed())
+		Expect(revoker.revoked).To(ContainElement(expired.Id))
+
+		soon := newLease("token-3", 10*time.Second)
+		Expect(m.revokeDue(ctx, now.Add(5*time.Second))).To(Succeed())
+		_, err := m.Get(ctx, soon.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(m.revokeDue(ctx, time.Unix(soon.ExpiresAt, 0))).To(Succeed())
+		_, err = m.Get(ctx, soon.Id)
+		Expect(err).To(MatchError(ErrLeaseNotFound))
+
+		leases, err := m.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leases).To(HaveLen(1))
+		Expect(leases[0].TokenReferenceID).To(Equal("token-2"))
+	})
+
+	It("retries leases whose secrets could not be revoked", func() {
+		now := time.Now()
+		l := newLease("token-4", 10*time.Second)
+
+		revoker.err = fmt.Errorf("unreachable")
+		Expect(m.revokeDue(ctx, now.Add(time.Minute))).NotTo(Succeed())
+		revoker.err = nil
+
+		Expect(m.revokeDue(ctx, now.Add(time.Minute+retryInterval/2))).To(Succeed())
+		_, err := m.Get(ctx, l.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(m.revokeDue(ctx, now.Add(time.Minute+retryInterval+wheelTick))).To(Succeed())
+		_, err = m.Get(ctx, l.Id)
+		Expect(err).To(MatchError(ErrLeaseNotFound))
+	})
+
+	It("can renew a lease", func() {
+		l := newLease("token-5", time.Minute)
+
+		renewed, err := m.Renew(ctx, l.Id, 10*time.Minute, time.Now().Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(time.Unix(renewed.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(10*time.Minute), 2*time.Second))
+
+		renewed, err = m.Renew(ctx, l.Id, 0, time.Now().Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(time.Unix(renewed.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(10*time.Minute), 2*time.Second))
+
+		notAfter := time.Now().Add(30 * time.Second)
+		renewed, err = m.Renew(ctx, l.Id, time.Hour, notAfter)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renewed.ExpiresAt).To(Equal(notAfter.Unix()))
+
+		capped := &apiv1.Lease{Engine: "test", IssuedAt: time.Now().Unix(), ExpiresAt: time.Now().Add(time.Minute).Unix(), MaxExpiresAt: time.Now().Add(2 * time.Minute).Unix()}
+		Expect(m.Create(ctx, "test/creds/role", capped)).To(Succeed())
+		renewed, err = m.Renew(ctx, capped.Id, time.Hour, time.Now().Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renewed.ExpiresAt).To(Equal(capped.MaxExpiresAt))
+
+		// the renewed lease is rescheduled, so it is not revoked at its original expiry.
+		Expect(m.revokeDue(ctx, time.Now().Add(time.Minute+wheelTick))).To(Succeed())
+		_, err = m.Get(ctx, capped.Id)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rebuilds the timer wheel from the stored leases", func() {
+		restarted := NewManager(barr)
+		restarted.RegisterRevoker("test", revoker)
+
+		Expect(restarted.revokeDue(ctx, time.Now())).To(Succeed())
+		leases, err := m.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restarted.wheel.len()).To(Equal(len(leases)))
+
+		Expect(restarted.revokeDue(ctx, time.Now().Add(2*time.Hour))).To(Succeed())
+		leases, err = m.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leases).To(BeEmpty())
+	})
+
+	It("revokes leases by prefix", func() {
+		newLease("token-6", time.Hour)
+		other := &apiv1.Lease{Namespace: "namespace", Engine: "test", IssuedAt: time.Now().Unix(), ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		Expect(m.Create(ctx, "test/creds/other", other)).To(Succeed())
+
+		revoked, err := m.RevokePrefix(ctx, "other-namespace", "test/creds/role/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revoked).To(BeZero())
+
+		revoked, err = m.RevokePrefix(ctx, "namespace", "test/creds/role/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revoked).To(Equal(1))
+
+		leases, err := m.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leases).To(HaveLen(1))
+		Expect(leases[0].Id).To(Equal(other.Id))
+	})
+})
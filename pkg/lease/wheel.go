@@ -0,0 +1,92 @@
+package lease
+
+import (
+	"time"
+)
+
+// wheel is a hashed timer wheel. A lease is kept in the slot of the first tick after it expires, modulo the number of slots,
+// so advancing the wheel only looks at the slots of the ticks that passed, no matter how many leases there are.
+// A slot holds leases of later rounds as well, which stay in it until their deadline has passed.
+type wheel struct {
+	tick  time.Duration
+	slots []map[string]time.Time
+	index map[string]int
+	// current is the last tick that was processed.
+	current int64
+}
+
+func newWheel(tick time.Duration, size int, now time.Time) *wheel {
+	w := &wheel{
+		tick:    tick,
+		slots:   make([]map[string]time.Time, size),
+		index:   map[string]int{},
+		current: now.UnixNano() / int64(tick),
+	}
+	for i := range w.slots {
+		w.slots[i] = map[string]time.Time{}
+	}
+
+	return w
+}
+
+// schedule adds an ID to the wheel, replacing its previous deadline. IDs whose deadline already passed are returned by the next advance.
+func (w *wheel) schedule(id string, deadline time.Time) {
+	w.cancel(id)
+
+	// the first tick that starts at or after the deadline.
+	tick := (deadline.UnixNano() + int64(w.tick) - 1) / int64(w.tick)
+	if tick <= w.current {
+		tick = w.current + 1
+	}
+
+	slot := int(tick % int64(len(w.slots)))
+	w.slots[slot][id] = deadline
+	w.index[id] = slot
+}
+
+// cancel removes an ID from the wheel.
+func (w *wheel) cancel(id string) {
+	slot, ok := w.index[id]
+	if !ok {
+		return
+	}
+
+	delete(w.slots[slot], id)
+	delete(w.index, id)
+}
+
+// advance processes all ticks up to now and removes and returns the IDs whose deadline has passed.
+func (w *wheel) advance(now time.Time) []string {
+	target := now.UnixNano() / int64(w.tick)
+	if target <= w.current {
+		return nil
+	}
+
+	// every slot has been visited once the wheel went around, so the remaining ticks cannot find anything new.
+	first := w.current + 1
+	if target-first >= int64(len(w.slots)) {
+		first = target - int64(len(w.slots)) + 1
+	}
+
+	var due []string
+	for tick := first; tick <= target; tick++ {
+		slot := int(tick % int64(len(w.slots)))
+		for id, deadline := range w.slots[slot] {
+			if deadline.After(now) {
+				continue
+			}
+
+			delete(w.slots[slot], id)
+			delete(w.index, id)
+			due = append(due, id)
+		}
+	}
+	w.current = target
+
+	return due
+}
+
+// len returns the number of scheduled IDs.
+func (w *wheel) len() int {
+	return len(w.index)
+}
@@ -0,0 +1,222 @@
+// Package migrate copies every item stored by K-Stash from one storage backend to another without decrypting anything.
+// Migrations are performed offline, so no K-Stash instance may use either backend while items are copied.
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	rootPath = "/kstash/"
+	// haPath holds the leader election records of the source deployment's nodes, which are not copied.
+	haPath = rootPath + "ha/"
+)
+
+var (
+	ErrDestinationInitialized = fmt.Errorf("destination storage backend is already initialized")
+	ErrDestinationNotEmpty    = fmt.Errorf("destination storage backend is not empty, resume the migration to continue copying into it")
+	ErrChecksumMismatch       = fmt.Errorf("copied item does not match the checksum of its source")
+
+	errStop = fmt.Errorf("stop walking")
+)
+
+// Action is the action taken for an item during a migration.
+type Action string
+
+const (
+	ActionCopy Action = "copy"
+	ActionSkip Action = "skip"
+)
+
+// Options for a migration.
+type Options struct {
+	// Resume continues an interrupted migration into a destination that is not empty. Items that were already copied are skipped.
+	Resume bool
+	// DryRun determines the items that would be copied without writing anything to the destination.
+	DryRun bool
+	// Progress is called with every item and the action taken for it, if set.
+	Progress func(key string, action Action)
+}
+
+// Result of a migration.
+type Result struct {
+	Copied  int
+	Skipped int
+}
+
+// Migrate copies every item from src to dst. Every copied item is read back from dst and verified against the checksum of its source.
+// The barrier's keychain is copied last, so dst is only initialized once every other item has been copied and an interrupted migration can be resumed.
+func Migrate(ctx context.Context, src, dst storage.Storage, opts *Options) (*Result, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	if _, err := src.Get(ctx, barrier.KeychainPath); err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("source storage backend: %w", barrier.ErrBarrierNotInitialized)
+		}
+		return nil, err
+	}
+
+	if _, err := dst.Get(ctx, barrier.KeychainPath); err == nil {
+		return nil, ErrDestinationInitialized
+	} else if !storage.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	if !opts.Resume {
+		empty, err := isEmpty(ctx, dst)
+		if err != nil {
+			return nil, err
+		}
+		if !empty {
+			return nil, ErrDestinationNotEmpty
+		}
+	}
+
+	m := &migration{src: src, dst: dst, opts: opts, result: &Result{}}
+
+	if err := walk(ctx, src, rootPath, func(key string) error {
+		if key == barrier.Path {
+			return storage.ErrSkipPrefix
+		}
+		return m.copyItem(ctx, key)
+	}); err != nil {
+		return m.result, err
+	}
+
+	if err := walk(ctx, src, barrier.Path, func(key string) error {
+		if key == barrier.KeychainPath {
+			return nil
+		}
+		return m.copyItem(ctx, key)
+	}); err != nil {
+		return m.result, err
+	}
+
+	return m.result, m.copyItem(ctx, barrier.KeychainPath)
+}
+
+type migration struct {
+	src    storage.Storage
+	dst    storage.Storage
+	opts   *Options
+	result *Result
+}
+
+func (m *migration) copyItem(ctx context.Context, key string) error {
+	if strings.HasSuffix(key, "/") {
+		return nil
+	}
+
+	item, err := m.src.Get(ctx, key)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	item.Key = key
+	sum, err := checksum(item)
+	if err != nil {
+		return err
+	}
+
+	if m.opts.Resume {
+		copied, err := m.dst.Get(ctx, key)
+		if err != nil && !storage.IsErrNotFound(err) {
+			return err
+		}
+
+		if err == nil {
+			copiedSum, err := checksum(copied)
+			if err != nil {
+				return err
+			}
+
+			if bytes.Equal(sum, copiedSum) {
+				m.result.Skipped++
+				m.progress(key, ActionSkip)
+				return nil
+			}
+		}
+	}
+
+	if !m.opts.DryRun {
+		if err := m.dst.Put(ctx, item); err != nil {
+			return fmt.Errorf("unable to copy %s: %w", key, err)
+		}
+
+		copied, err := m.dst.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf("unable to verify %s: %w", key, err)
+		}
+
+		copiedSum, err := checksum(copied)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(sum, copiedSum) {
+			return fmt.Errorf("%w: %s", ErrChecksumMismatch, key)
+		}
+	}
+
+	m.result.Copied++
+	m.progress(key, ActionCopy)
+	return nil
+}
+
+func (m *migration) progress(key string, action Action) {
+	if m.opts.Progress != nil {
+		m.opts.Progress(key, action)
+	}
+}
+
+// checksum creates a SHA-256 checksum of the given item's key, encryption key ID, and value.
+func checksum(item *apiv1.BackendItem) ([]byte, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(b)
+	return sum[:], nil
+}
+
+func isEmpty(ctx context.Context, store storage.Storage) (bool, error) {
+	empty := true
+	err := walk(ctx, store, rootPath, func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+		empty = false
+		return errStop
+	})
+	if err != nil && err != errStop {
+		return false, err
+	}
+
+	return empty, nil
+}
+
+// walk calls fn with every key and prefix under the given prefix, skipping HA records.
+func walk(ctx context.Context, store storage.Storage, prefix string, fn storage.WalkFunc) error {
+	return storage.Walk(ctx, store, prefix, func(key string) error {
+		if key == haPath {
+			return storage.ErrSkipPrefix
+		}
+		return fn(key)
+	})
+}
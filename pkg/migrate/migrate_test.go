@@ -0,0 +1,149 @@
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestMigrate(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "migrate")
+}
+
+// corruptingStorage flips a bit in every value it stores.
+type corruptingStorage struct {
+	storage.Storage
+}
+
+func (s *corruptingStorage) Put(ctx context.Context, item *apiv1.BackendItem) error {
+	val := append([]byte{}, item.Val...)
+	if len(val) > 0 {
+		val[0] ^= 1
+	}
+
+	return s.Storage.Put(ctx, &apiv1.BackendItem{Key: item.Key, EncryptionKeyID: item.EncryptionKeyID, Val: val})
+}
+
+var _ = Describe("migrate", func() {
+	ctx := context.Background()
+
+	src, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	b, err := barrier.NewBarrier(src)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	newStore := func() storage.Storage {
+		store, err := memory.NewMemoryStorage(nil)
+		Expect(err).NotTo(HaveOccurred())
+		return store
+	}
+
+	var numItems int
+
+	It("fails to migrate an uninitialized source", func() {
+		_, err := Migrate(ctx, src, newStore(), nil)
+		Expect(err).To(MatchError(barrier.ErrBarrierNotInitialized))
+	})
+
+	It("determines the items to copy in a dry run", func() {
+		err := b.Initialize(ctx, gatekeeperKey, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = b.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer b.Seal()
+
+		err = b.Put(ctx, &apiv1.Item{Key: "/testing/key1", Raw: []byte("value1")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = b.Put(ctx, &apiv1.Item{Key: "/testing/nested/key2", Raw: []byte("value2")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = src.Put(ctx, &apiv1.BackendItem{Key: haPath + "leader", Val: []byte("node")})
+		Expect(err).NotTo(HaveOccurred())
+
+		dst := newStore()
+		keys := []string{}
+		result, err := Migrate(ctx, src, dst, &Options{
+			DryRun: true,
+			Progress: func(key string, action Action) {
+				Expect(action).To(Equal(ActionCopy))
+				keys = append(keys, key)
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Copied).To(Equal(len(keys)))
+		Expect(keys).To(ContainElement(barrier.IDPath))
+		Expect(keys).NotTo(ContainElement(haPath + "leader"))
+		Expect(keys[len(keys)-1]).To(Equal(barrier.KeychainPath))
+		numItems = result.Copied
+
+		empty, err := isEmpty(ctx, dst)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(empty).To(BeTrue())
+	})
+
+	It("copies every item into a destination that can be unsealed with the same key", func() {
+		dst := newStore()
+		result, err := Migrate(ctx, src, dst, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Copied).To(Equal(numItems))
+		Expect(result.Skipped).To(BeZero())
+
+		migrated, err := barrier.NewBarrier(dst)
+		Expect(err).NotTo(HaveOccurred())
+
+		sealed, err := migrated.IsSealed(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sealed).To(BeTrue())
+
+		err = migrated.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer migrated.Seal()
+
+		item, err := migrated.Get(ctx, "/testing/nested/key2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("value2")))
+
+		_, err = Migrate(ctx, src, dst, &Options{Resume: true})
+		Expect(err).To(MatchError(ErrDestinationInitialized))
+	})
+
+	It("refuses to copy into a destination that is not empty unless resuming", func() {
+		dst := newStore()
+
+		// simulate an interrupted migration
+		item, err := src.Get(ctx, barrier.IDPath)
+		Expect(err).NotTo(HaveOccurred())
+		err = dst.Put(ctx, item)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = Migrate(ctx, src, dst, nil)
+		Expect(err).To(MatchError(ErrDestinationNotEmpty))
+
+		result, err := Migrate(ctx, src, dst, &Options{Resume: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Skipped).To(Equal(1))
+		Expect(result.Copied).To(Equal(numItems - 1))
+	})
+
+	It("fails when a copied item does not match its checksum", func() {
+		_, err := Migrate(ctx, src, &corruptingStorage{Storage: newStore()}, nil)
+		Expect(err).To(MatchError(ErrChecksumMismatch))
+	})
+})
@@ -0,0 +1,499 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/lease"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	namespacesPath = "namespaces/"
+
+	connectionsPath = "config/"
+	rolesPath       = "roles/"
+
+	// Engine is the name of the engine that issues and revokes the leases of database credentials.
+	Engine = "database"
+
+	// CredsPath is the prefix of the IDs of leases of database credentials, followed by the name of the role.
+	CredsPath = "database/creds/"
+
+	// DefaultTTL is the lifetime of credentials if neither the request nor the role specify one.
+	DefaultTTL = time.Hour
+
+	// ExpirationFormat is the format of the `{{expiration}}` placeholder of creation statements.
+	ExpirationFormat = "2006-01-02 15:04:05-0700"
+
+	usernamePrefix = "ks_"
+	usernameSuffix = 5
+	passwordLength = 16
+)
+
+var (
+	ErrNoNamespace         = fmt.Errorf("no namespace specified")
+	ErrInvalidName         = fmt.Errorf("invalid name")
+	ErrUnknownPlugin       = fmt.Errorf("unknown database plugin")
+	ErrConnectionNotFound  = fmt.Errorf("connection not found")
+	ErrConnectionInUse     = fmt.Errorf("connection has active leases")
+	ErrRoleNotFound        = fmt.Errorf("role not found")
+	ErrRoleNotAllowed      = fmt.Errorf("role not allowed to use connection")
+	ErrTTLTooLong          = fmt.Errorf("TTL exceeds the max TTL of the role")
+	ErrTokenExpired        = fmt.Errorf("token expires before the credentials could be used")
+	ErrInvalidLeaseDetails = fmt.Errorf("lease is missing the details of the credentials")
+)
+
+// Credentials are a database user created for a lease.
+type Credentials struct {
+	Username string
+	Password string
+	Lease    *apiv1.Lease
+}
+
+// Database object. Database creates a uniquely named user of a configured database for each request, which is dropped once its lease ends.
+type Database struct {
+	b      *barrier.Barrier
+	leases *lease.Manager
+	mu     sync.Mutex
+	dbs    map[string]*sql.DB
+}
+
+// NewDatabase creates a new Database object and registers it as the revoker of its leases.
+func NewDatabase(b *barrier.Barrier, leases *lease.Manager) *Database {
+	d := &Database{
+		b:      b,
+		leases: leases,
+		dbs:    map[string]*sql.DB{},
+	}
+	leases.RegisterRevoker(Engine, d)
+
+	return d
+}
+
+// ConfigureConnection verifies that the database of a connection is reachable and stores the connection in a namespace.
+func (d *Database) ConfigureConnection(ctx context.Context, namespace string, conn *apiv1.DatabaseConnection) error {
+	if conn == nil {
+		return fmt.Errorf("connection cannot be nil")
+	}
+	plugin, ok := plugins[conn.Plugin]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownPlugin, conn.Plugin)
+	}
+	if len(conn.Driver) == 0 {
+		conn.Driver = plugin.Driver()
+	}
+
+	connPath, err := getConnectionPath(namespace, conn.Name)
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open(conn.Driver, conn.ConnectionURL)
+	if err != nil {
+		return err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return fmt.Errorf("unable to connect to database: %w", err)
+	}
+
+	raw, err := proto.Marshal(conn)
+	if err != nil {
+		db.Close()
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.b.Put(ctx, &apiv1.Item{Key: connPath, Raw: raw}); err != nil {
+		db.Close()
+		return err
+	}
+
+	if old, ok := d.dbs[connPath]; ok {
+		old.Close()
+	}
+	d.dbs[connPath] = db
+
+	return nil
+}
+
+// DeleteConnection deletes a connection from a namespace. Connections cannot be deleted while leases of their users are active.
+func (d *Database) DeleteConnection(ctx context.Context, namespace, name string) error {
+	if _, err := d.getConnection(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	leases, err := d.leases.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, l := range leases {
+		if l.Engine == Engine && l.Namespace == namespace && l.Data["connection"] == name {
+			return fmt.Errorf("%w: %s", ErrConnectionInUse, name)
+		}
+	}
+
+	connPath, err := getConnectionPath(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if db, ok := d.dbs[connPath]; ok {
+		db.Close()
+		delete(d.dbs, connPath)
+	}
+
+	return d.b.Delete(ctx, connPath)
+}
+
+// PutRole creates or replaces a role in a namespace. The role's connection must exist.
+func (d *Database) PutRole(ctx context.Context, namespace string, role *apiv1.DatabaseRole) error {
+	if role == nil {
+		return fmt.Errorf("role cannot be nil")
+	}
+	if role.MaxTTL > 0 && role.Ttl > role.MaxTTL {
+		return ErrTTLTooLong
+	}
+	if _, err := d.getConnection(ctx, namespace, role.Connection); err != nil {
+		return err
+	}
+
+	rolePath, err := getRolePath(namespace, role.Name)
+	if err != nil {
+		return err
+	}
+
+	raw, err := proto.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	return d.b.Put(ctx, &apiv1.Item{Key: rolePath, Raw: raw})
+}
+
+// GetRole returns a role from a namespace.
+func (d *Database) GetRole(ctx context.Context, namespace, name string) (*apiv1.DatabaseRole, error) {
+	rolePath, err := getRolePath(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := d.b.Get(ctx, rolePath)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+		}
+		return nil, err
+	}
+
+	role := &apiv1.DatabaseRole{}
+	if err := proto.Unmarshal(item.Raw, role); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal role: %s: %w", name, err)
+	}
+
+	return role, nil
+}
+
+// DeleteRole deletes a role from a namespace. Users created for the role are dropped with the plugin's revocation statements once their leases end.
+func (d *Database) DeleteRole(ctx context.Context, namespace, name string) error {
+	if _, err := d.GetRole(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	rolePath, err := getRolePath(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return d.b.Delete(ctx, rolePath)
+}
+
+// GenerateCredentials creates a user of the role's database with a lease owned by the token.
+// The lease expires after the TTL, or the role's TTL if none was requested, but never after the token.
+func (d *Database) GenerateCredentials(ctx context.Context, namespace, roleName string, ttl time.Duration, token *apiv1.AccessToken) (*Credentials, error) {
+	role, err := d.GetRole(ctx, namespace, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := d.getConnection(ctx, namespace, role.Connection)
+	if err != nil {
+		return nil, err
+	}
+	if !roleAllowed(conn, role.Name) {
+		return nil, fmt.Errorf("%w: %s", ErrRoleNotAllowed, role.Connection)
+	}
+
+	ttl, err = credentialsTTL(role, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	if tokenExpiresAt := time.Unix(token.ExpiresAt, 0); tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+	if !expiresAt.After(now) {
+		return nil, ErrTokenExpired
+	}
+
+	plugin := plugins[conn.Plugin]
+	username, err := newUsername(role.Name, plugin.MaxUsernameLength())
+	if err != nil {
+		return nil, err
+	}
+	password, err := newPassword()
+	if err != nil {
+		return nil, err
+	}
+
+	// the lease is stored before the user is created, so a user is never left behind without a lease that drops it.
+	l := &apiv1.Lease{
+		Namespace:        namespace,
+		Engine:           Engine,
+		TokenReferenceID: token.ReferenceID,
+		IssuedAt:         now.Unix(),
+		ExpiresAt:        expiresAt.Unix(),
+		Data: map[string]string{
+			"connection": conn.Name,
+			"role":       role.Name,
+			"username":   username,
+		},
+	}
+	if role.MaxTTL > 0 {
+		l.MaxExpiresAt = now.Add(time.Duration(role.MaxTTL) * time.Second).Unix()
+	}
+	if err := d.leases.Create(ctx, CredsPath+role.Name, l); err != nil {
+		return nil, err
+	}
+
+	statements := role.CreationStatements
+	if len(statements) == 0 {
+		statements = plugin.CreationStatements()
+	}
+	replacer := strings.NewReplacer("{{name}}", username, "{{password}}", password, "{{expiration}}", expiresAt.UTC().Format(ExpirationFormat))
+	if err := d.exec(ctx, namespace, conn, statements, replacer); err != nil {
+		if revokeErr := d.leases.Revoke(ctx, l.Id); revokeErr != nil {
+			return nil, fmt.Errorf("unable to create user: %w (revoking its lease failed: %s)", err, revokeErr)
+		}
+		return nil, fmt.Errorf("unable to create user: %w", err)
+	}
+
+	return &Credentials{Username: username, Password: password, Lease: l}, nil
+}
+
+// Revoke drops the user of a lease. It implements lease.Revoker.
+func (d *Database) Revoke(ctx context.Context, l *apiv1.Lease) error {
+	username := l.Data["username"]
+	if len(username) == 0 {
+		return ErrInvalidLeaseDetails
+	}
+
+	conn, err := d.getConnection(ctx, l.Namespace, l.Data["connection"])
+	if err != nil {
+		return err
+	}
+
+	var statements []string
+	role, err := d.GetRole(ctx, l.Namespace, l.Data["role"])
+	if err == nil {
+		statements = role.RevocationStatements
+	} else if !errors.Is(err, ErrRoleNotFound) {
+		return err
+	}
+	if len(statements) == 0 {
+		statements = plugins[conn.Plugin].RevocationStatements()
+	}
+
+	return d.exec(ctx, l.Namespace, conn, statements, strings.NewReplacer("{{name}}", username))
+}
+
+// Renew extends the expiration of the user of a renewed lease. It implements lease.Renewer.
+func (d *Database) Renew(ctx context.Context, l *apiv1.Lease) error {
+	username := l.Data["username"]
+	if len(username) == 0 {
+		return ErrInvalidLeaseDetails
+	}
+
+	conn, err := d.getConnection(ctx, l.Namespace, l.Data["connection"])
+	if err != nil {
+		return err
+	}
+
+	expiration := time.Unix(l.ExpiresAt, 0).UTC().Format(ExpirationFormat)
+	return d.exec(ctx, l.Namespace, conn, plugins[conn.Plugin].RenewStatements(), strings.NewReplacer("{{name}}", username, "{{expiration}}", expiration))
+}
+
+func (d *Database) exec(ctx context.Context, namespace string, conn *apiv1.DatabaseConnection, statements []string, replacer *strings.Replacer) error {
+	db, err := d.db(namespace, conn)
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		if _, err := db.ExecContext(ctx, replacer.Replace(statement)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// db returns the cached connection pool of a connection, opening it if this node has not used it yet.
+func (d *Database) db(namespace string, conn *apiv1.DatabaseConnection) (*sql.DB, error) {
+	connPath, err := getConnectionPath(namespace, conn.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if db, ok := d.dbs[connPath]; ok {
+		return db, nil
+	}
+
+	db, err := sql.Open(conn.Driver, conn.ConnectionURL)
+	if err != nil {
+		return nil, err
+	}
+	d.dbs[connPath] = db
+
+	return db, nil
+}
+
+func (d *Database) getConnection(ctx context.Context, namespace, name string) (*apiv1.DatabaseConnection, error) {
+	connPath, err := getConnectionPath(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := d.b.Get(ctx, connPath)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrConnectionNotFound, name)
+		}
+		return nil, err
+	}
+
+	conn := &apiv1.DatabaseConnection{}
+	if err := proto.Unmarshal(item.Raw, conn); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal connection: %s: %w", name, err)
+	}
+
+	return conn, nil
+}
+
+func roleAllowed(conn *apiv1.DatabaseConnection, role string) bool {
+	for _, allowed := range conn.AllowedRoles {
+		if allowed == "*" || allowed == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// credentialsTTL returns the TTL of credentials created for the role. The role's TTL is used if none was requested.
+func credentialsTTL(role *apiv1.DatabaseRole, ttl time.Duration) (time.Duration, error) {
+	maxTTL := time.Duration(role.MaxTTL) * time.Second
+	if ttl <= 0 {
+		ttl = time.Duration(role.Ttl) * time.Second
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+		if maxTTL > 0 && ttl > maxTTL {
+			ttl = maxTTL
+		}
+	}
+
+	if maxTTL > 0 && ttl > maxTTL {
+		return 0, fmt.Errorf("%w: %s exceeds %s", ErrTTLTooLong, ttl, maxTTL)
+	}
+
+	return ttl, nil
+}
+
+// newUsername returns a unique user name that contains as much of the role's name as fits and only characters that need no quoting.
+func newUsername(role string, maxLength int) (string, error) {
+	suffix := make([]byte, usernameSuffix)
+	if _, err := io.ReadFull(rand.Reader, suffix); err != nil {
+		return "", err
+	}
+
+	role = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, role)
+
+	if room := maxLength - len(usernamePrefix) - 1 - hex.EncodedLen(usernameSuffix); len(role) > room {
+		role = role[:room]
+	}
+
+	return usernamePrefix + role + "_" + hex.EncodeToString(suffix), nil
+}
+
+func newPassword() (string, error) {
+	password := make([]byte, passwordLength)
+	if _, err := io.ReadFull(rand.Reader, password); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(password), nil
+}
+
+func getConnectionPath(namespace, name string) (string, error) {
+	if len(name) == 0 || strings.Contains(name, "/") {
+		return "", ErrInvalidName
+	}
+
+	return getDatabasePath(namespace, connectionsPath+name)
+}
+
+func getRolePath(namespace, name string) (string, error) {
+	if len(name) == 0 || strings.Contains(name, "/") {
+		return "", ErrInvalidName
+	}
+
+	return getDatabasePath(namespace, rolesPath+name)
+}
+
+func getDatabasePath(namespace, subPath string) (string, error) {
+	namespace = strings.Trim(namespace, "/")
+	if len(namespace) == 0 {
+		return "", ErrNoNamespace
+	}
+
+	if strings.IndexFunc(namespace+subPath, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) > -1 {
+		return "", ErrInvalidName
+	}
+
+	return namespacesPath + namespace + "/database/" + subPath, nil
+}
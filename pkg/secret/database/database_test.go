@@ -0,0 +1,200 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/lease"
+	"github.com/slaskawi/vault-poc/pkg/secret/database/databasetest"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestDatabase(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "database")
+}
+
+var _ = Describe("database", func() {
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	barr, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx := context.Background()
+	err = barr.Initialize(ctx, gatekeeperKey, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Unseal(ctx, gatekeeperKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	leases := lease.NewManager(barr)
+	d := NewDatabase(barr, leases)
+	db := databasetest.Open("postgres://database-test")
+
+	token := &apiv1.AccessToken{ReferenceID: "kr000000000000000001", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+	var creds *Credentials
+
+	It("can configure a connection", func() {
+		err := d.ConfigureConnection(ctx, "namespace", &apiv1.DatabaseConnection{Name: "pg", Plugin: "oracle"})
+		Expect(err).To(MatchError(ErrUnknownPlugin))
+
+		db.FailWith(fmt.Errorf("connection refused"))
+		err = d.ConfigureConnection(ctx, "namespace", &apiv1.DatabaseConnection{
+			Name:          "pg",
+			Plugin:        "postgresql",
+			Driver:        databasetest.DriverName,
+			ConnectionURL: "postgres://database-test",
+		})
+		Expect(err).To(HaveOccurred())
+		db.FailWith(nil)
+
+		err = d.ConfigureConnection(ctx, "namespace", &apiv1.DatabaseConnection{
+			Name:          "pg",
+			Plugin:        "postgresql",
+			Driver:        databasetest.DriverName,
+			ConnectionURL: "postgres://database-test",
+			AllowedRoles:  []string{"readonly"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("can put and get roles", func() {
+		err := d.PutRole(ctx, "namespace", &apiv1.DatabaseRole{Name: "readonly", Connection: "missing"})
+		Expect(err).To(MatchError(ErrConnectionNotFound))
+
+		err = d.PutRole(ctx, "namespace", &apiv1.DatabaseRole{Name: "readonly", Connection: "pg", Ttl: 120, MaxTTL: 60})
+		Expect(err).To(MatchError(ErrTTLTooLong))
+
+		err = d.PutRole(ctx, "namespace", &apiv1.DatabaseRole{
+			Name:       "readonly",
+			Connection: "pg",
+			CreationStatements: []string{
+				`CREATE ROLE "{{name}}" WITH LOGIN PASSWORD '{{password}}' VALID UNTIL '{{expiration}}';`,
+				`GRANT SELECT ON ALL TABLES IN SCHEMA public TO "{{name}}";`,
+			},
+			Ttl:    600,
+			MaxTTL: 3600,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = d.PutRole(ctx, "namespace", &apiv1.DatabaseRole{Name: "writer", Connection: "pg"})
+		Expect(err).NotTo(HaveOccurred())
+
+		role, err := d.GetRole(ctx, "namespace", "readonly")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role.CreationStatements).To(HaveLen(2))
+	})
+
+	It("can generate credentials", func() {
+		creds, err = d.GenerateCredentials(ctx, "namespace", "readonly", 0, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.Username).To(MatchRegexp(`^ks_readonly_[0-9a-f]{10}$`))
+		Expect(creds.Password).To(HaveLen(32))
+		Expect(creds.Lease.Id).To(HavePrefix(CredsPath + "readonly/"))
+		Expect(creds.Lease.TokenReferenceID).To(Equal(token.ReferenceID))
+		Expect(time.Unix(creds.Lease.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(10*time.Minute), 5*time.Second))
+		Expect(db.Users()).To(Equal([]string{creds.Username}))
+
+		statements := db.Statements()
+		Expect(statements[len(statements)-1]).To(Equal(`GRANT SELECT ON ALL TABLES IN SCHEMA public TO "` + creds.Username + `";`))
+
+		other, err := d.GenerateCredentials(ctx, "namespace", "readonly", 0, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.Username).NotTo(Equal(creds.Username))
+		Expect(db.Users()).To(HaveLen(2))
+
+		Expect(leases.Revoke(ctx, other.Lease.Id)).To(Succeed())
+		Expect(db.Users()).To(Equal([]string{creds.Username}))
+	})
+
+	It("restricts credentials to the role and the token", func() {
+		_, err := d.GenerateCredentials(ctx, "namespace", "writer", 0, token)
+		Expect(err).To(MatchError(ErrRoleNotAllowed))
+
+		_, err = d.GenerateCredentials(ctx, "namespace", "readonly", 2*time.Hour, token)
+		Expect(err).To(MatchError(ErrTTLTooLong))
+
+		shortLived := &apiv1.AccessToken{ReferenceID: "kr000000000000000002", ExpiresAt: time.Now().Add(time.Minute).Unix()}
+		short, err := d.GenerateCredentials(ctx, "namespace", "readonly", 0, shortLived)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(short.Lease.ExpiresAt).To(Equal(shortLived.ExpiresAt))
+		Expect(leases.RevokeByToken(ctx, shortLived.ReferenceID)).To(Succeed())
+
+		_, err = d.GenerateCredentials(ctx, "other-namespace", "readonly", 0, token)
+		Expect(err).To(MatchError(ErrRoleNotFound))
+	})
+
+	It("does not leave a user behind if it cannot be created", func() {
+		db.FailWith(fmt.Errorf("permission denied"))
+		_, err := d.GenerateCredentials(ctx, "namespace", "readonly", 0, token)
+		Expect(err).To(MatchError(ContainSubstring("revoking its lease failed")))
+		db.FailWith(nil)
+
+		all, err := leases.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(HaveLen(2))
+
+		for _, l := range all {
+			if l.Id != creds.Lease.Id {
+				Expect(leases.Revoke(ctx, l.Id)).To(Succeed())
+			}
+		}
+		Expect(db.Users()).To(Equal([]string{creds.Username}))
+	})
+
+	It("extends the expiration of the user when its lease is renewed", func() {
+		Expect(creds.Lease.MaxExpiresAt).To(Equal(creds.Lease.IssuedAt + 3600))
+
+		renewed, err := leases.Renew(ctx, creds.Lease.Id, 2*time.Hour, time.Unix(token.ExpiresAt, 0))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renewed.ExpiresAt).To(Equal(creds.Lease.MaxExpiresAt))
+
+		expiration := time.Unix(renewed.ExpiresAt, 0).UTC().Format(ExpirationFormat)
+		statements := db.Statements()
+		Expect(statements[len(statements)-1]).To(Equal(`ALTER ROLE "` + creds.Username + `" VALID UNTIL '` + expiration + `';`))
+	})
+
+	It("keeps connections with active leases", func() {
+		err := d.DeleteConnection(ctx, "namespace", "pg")
+		Expect(err).To(MatchError(ErrConnectionInUse))
+	})
+
+	It("drops the user when its lease is revoked", func() {
+		err := d.DeleteRole(ctx, "namespace", "readonly")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = leases.RevokeByToken(ctx, token.ReferenceID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Users()).To(BeEmpty())
+
+		statements := db.Statements()
+		Expect(statements[len(statements)-1]).To(Equal(`DROP ROLE IF EXISTS "` + creds.Username + `";`))
+
+		_, err = leases.Get(ctx, creds.Lease.Id)
+		Expect(err).To(MatchError(lease.ErrLeaseNotFound))
+
+		err = d.DeleteConnection(ctx, "namespace", "pg")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fits user names into the limits of the database", func() {
+		username, err := newUsername("A-Very-Long-Role-Name-For-MySQL", mysql{}.MaxUsernameLength())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(username).To(MatchRegexp(`^ks_a_very_long_role_n_[0-9a-f]{10}$`))
+		Expect(len(username)).To(BeNumerically("<=", 32))
+	})
+})
@@ -0,0 +1,169 @@
+// Package databasetest provides an in-process stand-in for a database, so the database engine can be tested without a database server.
+package databasetest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// DriverName is the name of the stand-in database/sql driver.
+const DriverName = "kstash-test"
+
+var (
+	createUser = regexp.MustCompile(`(?i)^\s*CREATE\s+(?:ROLE|USER)\s+["']([^"']+)["']`)
+	dropUser   = regexp.MustCompile(`(?i)^\s*DROP\s+(?:ROLE|USER)\s+(?:IF\s+EXISTS\s+)?["']([^"']+)["']`)
+
+	mu  sync.Mutex
+	dbs = map[string]*DB{}
+)
+
+func init() {
+	sql.Register(DriverName, testDriver{})
+}
+
+// DB records the statements executed against a connection URL and keeps track of the users they create and drop.
+type DB struct {
+	mu         sync.Mutex
+	statements []string
+	users      map[string]struct{}
+	err        error
+}
+
+// Open returns the DB of a connection URL of the stand-in driver.
+func Open(connectionURL string) *DB {
+	mu.Lock()
+	defer mu.Unlock()
+
+	db, ok := dbs[connectionURL]
+	if !ok {
+		db = &DB{users: map[string]struct{}{}}
+		dbs[connectionURL] = db
+	}
+
+	return db
+}
+
+// Statements returns the statements executed so far.
+func (db *DB) Statements() []string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return append([]string{}, db.statements...)
+}
+
+// Users returns the names of the users that were created and not dropped yet.
+func (db *DB) Users() []string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	users := make([]string, 0, len(db.users))
+	for user := range db.users {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	return users
+}
+
+// FailWith makes all following statements fail with err, until it is called with nil.
+func (db *DB) FailWith(err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.err = err
+}
+
+func (db *DB) exec(query string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.err != nil {
+		return db.err
+	}
+
+	db.statements = append(db.statements, query)
+	if m := createUser.FindStringSubmatch(query); m != nil {
+		if _, ok := db.users[m[1]]; ok {
+			return fmt.Errorf("user %s already exists", m[1])
+		}
+		db.users[m[1]] = struct{}{}
+	}
+	if m := dropUser.FindStringSubmatch(query); m != nil {
+		delete(db.users, m[1])
+	}
+
+	return nil
+}
+
+type testDriver struct{}
+
+func (testDriver) Open(name string) (driver.Conn, error) {
+	return &conn{db: Open(name)}, nil
+}
+
+type conn struct {
+	db *DB
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return &stmt{db: c.db, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if len(args) > 0 {
+		return nil, driver.ErrSkip
+	}
+	if err := c.db.exec(query); err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(0), nil
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	return c.db.err
+}
+
+type stmt struct {
+	db    *DB
+	query string
+}
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+func (s *stmt) NumInput() int {
+	return -1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("arguments are not supported")
+	}
+	if err := s.db.exec(s.query); err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(0), nil
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, fmt.Errorf("queries are not supported")
+}
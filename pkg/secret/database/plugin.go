@@ -0,0 +1,96 @@
+package database
+
+import "sort"
+
+// Plugin describes how users of a type of database are created and dropped. Statements are run through database/sql,
+// so the driver of the database must be linked into the binary.
+type Plugin interface {
+	// Driver returns the name of the database/sql driver used if a connection does not specify one.
+	Driver() string
+
+	// CreationStatements returns the statements that create a user if a role does not specify any.
+	CreationStatements() []string
+
+	// RevocationStatements returns the statements that drop a user if a role does not specify any.
+	RevocationStatements() []string
+
+	// RenewStatements returns the statements that extend the expiration of a user when its lease is renewed.
+	RenewStatements() []string
+
+	// MaxUsernameLength returns the maximum length of a user name.
+	MaxUsernameLength() int
+}
+
+var plugins = map[string]Plugin{
+	"mysql":      mysql{},
+	"postgresql": postgreSQL{},
+}
+
+// Drivers returns the names of the database/sql drivers the plugins use by default, which the binary must link.
+func Drivers() []string {
+	drivers := make([]string, 0, len(plugins))
+	for _, plugin := range plugins {
+		drivers = append(drivers, plugin.Driver())
+	}
+	sort.Strings(drivers)
+
+	return drivers
+}
+
+// postgreSQL creates login roles that expire along with their lease.
+type postgreSQL struct{}
+
+func (postgreSQL) Driver() string {
+	return "postgres"
+}
+
+func (postgreSQL) CreationStatements() []string {
+	return []string{
+		`CREATE ROLE "{{name}}" WITH LOGIN PASSWORD '{{password}}' VALID UNTIL '{{expiration}}';`,
+	}
+}
+
+func (postgreSQL) RevocationStatements() []string {
+	return []string{
+		`REVOKE ALL PRIVILEGES ON ALL TABLES IN SCHEMA public FROM "{{name}}";`,
+		`DROP ROLE IF EXISTS "{{name}}";`,
+	}
+}
+
+func (postgreSQL) RenewStatements() []string {
+	return []string{
+		`ALTER ROLE "{{name}}" VALID UNTIL '{{expiration}}';`,
+	}
+}
+
+func (postgreSQL) MaxUsernameLength() int {
+	return 63
+}
+
+// mysql creates users that may connect from any host.
+type mysql struct{}
+
+func (mysql) Driver() string {
+	return "mysql"
+}
+
+func (mysql) CreationStatements() []string {
+	return []string{
+		`CREATE USER '{{name}}'@'%' IDENTIFIED BY '{{password}}';`,
+	}
+}
+
+func (mysql) RevocationStatements() []string {
+	return []string{
+		`DROP USER IF EXISTS '{{name}}'@'%';`,
+	}
+}
+
+// RenewStatements returns no statements, since users of MySQL do not expire on their own.
+func (mysql) RenewStatements() []string {
+	return nil
+}
+
+func (mysql) MaxUsernameLength() int {
+	return 32
+}
@@ -2,39 +2,182 @@ package kv
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 	"unicode"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/auth"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/lease"
+	"github.com/slaskawi/vault-poc/pkg/storage"
 )
 
-const namespacesPath = "namespaces/"
+const (
+	namespacesPath = "namespaces/"
+	kvPath         = "kv/"
+	metadataPath   = "kv-metadata/"
+
+	// Engine is the name of the engine that deletes expired items when their lease ends.
+	Engine = "kv"
+
+	// LeasePath is the prefix of the IDs of leases of expiring items, followed by the item's path.
+	LeasePath = "kv/"
+)
 
 var (
-	ErrInvalidPath = fmt.Errorf("invalid characters in path")
-	ErrNoNamespace = fmt.Errorf("no namespace specified")
+	ErrInvalidPath      = fmt.Errorf("invalid characters in path")
+	ErrNoNamespace      = fmt.Errorf("no namespace specified")
+	ErrInvalidExpiry    = fmt.Errorf("expiry must be in the future")
+	ErrNotRenewable     = fmt.Errorf("expiring items cannot be renewed, put them again instead")
+	ErrInvalidMetadata  = fmt.Errorf("invalid metadata")
+	ErrInvalidPageToken = fmt.Errorf("invalid page token")
+	ErrReservedPath     = fmt.Errorf("path is reserved for the ACLs of the secret engines")
 )
 
-// KV object.
+// KV object. Items that expire get a lease, which deletes them once it ends.
+// Every key has a metadata record, which is stored apart from its item.
 type KV struct {
-	b *barrier.Barrier
+	b      *barrier.Barrier
+	leases *lease.Manager
+	// mu guards updates of metadata records.
+	mu sync.Mutex
 }
 
-// NewKV creates a new KV object.
-func NewKV(b *barrier.Barrier) *KV {
-	return &KV{b: b}
+// NewKV creates a new KV object and registers it as the revoker of the leases of expiring items.
+func NewKV(b *barrier.Barrier, leases *lease.Manager) *KV {
+	k := &KV{b: b, leases: leases}
+	leases.RegisterRevoker(Engine, k)
+
+	return k
 }
 
-// List keys in a namespace.
+// List keys in a namespace. Expired items are not listed.
 func (k *KV) List(ctx context.Context, namespace, path string) ([]string, error) {
-	path, err := getKVPath(namespace, path)
+	entries, err := k.ListEntries(ctx, namespace, path)
 	if err != nil {
 		return nil, err
 	}
 
-	return k.b.List(ctx, path)
+	paths := make([]string, len(entries))
+	for i, entry := range entries {
+		paths[i] = entry.Path
+	}
+
+	return paths, nil
+}
+
+// ListEntries lists the keys in a namespace along with the expiry of their items. Expired items are not listed.
+func (k *KV) ListEntries(ctx context.Context, namespace, path string) ([]*apiv1.KVListEntry, error) {
+	entries, _, err := k.ListPage(ctx, namespace, path, ListOptions{})
+	return entries, err
+}
+
+// ListOptions control which entries are listed by ListPage.
+type ListOptions struct {
+	// Recursive lists all items below the path instead of the names of the items and folders directly under it.
+	Recursive bool
+
+	// PageSize is the maximum number of entries in the page. Zero lists all entries.
+	PageSize int
+
+	// PageToken is the token returned along with the previous page.
+	PageToken string
+
+	// Filter leaves out the entries it returns false for. It is called with the path of every entry in the namespace,
+	// e.g. `folder/item` or `folder/` when listing `folder/`.
+	Filter func(path string) bool
+}
+
+// ListPage lists a page of the keys in a namespace along with the expiry of their items. Expired items are not listed.
+// The returned token lists the next page, and is empty if there are no more entries. Only the entries of a page are read
+// from the storage backend, in lexical order, so large namespaces can be listed in parts.
+func (k *KV) ListPage(ctx context.Context, namespace, path string, opts ListOptions) ([]*apiv1.KVListEntry, string, error) {
+	kvPath, err := getKVPath(namespace, path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	after := ""
+	if len(opts.PageToken) > 0 {
+		bs, err := base64.RawURLEncoding.DecodeString(opts.PageToken)
+		if err != nil || len(bs) == 0 {
+			return nil, "", ErrInvalidPageToken
+		}
+		after = string(bs)
+	}
+
+	folder := strings.TrimSuffix(kvPath, "/") + "/"
+	relative := strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/")
+	if len(relative) > 0 {
+		relative += "/"
+	}
+
+	now := time.Now().Unix()
+	entries := []*apiv1.KVListEntry{}
+	for {
+		// only as many names as are missing from the page are read, so the last name read is the last entry of a full page.
+		limit := 0
+		if opts.PageSize > 0 {
+			limit = opts.PageSize - len(entries)
+		}
+
+		names, more, err := k.b.ListPage(ctx, folder, storage.ListOptions{Recursive: opts.Recursive, After: after, Limit: limit})
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, name := range names {
+			after = name
+
+			if opts.Filter != nil && !opts.Filter(relative+name) {
+				continue
+			}
+
+			entry, err := k.listEntry(ctx, folder, name, now)
+			if err != nil {
+				return nil, "", err
+			}
+			if entry != nil {
+				entries = append(entries, entry)
+			}
+		}
+
+		if !more {
+			return entries, "", nil
+		}
+		if opts.PageSize > 0 && len(entries) == opts.PageSize {
+			return entries, base64.RawURLEncoding.EncodeToString([]byte(after)), nil
+		}
+	}
+}
+
+// listEntry returns the entry of a name in a folder, or nil if its item is expired or no longer exists.
+func (k *KV) listEntry(ctx context.Context, folder, name string, now int64) (*apiv1.KVListEntry, error) {
+	entry := &apiv1.KVListEntry{Path: name}
+
+	// folders have no expiry of their own.
+	if strings.HasSuffix(name, "/") {
+		return entry, nil
+	}
+
+	// the expiry is read without decrypting the item, so listing does not read the chunks of large items either.
+	expiresAt, err := k.b.GetExpiry(ctx, folder+name)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if expiresAt > 0 && expiresAt <= now {
+		return nil, nil
+	}
+
+	entry.ExpiresAt = expiresAt
+	return entry, nil
 }
 
 // Get a key from a namespace.
@@ -53,12 +196,18 @@ func (k *KV) Get(ctx context.Context, namespace, path string) (*apiv1.Item, erro
 		return nil, err
 	}
 
+	// expired items are deleted by their lease, which may not have happened yet.
+	if isExpired(item, time.Now().Unix()) {
+		return nil, storage.ErrNotFound
+	}
+
 	item.Key = trimKVPath(namespace, path)
 	return item, nil
 }
 
-// Put a key into a namespace.
-func (k *KV) Put(ctx context.Context, namespace string, item *apiv1.Item) error {
+// Put a key into a namespace on behalf of the token with the given reference ID, which is recorded in the key's metadata.
+// Items with an expiry get a lease that deletes them once they expire. Keys under auth.EnginesSegment are reserved.
+func (k *KV) Put(ctx context.Context, namespace string, item *apiv1.Item, tokenReferenceID string) error {
 	if item == nil {
 		return fmt.Errorf("item cannot be nil")
 	}
@@ -67,30 +216,101 @@ func (k *KV) Put(ctx context.Context, namespace string, item *apiv1.Item) error
 		return ErrInvalidPath
 	}
 
+	if auth.IsEnginePath(item.Key) {
+		return ErrReservedPath
+	}
+
+	now := time.Now().Unix()
+	if item.ExpiresAt < 0 || (item.ExpiresAt > 0 && item.ExpiresAt <= now) {
+		return ErrInvalidExpiry
+	}
+
 	path, err := getKVPath(namespace, item.Key)
 	if err != nil {
 		return err
 	}
 
+	key := strings.TrimPrefix(item.Key, "/")
 	item.Key = path
-	return k.b.Put(ctx, item)
+	if err := k.b.Put(ctx, item); err != nil {
+		return err
+	}
+
+	if err := k.updateMetadata(ctx, namespace, item, key, tokenReferenceID); err != nil {
+		return err
+	}
+
+	if item.ExpiresAt == 0 {
+		return nil
+	}
+
+	return k.leases.Create(ctx, LeasePath+key, &apiv1.Lease{
+		Namespace: namespace,
+		Engine:    Engine,
+		IssuedAt:  now,
+		ExpiresAt: item.ExpiresAt,
+		Data:      map[string]string{"path": key},
+	})
 }
 
-// Delete a key from a namespace.
+// Delete a key and its metadata from a namespace.
 func (k *KV) Delete(ctx context.Context, namespace, path string) error {
 	if len(strings.TrimSuffix(path, "/")) == 0 {
 		return ErrInvalidPath
 	}
 
-	path, err := getKVPath(namespace, path)
+	kvPath, err := getKVPath(namespace, path)
 	if err != nil {
 		return err
 	}
 
-	return k.b.Delete(ctx, path)
+	if err := k.b.Delete(ctx, kvPath); err != nil {
+		return err
+	}
+
+	return k.deleteMetadata(ctx, namespace, path)
+}
+
+// Revoke deletes the item of an expired lease. It implements lease.Revoker. Items that were replaced or deleted
+// since the lease was created are left alone, since their expiry no longer matches the lease.
+func (k *KV) Revoke(ctx context.Context, l *apiv1.Lease) error {
+	path, err := getKVPath(l.Namespace, l.Data["path"])
+	if err != nil {
+		return err
+	}
+
+	item, err := k.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if item.ExpiresAt != l.ExpiresAt {
+		return nil
+	}
+
+	if err := k.b.Delete(ctx, path); err != nil {
+		return err
+	}
+
+	return k.deleteMetadata(ctx, l.Namespace, l.Data["path"])
+}
+
+// Renew implements lease.Renewer. The expiry of an item is part of the item, so items are renewed by putting them again.
+func (k *KV) Renew(ctx context.Context, l *apiv1.Lease) error {
+	return ErrNotRenewable
+}
+
+func isExpired(item *apiv1.Item, now int64) bool {
+	return item.ExpiresAt > 0 && item.ExpiresAt <= now
 }
 
 func getKVPath(namespace, path string) (string, error) {
+	return getNamespacePath(namespace, kvPath, path)
+}
+
+func getNamespacePath(namespace, folder, path string) (string, error) {
 	namespace = strings.Trim(namespace, "/")
 	if len(namespace) == 0 {
 		return "", ErrNoNamespace
@@ -108,9 +328,9 @@ func getKVPath(namespace, path string) (string, error) {
 		return "", ErrInvalidPath
 	}
 
-	return namespacesPath + namespace + "/kv/" + strings.TrimPrefix(path, "/"), nil
+	return namespacesPath + namespace + "/" + folder + strings.TrimPrefix(path, "/"), nil
 }
 
 func trimKVPath(namespace, path string) string {
-	return strings.TrimPrefix(path, namespacesPath+namespace+"/kv/")
+	return strings.TrimPrefix(path, namespacesPath+namespace+"/"+kvPath)
 }
@@ -3,6 +3,7 @@ package kv
 import (
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -11,6 +12,7 @@ import (
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 	"github.com/slaskawi/vault-poc/pkg/barrier"
 	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/lease"
 	"github.com/slaskawi/vault-poc/pkg/storage"
 	"github.com/slaskawi/vault-poc/pkg/storage/memory"
 )
@@ -40,7 +42,8 @@ var _ = Describe("kv", func() {
 	err = barr.Unseal(ctx, gatekeeperKey)
 	Expect(err).NotTo(HaveOccurred())
 
-	kv := NewKV(barr)
+	leases := lease.NewManager(barr)
+	kv := NewKV(barr, leases)
 
 	It("requires a namespace", func() {
 		paths, err := kv.List(ctx, "/", "not-gonna-work")
@@ -52,16 +55,25 @@ var _ = Describe("kv", func() {
 		_, err := kv.Get(ctx, "namespace", "")
 		Expect(err).To(MatchError(ErrInvalidPath))
 
-		err = kv.Put(ctx, "namespace", nil)
+		err = kv.Put(ctx, "namespace", nil, "")
 		Expect(err).To(HaveOccurred())
 
-		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: ""})
+		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: ""}, "")
 		Expect(err).To(MatchError(ErrInvalidPath))
 
 		err = kv.Delete(ctx, "namespace", "")
 		Expect(err).To(MatchError(ErrInvalidPath))
 	})
 
+	It("rejects keys reserved for the secret engines", func() {
+		err := kv.Put(ctx, "namespace", &apiv1.Item{Key: "/engines/pki/ca", Raw: []byte("value")}, "")
+		Expect(err).To(MatchError(ErrReservedPath))
+
+		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: "apps/engines", Raw: []byte("value")}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Delete(ctx, "namespace", "apps/engines")).To(Succeed())
+	})
+
 	It("requires printable characters in a namespace", func() {
 		paths, err := kv.List(ctx, "\x01", "item1")
 		Expect(err).To(MatchError(ErrInvalidPath))
@@ -82,7 +94,7 @@ var _ = Describe("kv", func() {
 			},
 		}
 
-		err := kv.Put(ctx, "namespace", item)
+		err := kv.Put(ctx, "namespace", item, "")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -137,4 +149,253 @@ var _ = Describe("kv", func() {
 		Expect(err).To(MatchError(storage.ErrNotFound))
 		Expect(item).To(BeNil())
 	})
+
+	It("rejects expiries in the past", func() {
+		err := kv.Put(ctx, "namespace", &apiv1.Item{Key: "expiring/item1", Raw: []byte("value"), ExpiresAt: time.Now().Add(-time.Minute).Unix()}, "")
+		Expect(err).To(MatchError(ErrInvalidExpiry))
+	})
+
+	It("can put an expiring item in the store", func() {
+		expiresAt := time.Now().Add(time.Hour).Unix()
+		err := kv.Put(ctx, "namespace", &apiv1.Item{Key: "expiring/item1", Raw: []byte("value"), ExpiresAt: expiresAt}, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := kv.Get(ctx, "namespace", "expiring/item1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.ExpiresAt).To(Equal(expiresAt))
+
+		entries, err := kv.ListEntries(ctx, "namespace", "expiring")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Path).To(Equal("item1"))
+		Expect(entries[0].ExpiresAt).To(Equal(expiresAt))
+
+		// listing does not decrypt items, so it does not depend on their encrypted value.
+		bitem, err := back.Get(ctx, "/kstash/secrets/namespaces/namespace/kv/expiring/item1")
+		Expect(err).NotTo(HaveOccurred())
+		val := bitem.Val
+		bitem.Val = []byte("unreadable")
+		Expect(back.Put(ctx, bitem)).To(Succeed())
+
+		entries, err = kv.ListEntries(ctx, "namespace", "expiring")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].ExpiresAt).To(Equal(expiresAt))
+
+		bitem.Val = val
+		Expect(back.Put(ctx, bitem)).To(Succeed())
+
+		l, err := leases.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).To(HaveLen(1))
+		Expect(l[0].Id).To(HavePrefix(LeasePath + "expiring/item1/"))
+		Expect(l[0].ExpiresAt).To(Equal(expiresAt))
+	})
+
+	It("treats expired items as not found", func() {
+		err := barr.Put(ctx, &apiv1.Item{Key: "namespaces/namespace/kv/expiring/item2", Raw: []byte("value"), ExpiresAt: time.Now().Add(-time.Second).Unix()})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = kv.Get(ctx, "namespace", "expiring/item2")
+		Expect(err).To(MatchError(storage.ErrNotFound))
+
+		paths, err := kv.List(ctx, "namespace", "expiring")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(Equal([]string{"item1"}))
+	})
+
+	It("deletes expired items when their lease ends", func() {
+		l, err := leases.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).To(HaveLen(1))
+
+		// the lease of an item that was replaced no longer deletes it.
+		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: "expiring/item1", Raw: []byte("replaced")}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Revoke(ctx, l[0])).To(Succeed())
+
+		_, err = kv.Get(ctx, "namespace", "expiring/item1")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: "expiring/item1", Raw: []byte("value"), ExpiresAt: time.Now().Add(time.Hour).Unix()}, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		revoked, err := leases.RevokePrefix(ctx, "namespace", LeasePath+"expiring/item1/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revoked).To(Equal(2))
+
+		_, err = barr.Get(ctx, "namespaces/namespace/kv/expiring/item1")
+		Expect(err).To(MatchError(storage.ErrNotFound))
+
+		_, err = kv.GetMetadata(ctx, "namespace", "expiring/item1")
+		Expect(err).To(MatchError(storage.ErrNotFound))
+	})
+
+	It("parses label selectors", func() {
+		labels := map[string]string{"team": "payments", "env": "prod"}
+
+		for selector, matches := range map[string]bool{
+			"":                               true,
+			"team=payments":                  true,
+			"team==payments, env=prod":       true,
+			"team!=payments":                 false,
+			"team=search":                    false,
+			"env in (prod, staging)":         true,
+			"env notin (prod,staging)":       false,
+			"team,!deprecated":               true,
+			"deprecated":                     false,
+			"env in (staging),team=payments": false,
+		} {
+			sel, err := ParseSelector(selector)
+			Expect(err).NotTo(HaveOccurred(), selector)
+			Expect(sel.Matches(labels)).To(Equal(matches), selector)
+		}
+
+		for _, selector := range []string{"=prod", "env in prod", "env in ()", "env like (prod)", "team=a b"} {
+			_, err := ParseSelector(selector)
+			Expect(err).To(MatchError(ErrInvalidSelector), selector)
+		}
+	})
+
+	It("tracks the metadata of items", func() {
+		err := kv.Put(ctx, "namespace", &apiv1.Item{Key: "payments/db", Raw: []byte("value")}, "token1")
+		Expect(err).NotTo(HaveOccurred())
+
+		md, err := kv.GetMetadata(ctx, "namespace", "payments/db")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md.Path).To(Equal("payments/db"))
+		Expect(md.CreatedBy).To(Equal("token1"))
+		Expect(md.UpdatedBy).To(Equal("token1"))
+		Expect(md.CreatedAt).NotTo(BeZero())
+
+		_, err = kv.PutMetadata(ctx, "namespace", &apiv1.KVMetadata{Path: "payments/missing", Owner: "team-payments"})
+		Expect(err).To(MatchError(storage.ErrNotFound))
+
+		_, err = kv.PutMetadata(ctx, "namespace", &apiv1.KVMetadata{Path: "payments/db", Labels: map[string]string{"team": "a b"}})
+		Expect(err).To(MatchError(ErrInvalidMetadata))
+
+		md, err = kv.PutMetadata(ctx, "namespace", &apiv1.KVMetadata{
+			Path:          "payments/db",
+			Owner:         "team-payments",
+			Description:   "database password",
+			Labels:        map[string]string{"team": "payments"},
+			RotationDueAt: time.Now().Add(time.Hour).Unix(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md.Owner).To(Equal("team-payments"))
+		Expect(md.CreatedBy).To(Equal("token1"))
+
+		// putting the item again keeps its metadata.
+		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: "payments/db", Raw: []byte("rotated")}, "token2")
+		Expect(err).NotTo(HaveOccurred())
+
+		md, err = kv.GetMetadata(ctx, "namespace", "payments/db")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md.Owner).To(Equal("team-payments"))
+		Expect(md.Labels).To(Equal(map[string]string{"team": "payments"}))
+		Expect(md.CreatedBy).To(Equal("token1"))
+		Expect(md.UpdatedBy).To(Equal("token2"))
+
+		// metadata is not listed along with the items.
+		paths, err := kv.List(ctx, "namespace", "/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).NotTo(ContainElement("kv-metadata/"))
+	})
+
+	It("searches items by their labels", func() {
+		err := kv.Put(ctx, "namespace", &apiv1.Item{Key: "payments/api/key", Raw: []byte("value")}, "token1")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = kv.PutMetadata(ctx, "namespace", &apiv1.KVMetadata{Path: "payments/api/key", Labels: map[string]string{"team": "payments", "env": "staging"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = kv.Put(ctx, "namespace", &apiv1.Item{Key: "search/key", Raw: []byte("value")}, "token1")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = kv.PutMetadata(ctx, "namespace", &apiv1.KVMetadata{Path: "search/key", Labels: map[string]string{"team": "search"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		results, err := kv.Search(ctx, "namespace", "", "team=payments", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Path).To(Equal("payments/api/key"))
+		Expect(results[1].Path).To(Equal("payments/db"))
+
+		results, err = kv.Search(ctx, "namespace", "", "team=payments", time.Now().Add(2*time.Hour).Unix())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Path).To(Equal("payments/db"))
+
+		results, err = kv.Search(ctx, "namespace", "search", "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Path).To(Equal("search/key"))
+
+		results, err = kv.Search(ctx, "other", "", "team", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(BeEmpty())
+
+		_, err = kv.Search(ctx, "namespace", "", "team in payments", 0)
+		Expect(err).To(MatchError(ErrInvalidSelector))
+
+		// deleting an item deletes its metadata.
+		Expect(kv.Delete(ctx, "namespace", "search/key")).To(Succeed())
+		results, err = kv.Search(ctx, "namespace", "", "team=search", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(BeEmpty())
+	})
+
+	It("lists pages of entries", func() {
+		for _, key := range []string{"paged/a", "paged/b", "paged/c/1", "paged/c/2", "paged/d"} {
+			err := kv.Put(ctx, "namespace", &apiv1.Item{Key: key, Raw: []byte("value")}, "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		var paths []string
+		token := ""
+		for {
+			entries, next, err := kv.ListPage(ctx, "namespace", "paged", ListOptions{PageSize: 2, PageToken: token})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(entries)).To(BeNumerically("<=", 2))
+			for _, entry := range entries {
+				paths = append(paths, entry.Path)
+			}
+
+			if len(next) == 0 {
+				break
+			}
+			token = next
+		}
+		Expect(paths).To(Equal([]string{"a", "b", "c/", "d"}))
+
+		entries, next, err := kv.ListPage(ctx, "namespace", "paged/", ListOptions{Recursive: true, PageSize: 3})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(3))
+		Expect(entries[2].Path).To(Equal("c/1"))
+
+		entries, next, err = kv.ListPage(ctx, "namespace", "paged/", ListOptions{Recursive: true, PageSize: 3, PageToken: next})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Path).To(Equal("c/2"))
+		Expect(entries[1].Path).To(Equal("d"))
+		Expect(next).To(BeEmpty())
+
+		// filtered entries do not count towards the size of a page.
+		var filtered []string
+		entries, next, err = kv.ListPage(ctx, "namespace", "paged", ListOptions{
+			Recursive: true,
+			PageSize:  2,
+			Filter: func(path string) bool {
+				filtered = append(filtered, path)
+				return path != "paged/a" && path != "paged/c/1"
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Path).To(Equal("b"))
+		Expect(entries[1].Path).To(Equal("c/2"))
+		Expect(next).NotTo(BeEmpty())
+		Expect(filtered).To(Equal([]string{"paged/a", "paged/b", "paged/c/1", "paged/c/2"}))
+
+		_, _, err = kv.ListPage(ctx, "namespace", "paged", ListOptions{PageToken: "not a token"})
+		Expect(err).To(MatchError(ErrInvalidPageToken))
+	})
 })
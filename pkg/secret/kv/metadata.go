@@ -0,0 +1,216 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetMetadata gets the metadata of a key from a namespace. Keys of expired items have no metadata.
+func (k *KV) GetMetadata(ctx context.Context, namespace, path string) (*apiv1.KVMetadata, error) {
+	if len(strings.TrimSuffix(path, "/")) == 0 {
+		return nil, ErrInvalidPath
+	}
+
+	md, err := k.getMetadata(ctx, namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if md.ExpiresAt > 0 && md.ExpiresAt <= time.Now().Unix() {
+		return nil, storage.ErrNotFound
+	}
+
+	return md, nil
+}
+
+// PutMetadata sets the owner, description, labels and rotation due date of a key. The key must hold an item.
+// The creation and update of the item are tracked when it is put and cannot be changed.
+func (k *KV) PutMetadata(ctx context.Context, namespace string, md *apiv1.KVMetadata) (*apiv1.KVMetadata, error) {
+	if md == nil {
+		return nil, fmt.Errorf("metadata cannot be nil")
+	}
+
+	if md.RotationDueAt < 0 {
+		return nil, fmt.Errorf("%w: rotation due date cannot be negative", ErrInvalidMetadata)
+	}
+
+	for key, value := range md.Labels {
+		if _, err := newRequirement(key, opEquals, []string{value}); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMetadata, err)
+		}
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	// the item must exist, so that metadata cannot be created for keys the caller cannot put.
+	if _, err := k.Get(ctx, namespace, md.Path); err != nil {
+		return nil, err
+	}
+
+	existing, err := k.getMetadata(ctx, namespace, md.Path)
+	if err != nil {
+		if !storage.IsErrNotFound(err) {
+			return nil, err
+		}
+		existing = &apiv1.KVMetadata{Path: strings.TrimPrefix(md.Path, "/")}
+	}
+
+	existing.Owner = md.Owner
+	existing.Description = md.Description
+	existing.Labels = md.Labels
+	existing.RotationDueAt = md.RotationDueAt
+
+	if err := k.putMetadata(ctx, namespace, existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// Search lists the metadata of the keys below a path whose labels match a selector. If rotationDueBefore is set,
+// only keys that are due for rotation by then are returned. Expired items are not returned.
+func (k *KV) Search(ctx context.Context, namespace, path, selector string, rotationDueBefore int64) ([]*apiv1.KVMetadata, error) {
+	sel, err := ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := getMetadataPath(namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	var results []*apiv1.KVMetadata
+	err = k.walkMetadata(ctx, prefix, func(md *apiv1.KVMetadata) {
+		if md.ExpiresAt > 0 && md.ExpiresAt <= now {
+			return
+		}
+		if rotationDueBefore > 0 && (md.RotationDueAt == 0 || md.RotationDueAt > rotationDueBefore) {
+			return
+		}
+		if !sel.Matches(md.Labels) {
+			return
+		}
+
+		results = append(results, md)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// updateMetadata records that an item was put by a token, creating the metadata of the key if it is new.
+func (k *KV) updateMetadata(ctx context.Context, namespace string, item *apiv1.Item, key, tokenReferenceID string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := time.Now().Unix()
+	md, err := k.getMetadata(ctx, namespace, key)
+	if err != nil {
+		if !storage.IsErrNotFound(err) {
+			return err
+		}
+		md = &apiv1.KVMetadata{Path: key, CreatedAt: now, CreatedBy: tokenReferenceID}
+	}
+
+	md.UpdatedAt = now
+	md.UpdatedBy = tokenReferenceID
+	md.ExpiresAt = item.ExpiresAt
+
+	return k.putMetadata(ctx, namespace, md)
+}
+
+// deleteMetadata deletes the metadata of a key, if there is any.
+func (k *KV) deleteMetadata(ctx context.Context, namespace, path string) error {
+	mdPath, err := getMetadataPath(namespace, path)
+	if err != nil {
+		return err
+	}
+
+	if err := k.b.Delete(ctx, mdPath); err != nil && !storage.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (k *KV) getMetadata(ctx context.Context, namespace, path string) (*apiv1.KVMetadata, error) {
+	mdPath, err := getMetadataPath(namespace, path)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := k.b.Get(ctx, mdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	md := &apiv1.KVMetadata{}
+	if err := proto.Unmarshal(item.Raw, md); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal metadata: %s: %w", path, err)
+	}
+
+	return md, nil
+}
+
+func (k *KV) putMetadata(ctx context.Context, namespace string, md *apiv1.KVMetadata) error {
+	mdPath, err := getMetadataPath(namespace, md.Path)
+	if err != nil {
+		return err
+	}
+
+	raw, err := proto.Marshal(md)
+	if err != nil {
+		return err
+	}
+
+	return k.b.Put(ctx, &apiv1.Item{Key: mdPath, Raw: raw})
+}
+
+// walkMetadata calls fn with the metadata of every key below a prefix, descending into folders.
+func (k *KV) walkMetadata(ctx context.Context, prefix string, fn func(md *apiv1.KVMetadata)) error {
+	keys, err := k.b.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		path := strings.TrimSuffix(prefix, "/") + "/" + key
+		if strings.HasSuffix(key, "/") {
+			if err := k.walkMetadata(ctx, path, fn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		item, err := k.b.Get(ctx, path)
+		if err != nil {
+			if storage.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		md := &apiv1.KVMetadata{}
+		if err := proto.Unmarshal(item.Raw, md); err != nil {
+			return fmt.Errorf("unable to unmarshal metadata: %s: %w", path, err)
+		}
+		fn(md)
+	}
+
+	return nil
+}
+
+func getMetadataPath(namespace, path string) (string, error) {
+	return getNamespacePath(namespace, metadataPath, path)
+}
@@ -0,0 +1,181 @@
+package kv
+
+import (
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidSelector = fmt.Errorf("invalid label selector")
+
+type operator int
+
+const (
+	opEquals operator = iota
+	opNotEquals
+	opIn
+	opNotIn
+	opExists
+	opDoesNotExist
+)
+
+// requirement is a single condition of a Selector.
+type requirement struct {
+	key    string
+	op     operator
+	values []string
+}
+
+// Selector filters labels with the syntax of Kubernetes label selectors. Requirements are separated by commas and must all match:
+// `key=value` or `key==value`, `key!=value`, `key in (a,b)`, `key notin (a,b)`, `key` for a label that exists, and `!key` for one that does not.
+// An empty selector matches everything.
+type Selector []requirement
+
+// ParseSelector parses a label selector.
+func ParseSelector(selector string) (Selector, error) {
+	var s Selector
+	for _, part := range splitSelector(selector) {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+
+		req, err := parseRequirement(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSelector, err)
+		}
+		s = append(s, req)
+	}
+
+	return s, nil
+}
+
+// Matches determines if labels satisfy every requirement of the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, req := range s {
+		value, ok := labels[req.key]
+
+		switch req.op {
+		case opEquals:
+			if !ok || value != req.values[0] {
+				return false
+			}
+		case opNotEquals:
+			if ok && value == req.values[0] {
+				return false
+			}
+		case opIn:
+			if !ok || !contains(req.values, value) {
+				return false
+			}
+		case opNotIn:
+			if ok && contains(req.values, value) {
+				return false
+			}
+		case opExists:
+			if !ok {
+				return false
+			}
+		case opDoesNotExist:
+			if ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func parseRequirement(part string) (requirement, error) {
+	if strings.HasPrefix(part, "!") {
+		return newRequirement(part[1:], opDoesNotExist, nil)
+	}
+
+	if i := strings.Index(part, "!="); i > -1 {
+		return newRequirement(part[:i], opNotEquals, []string{part[i+2:]})
+	}
+	if i := strings.Index(part, "=="); i > -1 {
+		return newRequirement(part[:i], opEquals, []string{part[i+2:]})
+	}
+	if i := strings.Index(part, "="); i > -1 {
+		return newRequirement(part[:i], opEquals, []string{part[i+1:]})
+	}
+
+	fields := strings.Fields(part)
+	if len(fields) == 1 {
+		return newRequirement(fields[0], opExists, nil)
+	}
+
+	var op operator
+	switch fields[1] {
+	case "in":
+		op = opIn
+	case "notin":
+		op = opNotIn
+	default:
+		return requirement{}, fmt.Errorf("unknown operator: %s", fields[1])
+	}
+
+	set := strings.TrimSpace(strings.Join(fields[2:], " "))
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return requirement{}, fmt.Errorf("values must be enclosed in parentheses: %s", part)
+	}
+
+	var values []string
+	for _, value := range strings.Split(set[1:len(set)-1], ",") {
+		if value = strings.TrimSpace(value); len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return requirement{}, fmt.Errorf("no values: %s", part)
+	}
+
+	return newRequirement(fields[0], op, values)
+}
+
+func newRequirement(key string, op operator, values []string) (requirement, error) {
+	key = strings.TrimSpace(key)
+	if len(key) == 0 || strings.ContainsAny(key, " ()!=,") {
+		return requirement{}, fmt.Errorf("invalid key: %q", key)
+	}
+
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+		if strings.ContainsAny(values[i], " ()!=,") {
+			return requirement{}, fmt.Errorf("invalid value: %q", values[i])
+		}
+	}
+
+	return requirement{key: key, op: op, values: values}, nil
+}
+
+// splitSelector splits a selector at the commas that are not part of a set of values.
+func splitSelector(selector string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, selector[start:])
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
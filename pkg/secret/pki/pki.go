@@ -0,0 +1,745 @@
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	namespacesPath = "namespaces/"
+
+	caPath      = "ca"
+	rolesPath   = "roles/"
+	certsPath   = "certs/"
+	revokedPath = "revoked/"
+
+	// DefaultCATTL is the lifetime of generated CAs if none is requested.
+	DefaultCATTL = 10 * 365 * 24 * time.Hour
+
+	// DefaultTTL is the lifetime of issued certificates if neither the request nor the role specify one.
+	DefaultTTL = 30 * 24 * time.Hour
+
+	// crlLifetime is the time until a generated CRL must be fetched again.
+	crlLifetime = 24 * time.Hour
+
+	// backdate allows for clock skew between K-Stash and the clients validating its certificates.
+	backdate = 30 * time.Second
+
+	serialNumberBits = 159
+)
+
+var (
+	ErrNoNamespace         = fmt.Errorf("no namespace specified")
+	ErrInvalidName         = fmt.Errorf("invalid role name")
+	ErrCAExists            = fmt.Errorf("a CA has already been configured")
+	ErrCANotFound          = fmt.Errorf("no CA has been configured")
+	ErrInvalidCA           = fmt.Errorf("invalid CA")
+	ErrRoleNotFound        = fmt.Errorf("role not found")
+	ErrCertificateNotFound = fmt.Errorf("certificate not found")
+	ErrInvalidCSR          = fmt.Errorf("invalid certificate signing request")
+	ErrNameNotAllowed      = fmt.Errorf("name not allowed by role")
+	ErrIPSANsNotAllowed    = fmt.Errorf("IP SANs not allowed by role")
+	ErrNoNames             = fmt.Errorf("a common name or SAN is required")
+	ErrTTLTooLong          = fmt.Errorf("TTL exceeds the max TTL of the role")
+	ErrUnknownKeyType      = fmt.Errorf("unknown key type")
+)
+
+// PKI object. PKI issues X.509 certificates from a CA whose private key never leaves the barrier.
+type PKI struct {
+	b  *barrier.Barrier
+	mu sync.Mutex
+}
+
+// NewPKI creates a new PKI object.
+func NewPKI(b *barrier.Barrier) *PKI {
+	return &PKI{b: b}
+}
+
+// GenerateCA generates a self-signed CA for a namespace and returns its PEM encoded certificate.
+func (p *PKI) GenerateCA(ctx context.Context, namespace, commonName string, keyType apiv1.PKIKeyType, ttl time.Duration) (string, error) {
+	path, err := getPKIPath(namespace, caPath)
+	if err != nil {
+		return "", err
+	}
+	if len(commonName) == 0 {
+		return "", ErrNoNames
+	}
+	if ttl <= 0 {
+		ttl = DefaultCATTL
+	}
+
+	key, err := generateKey(keyType)
+	if err != nil {
+		return "", err
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.Add(ttl),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return "", err
+	}
+
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	ca := &apiv1.PKICA{
+		Certificate: encodeCertificate(der),
+		PrivateKey:  keyPEM,
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.putCA(ctx, path, ca); err != nil {
+		return "", err
+	}
+
+	return ca.Certificate, nil
+}
+
+// ImportCA imports a PEM encoded CA certificate and its private key into a namespace.
+func (p *PKI) ImportCA(ctx context.Context, namespace, certificate, privateKey string) error {
+	path, err := getPKIPath(namespace, caPath)
+	if err != nil {
+		return err
+	}
+
+	ca := &apiv1.PKICA{
+		Certificate: certificate,
+		PrivateKey:  privateKey,
+	}
+
+	cert, key, err := parseCA(ca)
+	if err != nil {
+		return err
+	}
+	if !cert.IsCA {
+		return fmt.Errorf("%w: certificate is not a CA", ErrInvalidCA)
+	}
+
+	pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(cert.PublicKey) {
+		return fmt.Errorf("%w: private key does not match certificate", ErrInvalidCA)
+	}
+
+	// re-encode the key, so it is stored the same way as generated keys
+	ca.PrivateKey, err = encodeKey(key)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.putCA(ctx, path, ca)
+}
+
+// GetCA returns the PEM encoded certificate of a namespace's CA.
+func (p *PKI) GetCA(ctx context.Context, namespace string) (string, error) {
+	ca, err := p.getCA(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	return ca.Certificate, nil
+}
+
+// PutRole creates or replaces a role in a namespace.
+func (p *PKI) PutRole(ctx context.Context, namespace string, role *apiv1.PKIRole) error {
+	if role == nil {
+		return fmt.Errorf("role cannot be nil")
+	}
+	if _, ok := apiv1.PKIKeyType_name[int32(role.KeyType)]; !ok {
+		return ErrUnknownKeyType
+	}
+	if role.MaxTTL > 0 && role.Ttl > role.MaxTTL {
+		return ErrTTLTooLong
+	}
+
+	path, err := getRolePath(namespace, role.Name)
+	if err != nil {
+		return err
+	}
+
+	raw, err := proto.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	return p.b.Put(ctx, &apiv1.Item{Key: path, Raw: raw})
+}
+
+// GetRole returns a role from a namespace.
+func (p *PKI) GetRole(ctx context.Context, namespace, name string) (*apiv1.PKIRole, error) {
+	path, err := getRolePath(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := p.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+		}
+		return nil, err
+	}
+
+	role := &apiv1.PKIRole{}
+	if err := proto.Unmarshal(item.Raw, role); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal role: %s: %w", name, err)
+	}
+
+	return role, nil
+}
+
+// DeleteRole deletes a role from a namespace. Certificates issued for the role remain valid until they expire or are revoked.
+func (p *PKI) DeleteRole(ctx context.Context, namespace, name string) error {
+	if _, err := p.GetRole(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	path, err := getRolePath(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return p.b.Delete(ctx, path)
+}
+
+// Issue generates a private key and issues a certificate for it that is allowed by the given role.
+// The common name is added to the DNS SANs of the certificate.
+func (p *PKI) Issue(ctx context.Context, namespace, roleName, commonName string, altNames, ipSANs []string, ttl time.Duration) (*apiv1.PKIIssuedCertificate, error) {
+	role, err := p.GetRole(ctx, namespace, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if len(commonName) == 0 {
+		return nil, ErrNoNames
+	}
+
+	ips := []net.IP{}
+	for _, ipSAN := range ipSANs {
+		ip := net.ParseIP(ipSAN)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP SAN: %s", ipSAN)
+		}
+		ips = append(ips, ip)
+	}
+
+	key, err := generateKey(role.KeyType)
+	if err != nil {
+		return nil, err
+	}
+
+	issued, err := p.issue(ctx, namespace, role, commonName, append([]string{commonName}, altNames...), ips, key.Public(), ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	issued.PrivateKey, err = encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+// Sign issues a certificate for a PEM encoded certificate signing request that is allowed by the given role.
+// The subject of the request is ignored except for its common name.
+func (p *PKI) Sign(ctx context.Context, namespace, roleName, csr string, ttl time.Duration) (*apiv1.PKIIssuedCertificate, error) {
+	role, err := p.GetRole(ctx, namespace, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode([]byte(csr))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("%w: no PEM encoded certificate request found", ErrInvalidCSR)
+	}
+
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCSR, err)
+	}
+	if err := req.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCSR, err)
+	}
+
+	dnsNames := req.DNSNames
+	if len(req.Subject.CommonName) > 0 {
+		dnsNames = append([]string{req.Subject.CommonName}, dnsNames...)
+	}
+
+	return p.issue(ctx, namespace, role, req.Subject.CommonName, dnsNames, req.IPAddresses, req.PublicKey, ttl)
+}
+
+// Revoke revokes the certificate with the given serial number and returns the time it was revoked at.
+func (p *PKI) Revoke(ctx context.Context, namespace, serialNumber string) (int64, error) {
+	serial, err := parseSerialNumber(serialNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	serialNumber = formatSerialNumber(serial)
+	certPath, err := getPKIPath(namespace, certsPath+serialNumber)
+	if err != nil {
+		return 0, err
+	}
+	revokedCertPath, err := getPKIPath(namespace, revokedPath+serialNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cert, err := p.getCertificate(ctx, certPath, serialNumber)
+	if err != nil {
+		return 0, err
+	}
+	if cert.RevokedAt > 0 {
+		return cert.RevokedAt, nil
+	}
+
+	cert.RevokedAt = time.Now().Unix()
+	if err := p.putCertificate(ctx, revokedCertPath, cert); err != nil {
+		return 0, err
+	}
+	if err := p.putCertificate(ctx, certPath, cert); err != nil {
+		return 0, err
+	}
+
+	return cert.RevokedAt, nil
+}
+
+// CRL generates a PEM encoded certificate revocation list of a namespace's CA, which lists every revoked certificate that has not expired.
+func (p *PKI) CRL(ctx context.Context, namespace string) (string, error) {
+	ca, err := p.getCA(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	caCert, caKey, err := parseCA(ca)
+	if err != nil {
+		return "", err
+	}
+
+	prefix, err := getPKIPath(namespace, revokedPath)
+	if err != nil {
+		return "", err
+	}
+
+	serialNumbers, err := p.b.List(ctx, prefix)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	revoked := []pkix.RevokedCertificate{}
+	for _, serialNumber := range serialNumbers {
+		cert, err := p.getCertificate(ctx, prefix+serialNumber, serialNumber)
+		if err != nil {
+			return "", err
+		}
+		if cert.ExpiresAt < now.Unix() {
+			continue
+		}
+
+		serial, err := parseSerialNumber(cert.SerialNumber)
+		if err != nil {
+			return "", err
+		}
+
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: time.Unix(cert.RevokedAt, 0),
+		})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificates: revoked,
+		Number:              big.NewInt(now.UnixNano()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(crlLifetime),
+	}, caCert, caKey)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})), nil
+}
+
+// issue checks the given names against the role and issues a certificate for them, which is recorded so it can be revoked.
+func (p *PKI) issue(ctx context.Context, namespace string, role *apiv1.PKIRole, commonName string, dnsNames []string, ips []net.IP, pub crypto.PublicKey, ttl time.Duration) (*apiv1.PKIIssuedCertificate, error) {
+	dnsNames = dedupe(dnsNames)
+	if len(dnsNames) == 0 && len(ips) == 0 {
+		return nil, ErrNoNames
+	}
+	for _, name := range dnsNames {
+		if !nameAllowed(role, name) {
+			return nil, fmt.Errorf("%w: %s", ErrNameNotAllowed, name)
+		}
+	}
+	if len(ips) > 0 && !role.AllowIPSANs {
+		return nil, ErrIPSANsNotAllowed
+	}
+
+	ttl, err := certificateTTL(role, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := p.getCA(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	caCert, caKey, err := parseCA(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// certificates never outlive their CA
+	now := time.Now()
+	notAfter := now.Add(ttl)
+	if notAfter.After(caCert.NotAfter) {
+		notAfter = caCert.NotAfter
+	}
+
+	keyUsage := x509.KeyUsageDigitalSignature
+	if _, ok := pub.(*rsa.PublicKey); ok {
+		keyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              dnsNames,
+		IPAddresses:           ips,
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              notAfter,
+		KeyUsage:              keyUsage,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, pub, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := &apiv1.PKICertificate{
+		SerialNumber: formatSerialNumber(serial),
+		Certificate:  encodeCertificate(der),
+		ExpiresAt:    notAfter.Unix(),
+	}
+
+	certPath, err := getPKIPath(namespace, certsPath+cert.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.putCertificate(ctx, certPath, cert); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PKIIssuedCertificate{
+		SerialNumber:  cert.SerialNumber,
+		Certificate:   cert.Certificate,
+		CaCertificate: ca.Certificate,
+		ExpiresAt:     cert.ExpiresAt,
+	}, nil
+}
+
+func (p *PKI) getCA(ctx context.Context, namespace string) (*apiv1.PKICA, error) {
+	path, err := getPKIPath(namespace, caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := p.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, ErrCANotFound
+		}
+		return nil, err
+	}
+
+	ca := &apiv1.PKICA{}
+	if err := proto.Unmarshal(item.Raw, ca); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal CA: %w", err)
+	}
+
+	return ca, nil
+}
+
+func (p *PKI) putCA(ctx context.Context, path string, ca *apiv1.PKICA) error {
+	if _, err := p.b.Get(ctx, path); err == nil {
+		return ErrCAExists
+	} else if !storage.IsErrNotFound(err) {
+		return err
+	}
+
+	raw, err := proto.Marshal(ca)
+	if err != nil {
+		return err
+	}
+
+	return p.b.Put(ctx, &apiv1.Item{Key: path, Raw: raw})
+}
+
+func (p *PKI) getCertificate(ctx context.Context, path, serialNumber string) (*apiv1.PKICertificate, error) {
+	item, err := p.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrCertificateNotFound, serialNumber)
+		}
+		return nil, err
+	}
+
+	cert := &apiv1.PKICertificate{}
+	if err := proto.Unmarshal(item.Raw, cert); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal certificate: %s: %w", serialNumber, err)
+	}
+
+	return cert, nil
+}
+
+func (p *PKI) putCertificate(ctx context.Context, path string, cert *apiv1.PKICertificate) error {
+	raw, err := proto.Marshal(cert)
+	if err != nil {
+		return err
+	}
+
+	return p.b.Put(ctx, &apiv1.Item{Key: path, Raw: raw})
+}
+
+// nameAllowed checks whether a DNS name matches one of the allowed domains of the role.
+// Wildcard names are only allowed as subdomains.
+func nameAllowed(role *apiv1.PKIRole, name string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if !validHostname(name) {
+		return false
+	}
+
+	for _, domain := range role.AllowedDomains {
+		domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+		if len(domain) == 0 {
+			continue
+		}
+
+		if role.AllowBareDomains && name == domain {
+			return true
+		}
+		if role.AllowSubdomains && strings.HasSuffix(name, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validHostname checks that every label of the name is a valid hostname label. Only the first label may be a wildcard.
+func validHostname(name string) bool {
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if label == "*" && i == 0 && len(labels) > 1 {
+			continue
+		}
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		if strings.IndexFunc(label, func(r rune) bool {
+			return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-')
+		}) > -1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// certificateTTL returns the TTL of a certificate issued for the role. The role's TTL is used if none was requested.
+func certificateTTL(role *apiv1.PKIRole, ttl time.Duration) (time.Duration, error) {
+	maxTTL := time.Duration(role.MaxTTL) * time.Second
+	if ttl <= 0 {
+		ttl = time.Duration(role.Ttl) * time.Second
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+		if maxTTL > 0 && ttl > maxTTL {
+			ttl = maxTTL
+		}
+	}
+
+	if maxTTL > 0 && ttl > maxTTL {
+		return 0, fmt.Errorf("%w: %s exceeds %s", ErrTTLTooLong, ttl, maxTTL)
+	}
+
+	return ttl, nil
+}
+
+func generateKey(keyType apiv1.PKIKeyType) (crypto.Signer, error) {
+	switch keyType {
+	case apiv1.PKIKeyType_EC_P256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case apiv1.PKIKeyType_RSA_2048:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case apiv1.PKIKeyType_ED25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, ErrUnknownKeyType
+	}
+}
+
+func encodeKey(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+func encodeCertificate(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// parseCA parses the PEM encoded certificate and private key of a CA. PKCS #8, PKCS #1, and SEC 1 private keys are supported.
+func parseCA(ca *apiv1.PKICA) (*x509.Certificate, crypto.Signer, error) {
+	certBlock, _ := pem.Decode([]byte(ca.Certificate))
+	if certBlock == nil || certBlock.Type != "CERTIFICATE" {
+		return nil, nil, fmt.Errorf("%w: no PEM encoded certificate found", ErrInvalidCA)
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	keyBlock, _ := pem.Decode([]byte(ca.PrivateKey))
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("%w: no PEM encoded private key found", ErrInvalidCA)
+	}
+
+	var key interface{}
+	switch keyBlock.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(keyBlock.Bytes)
+	default:
+		err = fmt.Errorf("unsupported private key type: %s", keyBlock.Type)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: private key cannot sign", ErrInvalidCA)
+	}
+
+	return cert, signer, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+}
+
+// formatSerialNumber formats a serial number as lowercase hex without leading zeros.
+func formatSerialNumber(serial *big.Int) string {
+	return serial.Text(16)
+}
+
+// parseSerialNumber accepts serial numbers formatted as hex with or without colons, e.g. as printed by OpenSSL.
+func parseSerialNumber(serialNumber string) (*big.Int, error) {
+	serial, ok := new(big.Int).SetString(strings.ReplaceAll(serialNumber, ":", ""), 16)
+	if !ok || serial.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrCertificateNotFound, serialNumber)
+	}
+
+	return serial, nil
+}
+
+func dedupe(names []string) []string {
+	seen := map[string]struct{}{}
+	deduped := []string{}
+	for _, name := range names {
+		if _, ok := seen[name]; ok || len(name) == 0 {
+			continue
+		}
+		seen[name] = struct{}{}
+		deduped = append(deduped, name)
+	}
+
+	return deduped
+}
+
+func getRolePath(namespace, name string) (string, error) {
+	if len(name) == 0 || strings.Contains(name, "/") {
+		return "", ErrInvalidName
+	}
+
+	return getPKIPath(namespace, rolesPath+name)
+}
+
+func getPKIPath(namespace, path string) (string, error) {
+	namespace = strings.Trim(namespace, "/")
+	if len(namespace) == 0 {
+		return "", ErrNoNamespace
+	}
+
+	if strings.IndexFunc(namespace+path, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) > -1 {
+		return "", ErrInvalidName
+	}
+
+	return namespacesPath + namespace + "/pki/" + path, nil
+}
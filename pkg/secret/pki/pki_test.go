@@ -0,0 +1,304 @@
+package pki
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestPKI(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "pki")
+}
+
+func parseCertificate(certPEM string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(certPEM))
+	Expect(block).NotTo(BeNil())
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	Expect(err).NotTo(HaveOccurred())
+	return cert
+}
+
+func parseCRL(crlPEM string) *pkix.CertificateList {
+	block, _ := pem.Decode([]byte(crlPEM))
+	Expect(block).NotTo(BeNil())
+
+	crl, err := x509.ParseCRL(block.Bytes)
+	Expect(err).NotTo(HaveOccurred())
+	return crl
+}
+
+var _ = Describe("pki", func() {
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	barr, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx := context.Background()
+	err = barr.Initialize(ctx, gatekeeperKey, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Unseal(ctx, gatekeeperKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	pki := NewPKI(barr)
+
+	var (
+		caCert *x509.Certificate
+		issued *apiv1.PKIIssuedCertificate
+	)
+
+	It("requires a CA to issue certificates", func() {
+		_, err := pki.GetCA(ctx, "namespace")
+		Expect(err).To(MatchError(ErrCANotFound))
+
+		err = pki.PutRole(ctx, "namespace", &apiv1.PKIRole{Name: "web", AllowedDomains: []string{"example.com"}, AllowSubdomains: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = pki.Issue(ctx, "namespace", "web", "www.example.com", nil, nil, 0)
+		Expect(err).To(MatchError(ErrCANotFound))
+	})
+
+	It("can generate a CA", func() {
+		certPEM, err := pki.GenerateCA(ctx, "namespace", "Example CA", apiv1.PKIKeyType_EC_P256, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		caCert = parseCertificate(certPEM)
+		Expect(caCert.IsCA).To(BeTrue())
+		Expect(caCert.Subject.CommonName).To(Equal("Example CA"))
+		Expect(caCert.CheckSignatureFrom(caCert)).To(Succeed())
+
+		_, err = pki.GenerateCA(ctx, "namespace", "Example CA", apiv1.PKIKeyType_EC_P256, time.Hour)
+		Expect(err).To(MatchError(ErrCAExists))
+	})
+
+	It("stores the CA in the barrier", func() {
+		item, err := barr.Get(ctx, "namespaces/namespace/pki/ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(item.Raw)).To(ContainSubstring("PRIVATE KEY"))
+
+		bitem, err := back.Get(ctx, "/kstash/secrets/namespaces/namespace/pki/ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bitem.Val)).NotTo(ContainSubstring("PRIVATE KEY"))
+	})
+
+	It("can issue a certificate", func() {
+		issued, err = pki.Issue(ctx, "namespace", "web", "www.example.com", []string{"api.example.com"}, nil, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issued.PrivateKey).To(ContainSubstring("PRIVATE KEY"))
+
+		cert := parseCertificate(issued.Certificate)
+		Expect(cert.CheckSignatureFrom(caCert)).To(Succeed())
+		Expect(cert.Subject.CommonName).To(Equal("www.example.com"))
+		Expect(cert.DNSNames).To(Equal([]string{"www.example.com", "api.example.com"}))
+		Expect(cert.NotAfter.Unix()).To(Equal(issued.ExpiresAt))
+		Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+		Expect(formatSerialNumber(cert.SerialNumber)).To(Equal(issued.SerialNumber))
+	})
+
+	It("restricts names to the allowed domains of the role", func() {
+		_, err := pki.Issue(ctx, "namespace", "web", "example.com", nil, nil, 0)
+		Expect(err).To(MatchError(ErrNameNotAllowed))
+
+		_, err = pki.Issue(ctx, "namespace", "web", "www.example.org", nil, nil, 0)
+		Expect(err).To(MatchError(ErrNameNotAllowed))
+
+		_, err = pki.Issue(ctx, "namespace", "web", "www.example.com", []string{"evil-example.com"}, nil, 0)
+		Expect(err).To(MatchError(ErrNameNotAllowed))
+
+		_, err = pki.Issue(ctx, "namespace", "web", "www.example.com", nil, []string{"10.0.0.1"}, 0)
+		Expect(err).To(MatchError(ErrIPSANsNotAllowed))
+
+		_, err = pki.Issue(ctx, "namespace", "not-here", "www.example.com", nil, nil, 0)
+		Expect(err).To(MatchError(ErrRoleNotFound))
+	})
+
+	It("restricts the TTL of certificates", func() {
+		err := pki.PutRole(ctx, "namespace", &apiv1.PKIRole{
+			Name:             "internal",
+			AllowedDomains:   []string{"internal.example.com"},
+			AllowBareDomains: true,
+			AllowIPSANs:      true,
+			KeyType:          apiv1.PKIKeyType_ED25519,
+			Ttl:              60,
+			MaxTTL:           120,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = pki.Issue(ctx, "namespace", "internal", "internal.example.com", nil, nil, time.Hour)
+		Expect(err).To(MatchError(ErrTTLTooLong))
+
+		internal, err := pki.Issue(ctx, "namespace", "internal", "internal.example.com", nil, []string{"10.0.0.1"}, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		cert := parseCertificate(internal.Certificate)
+		Expect(cert.IPAddresses[0].Equal(net.ParseIP("10.0.0.1"))).To(BeTrue())
+		Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+
+		err = pki.PutRole(ctx, "namespace", &apiv1.PKIRole{Name: "invalid", Ttl: 120, MaxTTL: 60})
+		Expect(err).To(MatchError(ErrTTLTooLong))
+	})
+
+	It("never issues certificates that outlive the CA", func() {
+		err := pki.PutRole(ctx, "namespace", &apiv1.PKIRole{Name: "long", AllowedDomains: []string{"example.com"}, AllowBareDomains: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		long, err := pki.Issue(ctx, "namespace", "long", "example.com", nil, nil, 24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseCertificate(long.Certificate).NotAfter).To(Equal(caCert.NotAfter))
+	})
+
+	It("can sign a certificate signing request", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: "app.example.com", Organization: []string{"ignored"}},
+			DNSNames: []string{"*.app.example.com"},
+		}, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		csr := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+		signed, err := pki.Sign(ctx, "namespace", "web", csr, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signed.PrivateKey).To(BeEmpty())
+
+		cert := parseCertificate(signed.Certificate)
+		Expect(cert.CheckSignatureFrom(caCert)).To(Succeed())
+		Expect(cert.DNSNames).To(Equal([]string{"app.example.com", "*.app.example.com"}))
+		Expect(cert.Subject.Organization).To(BeEmpty())
+		Expect(cert.PublicKey.(*ecdsa.PublicKey).Equal(key.Public())).To(BeTrue())
+
+		der, err = x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject: pkix.Name{CommonName: "app.example.org"},
+		}, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		csr = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+		_, err = pki.Sign(ctx, "namespace", "web", csr, 0)
+		Expect(err).To(MatchError(ErrNameNotAllowed))
+
+		_, err = pki.Sign(ctx, "namespace", "web", "not-a-csr", 0)
+		Expect(err).To(MatchError(ErrInvalidCSR))
+	})
+
+	It("can revoke a certificate", func() {
+		crlPEM, err := pki.CRL(ctx, "namespace")
+		Expect(err).NotTo(HaveOccurred())
+
+		crl := parseCRL(crlPEM)
+		Expect(caCert.CheckCRLSignature(crl)).To(Succeed())
+		Expect(crl.TBSCertList.RevokedCertificates).To(BeEmpty())
+
+		cert := parseCertificate(issued.Certificate)
+		revokedAt, err := pki.Revoke(ctx, "namespace", issued.SerialNumber)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revokedAt).NotTo(BeZero())
+
+		again, err := pki.Revoke(ctx, "namespace", issued.SerialNumber)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(revokedAt))
+
+		crlPEM, err = pki.CRL(ctx, "namespace")
+		Expect(err).NotTo(HaveOccurred())
+
+		crl = parseCRL(crlPEM)
+		Expect(caCert.CheckCRLSignature(crl)).To(Succeed())
+		Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+		Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(cert.SerialNumber))
+	})
+
+	It("accepts serial numbers formatted with colons", func() {
+		serial := new(big.Int).SetBytes([]byte{0x0a, 0x1b})
+		Expect(parseSerialNumber("0A:1B")).To(Equal(serial))
+
+		_, err := pki.Revoke(ctx, "namespace", "0a:1b")
+		Expect(err).To(MatchError(ErrCertificateNotFound))
+
+		_, err = pki.Revoke(ctx, "namespace", "not-a-serial")
+		Expect(err).To(MatchError(ErrCertificateNotFound))
+	})
+
+	It("can import a CA", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "Imported CA"},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		Expect(err).NotTo(HaveOccurred())
+
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		certPEM := encodeCertificate(der)
+		keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+		otherKey, err := generateKey(apiv1.PKIKeyType_EC_P256)
+		Expect(err).NotTo(HaveOccurred())
+		otherKeyPEM, err := encodeKey(otherKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = pki.ImportCA(ctx, "imported", certPEM, otherKeyPEM)
+		Expect(err).To(MatchError(ErrInvalidCA))
+
+		err = pki.ImportCA(ctx, "imported", certPEM, keyPEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = pki.PutRole(ctx, "imported", &apiv1.PKIRole{Name: "web", AllowedDomains: []string{"example.com"}, AllowSubdomains: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		imported, err := pki.Issue(ctx, "imported", "web", "www.example.com", nil, nil, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseCertificate(imported.Certificate).CheckSignatureFrom(parseCertificate(certPEM))).To(Succeed())
+	})
+
+	It("isolates namespaces", func() {
+		_, err := pki.Issue(ctx, "something-else", "web", "www.example.com", nil, nil, 0)
+		Expect(err).To(MatchError(ErrRoleNotFound))
+
+		_, err = pki.Revoke(ctx, "imported", issued.SerialNumber)
+		Expect(err).To(MatchError(ErrCertificateNotFound))
+	})
+
+	It("can delete a role", func() {
+		err := pki.DeleteRole(ctx, "namespace", "web")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = pki.GetRole(ctx, "namespace", "web")
+		Expect(err).To(MatchError(ErrRoleNotFound))
+
+		err = pki.DeleteRole(ctx, "namespace", "web")
+		Expect(err).To(MatchError(ErrRoleNotFound))
+	})
+})
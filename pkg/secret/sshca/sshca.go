@@ -0,0 +1,409 @@
+package sshca
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	namespacesPath = "namespaces/"
+
+	caPath    = "ca"
+	rolesPath = "roles/"
+
+	// DefaultTTL is the lifetime of signed certificates if neither the request nor the role specify one.
+	DefaultTTL = time.Hour
+
+	// backdate allows for clock skew between K-Stash and the hosts validating its certificates.
+	backdate = 30 * time.Second
+)
+
+var (
+	ErrNoNamespace        = fmt.Errorf("no namespace specified")
+	ErrInvalidName        = fmt.Errorf("invalid role name")
+	ErrCAExists           = fmt.Errorf("an SSH CA has already been configured")
+	ErrCANotFound         = fmt.Errorf("no SSH CA has been configured")
+	ErrInvalidCA          = fmt.Errorf("invalid SSH CA")
+	ErrRoleNotFound       = fmt.Errorf("role not found")
+	ErrInvalidRole        = fmt.Errorf("invalid role")
+	ErrInvalidPublicKey   = fmt.Errorf("invalid public key")
+	ErrNoPrincipals       = fmt.Errorf("at least one principal is required")
+	ErrPrincipalForbidden = fmt.Errorf("principal not allowed by role")
+	ErrTTLTooLong         = fmt.Errorf("TTL exceeds the max TTL of the role")
+)
+
+// SSHCA object. SSHCA signs SSH user and host keys with a CA key that never leaves the barrier.
+type SSHCA struct {
+	b  *barrier.Barrier
+	mu sync.Mutex
+}
+
+// NewSSHCA creates a new SSHCA object.
+func NewSSHCA(b *barrier.Barrier) *SSHCA {
+	return &SSHCA{b: b}
+}
+
+// GenerateCA generates an ed25519 CA key for a namespace and returns its public key in authorized_keys format.
+func (s *SSHCA) GenerateCA(ctx context.Context, namespace string) (string, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return s.putCA(ctx, namespace, key)
+}
+
+// ImportCA imports a PEM encoded CA private key into a namespace and returns its public key in authorized_keys format.
+// RSA, ECDSA, and ed25519 keys in PKCS #8, PKCS #1, SEC 1, and OpenSSH format are supported.
+func (s *SSHCA) ImportCA(ctx context.Context, namespace, privateKey string) (string, error) {
+	key, err := ssh.ParseRawPrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	// OpenSSH keys are parsed into a pointer, which cannot be marshaled as PKCS #8
+	if k, ok := key.(*ed25519.PrivateKey); ok {
+		key = *k
+	}
+
+	return s.putCA(ctx, namespace, key)
+}
+
+// GetCA returns the public key of a namespace's CA in authorized_keys format, which hosts and clients use to trust its certificates.
+func (s *SSHCA) GetCA(ctx context.Context, namespace string) (string, error) {
+	ca, err := s.getCA(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	return ca.PublicKey, nil
+}
+
+// PutRole creates or replaces a role in a namespace.
+func (s *SSHCA) PutRole(ctx context.Context, namespace string, role *apiv1.SSHRole) error {
+	if role == nil {
+		return fmt.Errorf("role cannot be nil")
+	}
+	if _, ok := apiv1.SSHCertificateType_name[int32(role.CertType)]; !ok {
+		return fmt.Errorf("%w: unknown certificate type", ErrInvalidRole)
+	}
+	if role.CertType == apiv1.SSHCertificateType_HOST && (len(role.Extensions) > 0 || len(role.CriticalOptions) > 0) {
+		return fmt.Errorf("%w: extensions and critical options are only supported for user certificates", ErrInvalidRole)
+	}
+	for _, pattern := range role.AllowedPrincipals {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w: invalid allowed principal: %s", ErrInvalidRole, pattern)
+		}
+	}
+	if role.MaxTTL > 0 && role.Ttl > role.MaxTTL {
+		return ErrTTLTooLong
+	}
+
+	rolePath, err := getRolePath(namespace, role.Name)
+	if err != nil {
+		return err
+	}
+
+	raw, err := proto.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	return s.b.Put(ctx, &apiv1.Item{Key: rolePath, Raw: raw})
+}
+
+// GetRole returns a role from a namespace.
+func (s *SSHCA) GetRole(ctx context.Context, namespace, name string) (*apiv1.SSHRole, error) {
+	path, err := getRolePath(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+		}
+		return nil, err
+	}
+
+	role := &apiv1.SSHRole{}
+	if err := proto.Unmarshal(item.Raw, role); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal role: %s: %w", name, err)
+	}
+
+	return role, nil
+}
+
+// DeleteRole deletes a role from a namespace. Certificates signed for the role remain valid until they expire.
+func (s *SSHCA) DeleteRole(ctx context.Context, namespace, name string) error {
+	if _, err := s.GetRole(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	path, err := getRolePath(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return s.b.Delete(ctx, path)
+}
+
+// SignKey signs a public key in authorized_keys format for the given principals, which must be allowed by the role.
+// The default principals of the role are used if none are requested. The key ID identifies the certificate in the logs of SSH servers.
+func (s *SSHCA) SignKey(ctx context.Context, namespace, roleName, publicKey string, principals []string, ttl time.Duration, keyID string) (*ssh.Certificate, error) {
+	role, err := s.GetRole(ctx, namespace, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+	}
+	if _, ok := pub.(*ssh.Certificate); ok {
+		return nil, fmt.Errorf("%w: certificates cannot be signed", ErrInvalidPublicKey)
+	}
+
+	if len(principals) == 0 {
+		principals = role.DefaultPrincipals
+	}
+	if len(principals) == 0 {
+		return nil, ErrNoPrincipals
+	}
+	for _, principal := range principals {
+		if !principalAllowed(role, principal) {
+			return nil, fmt.Errorf("%w: %s", ErrPrincipalForbidden, principal)
+		}
+	}
+
+	ttl, err = certificateTTL(role, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := s.signer(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	cert := &ssh.Certificate{
+		Key:             pub,
+		Serial:          serial,
+		CertType:        ssh.UserCert,
+		KeyId:           keyID,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-backdate).Unix()),
+		ValidBefore:     uint64(now.Add(ttl).Unix()),
+		Permissions: ssh.Permissions{
+			CriticalOptions: role.CriticalOptions,
+			Extensions:      role.Extensions,
+		},
+	}
+	if role.CertType == apiv1.SSHCertificateType_HOST {
+		cert.CertType = ssh.HostCert
+		cert.Permissions = ssh.Permissions{}
+	}
+
+	if err := cert.SignCert(rand.Reader, signer); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+func (s *SSHCA) getCA(ctx context.Context, namespace string) (*apiv1.SSHCA, error) {
+	path, err := getSSHPath(namespace, caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, ErrCANotFound
+		}
+		return nil, err
+	}
+
+	ca := &apiv1.SSHCA{}
+	if err := proto.Unmarshal(item.Raw, ca); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal SSH CA: %w", err)
+	}
+
+	return ca, nil
+}
+
+// putCA stores the given private key as the CA of a namespace, unless the namespace already has a CA.
+func (s *SSHCA) putCA(ctx context.Context, namespace string, key interface{}) (string, error) {
+	path, err := getSSHPath(namespace, caPath)
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	ca := &apiv1.SSHCA{
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		PublicKey:  strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))),
+	}
+
+	raw, err := proto.Marshal(ca)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.b.Get(ctx, path); err == nil {
+		return "", ErrCAExists
+	} else if !storage.IsErrNotFound(err) {
+		return "", err
+	}
+
+	if err := s.b.Put(ctx, &apiv1.Item{Key: path, Raw: raw}); err != nil {
+		return "", err
+	}
+
+	return ca.PublicKey, nil
+}
+
+// signer returns a signer for the CA of a namespace. RSA CAs sign with SHA-256, since OpenSSH no longer accepts SHA-1 signatures.
+func (s *SSHCA) signer(ctx context.Context, namespace string) (ssh.Signer, error) {
+	ca, err := s.getCA(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ssh.ParseRawPrivateKey([]byte(ca.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCA, err)
+	}
+
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		if algorithmSigner, ok := signer.(ssh.AlgorithmSigner); ok {
+			return &rsaSHA256Signer{signer: algorithmSigner}, nil
+		}
+	}
+
+	return signer, nil
+}
+
+// rsaSHA256Signer signs with rsa-sha2-256. It only implements ssh.Signer, since SignCert picks an algorithm of its own for ssh.AlgorithmSigners.
+type rsaSHA256Signer struct {
+	signer ssh.AlgorithmSigner
+}
+
+func (s *rsaSHA256Signer) PublicKey() ssh.PublicKey {
+	return s.signer.PublicKey()
+}
+
+func (s *rsaSHA256Signer) Sign(rand io.Reader, data []byte) (*ssh.Signature, error) {
+	return s.signer.SignWithAlgorithm(rand, data, ssh.SigAlgoRSASHA2256)
+}
+
+// principalAllowed checks whether a principal matches one of the allowed principals of the role, which may contain wildcards.
+func principalAllowed(role *apiv1.SSHRole, principal string) bool {
+	if len(principal) == 0 {
+		return false
+	}
+
+	for _, pattern := range role.AllowedPrincipals {
+		if ok, _ := path.Match(pattern, principal); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// certificateTTL returns the TTL of a certificate signed for the role. The role's TTL is used if none was requested.
+func certificateTTL(role *apiv1.SSHRole, ttl time.Duration) (time.Duration, error) {
+	maxTTL := time.Duration(role.MaxTTL) * time.Second
+	if ttl <= 0 {
+		ttl = time.Duration(role.Ttl) * time.Second
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+		if maxTTL > 0 && ttl > maxTTL {
+			ttl = maxTTL
+		}
+	}
+
+	if maxTTL > 0 && ttl > maxTTL {
+		return 0, fmt.Errorf("%w: %s exceeds %s", ErrTTLTooLong, ttl, maxTTL)
+	}
+
+	return ttl, nil
+}
+
+func newSerialNumber() (uint64, error) {
+	b := make([]byte, 8)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(b), nil
+}
+
+func getRolePath(namespace, name string) (string, error) {
+	if len(name) == 0 || strings.Contains(name, "/") {
+		return "", ErrInvalidName
+	}
+
+	return getSSHPath(namespace, rolesPath+name)
+}
+
+func getSSHPath(namespace, subPath string) (string, error) {
+	namespace = strings.Trim(namespace, "/")
+	if len(namespace) == 0 {
+		return "", ErrNoNamespace
+	}
+
+	if strings.IndexFunc(namespace+subPath, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) > -1 {
+		return "", ErrInvalidName
+	}
+
+	return namespacesPath + namespace + "/ssh/" + subPath, nil
+}
@@ -0,0 +1,222 @@
+package sshca
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestSSHCA(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "sshca")
+}
+
+func publicKey() string {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	Expect(err).NotTo(HaveOccurred())
+	return string(ssh.MarshalAuthorizedKey(sshPub))
+}
+
+func parseAuthorizedKey(key string) ssh.PublicKey {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	Expect(err).NotTo(HaveOccurred())
+	return pub
+}
+
+var _ = Describe("sshca", func() {
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	barr, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx := context.Background()
+	err = barr.Initialize(ctx, gatekeeperKey, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Unseal(ctx, gatekeeperKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	ca := NewSSHCA(barr)
+
+	var caKey ssh.PublicKey
+
+	It("requires a CA to sign keys", func() {
+		_, err := ca.GetCA(ctx, "namespace")
+		Expect(err).To(MatchError(ErrCANotFound))
+
+		err = ca.PutRole(ctx, "namespace", &apiv1.SSHRole{
+			Name:              "oncall",
+			AllowedPrincipals: []string{"oncall", "dev-*"},
+			DefaultPrincipals: []string{"oncall"},
+			Extensions:        map[string]string{"permit-pty": ""},
+			CriticalOptions:   map[string]string{"source-address": "10.0.0.0/8"},
+			MaxTTL:            3600,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ca.SignKey(ctx, "namespace", "oncall", publicKey(), nil, 0, "key-id")
+		Expect(err).To(MatchError(ErrCANotFound))
+	})
+
+	It("can generate a CA", func() {
+		pub, err := ca.GenerateCA(ctx, "namespace")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub).To(HavePrefix("ssh-ed25519 "))
+
+		caKey = parseAuthorizedKey(pub)
+
+		_, err = ca.GenerateCA(ctx, "namespace")
+		Expect(err).To(MatchError(ErrCAExists))
+
+		stored, err := ca.GetCA(ctx, "namespace")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(Equal(pub))
+	})
+
+	It("stores the CA in the barrier", func() {
+		bitem, err := back.Get(ctx, "/kstash/secrets/namespaces/namespace/ssh/ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bitem.Val)).NotTo(ContainSubstring("PRIVATE KEY"))
+	})
+
+	It("can sign a user key", func() {
+		cert, err := ca.SignKey(ctx, "namespace", "oncall", publicKey(), nil, 10*time.Minute, "key-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.CertType).To(Equal(uint32(ssh.UserCert)))
+		Expect(cert.KeyId).To(Equal("key-id"))
+		Expect(cert.ValidPrincipals).To(Equal([]string{"oncall"}))
+		Expect(cert.Extensions).To(HaveKey("permit-pty"))
+		Expect(cert.CriticalOptions).To(HaveKeyWithValue("source-address", "10.0.0.0/8"))
+		Expect(time.Unix(int64(cert.ValidBefore), 0)).To(BeTemporally("~", time.Now().Add(10*time.Minute), 5*time.Second))
+
+		checker := &ssh.CertChecker{
+			IsUserAuthority: func(auth ssh.PublicKey) bool {
+				return string(auth.Marshal()) == string(caKey.Marshal())
+			},
+		}
+		Expect(checker.CheckCert("oncall", cert)).To(Succeed())
+		Expect(checker.CheckCert("root", cert)).NotTo(Succeed())
+
+		signed := parseAuthorizedKey(string(ssh.MarshalAuthorizedKey(cert)))
+		Expect(signed).To(BeAssignableToTypeOf(&ssh.Certificate{}))
+	})
+
+	It("restricts principals to the allowed principals of the role", func() {
+		cert, err := ca.SignKey(ctx, "namespace", "oncall", publicKey(), []string{"dev-alice", "oncall"}, 0, "key-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.ValidPrincipals).To(Equal([]string{"dev-alice", "oncall"}))
+
+		_, err = ca.SignKey(ctx, "namespace", "oncall", publicKey(), []string{"root"}, 0, "key-id")
+		Expect(err).To(MatchError(ErrPrincipalForbidden))
+
+		_, err = ca.SignKey(ctx, "namespace", "oncall", publicKey(), []string{""}, 0, "key-id")
+		Expect(err).To(MatchError(ErrPrincipalForbidden))
+	})
+
+	It("restricts the TTL of certificates", func() {
+		_, err := ca.SignKey(ctx, "namespace", "oncall", publicKey(), nil, 2*time.Hour, "key-id")
+		Expect(err).To(MatchError(ErrTTLTooLong))
+
+		err = ca.PutRole(ctx, "namespace", &apiv1.SSHRole{Name: "invalid", Ttl: 120, MaxTTL: 60})
+		Expect(err).To(MatchError(ErrTTLTooLong))
+	})
+
+	It("rejects invalid public keys", func() {
+		_, err := ca.SignKey(ctx, "namespace", "oncall", "not-a-key", nil, 0, "key-id")
+		Expect(err).To(MatchError(ErrInvalidPublicKey))
+
+		cert, err := ca.SignKey(ctx, "namespace", "oncall", publicKey(), nil, 0, "key-id")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ca.SignKey(ctx, "namespace", "oncall", string(ssh.MarshalAuthorizedKey(cert)), nil, 0, "key-id")
+		Expect(err).To(MatchError(ErrInvalidPublicKey))
+	})
+
+	It("can sign a host key", func() {
+		err := ca.PutRole(ctx, "namespace", &apiv1.SSHRole{
+			Name:              "hosts",
+			CertType:          apiv1.SSHCertificateType_HOST,
+			AllowedPrincipals: []string{"*.example.com"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ca.SignKey(ctx, "namespace", "hosts", publicKey(), nil, 0, "key-id")
+		Expect(err).To(MatchError(ErrNoPrincipals))
+
+		cert, err := ca.SignKey(ctx, "namespace", "hosts", publicKey(), []string{"bastion.example.com"}, 0, "key-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.CertType).To(Equal(uint32(ssh.HostCert)))
+		Expect(time.Unix(int64(cert.ValidBefore), 0)).To(BeTemporally("~", time.Now().Add(DefaultTTL), 5*time.Second))
+
+		checker := &ssh.CertChecker{
+			IsHostAuthority: func(auth ssh.PublicKey, address string) bool {
+				return string(auth.Marshal()) == string(caKey.Marshal())
+			},
+		}
+		Expect(checker.CheckCert("bastion.example.com", cert)).To(Succeed())
+
+		err = ca.PutRole(ctx, "namespace", &apiv1.SSHRole{
+			Name:       "invalid",
+			CertType:   apiv1.SSHCertificateType_HOST,
+			Extensions: map[string]string{"permit-pty": ""},
+		})
+		Expect(err).To(MatchError(ErrInvalidRole))
+	})
+
+	It("can import an RSA CA that signs with SHA-256", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		_, err = ca.ImportCA(ctx, "imported", "not-a-key")
+		Expect(err).To(MatchError(ErrInvalidCA))
+
+		pub, err := ca.ImportCA(ctx, "imported", keyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub).To(HavePrefix("ssh-rsa "))
+
+		err = ca.PutRole(ctx, "imported", &apiv1.SSHRole{Name: "oncall", AllowedPrincipals: []string{"*"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		cert, err := ca.SignKey(ctx, "imported", "oncall", publicKey(), []string{"anyone"}, 0, "key-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Signature.Format).To(Equal(ssh.SigAlgoRSASHA2256))
+		Expect(string(cert.SignatureKey.Marshal())).To(Equal(string(parseAuthorizedKey(pub).Marshal())))
+	})
+
+	It("isolates namespaces", func() {
+		_, err := ca.SignKey(ctx, "something-else", "oncall", publicKey(), nil, 0, "key-id")
+		Expect(err).To(MatchError(ErrRoleNotFound))
+	})
+
+	It("can delete a role", func() {
+		err := ca.DeleteRole(ctx, "namespace", "oncall")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ca.GetRole(ctx, "namespace", "oncall")
+		Expect(err).To(MatchError(ErrRoleNotFound))
+	})
+})
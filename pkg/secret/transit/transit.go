@@ -0,0 +1,410 @@
+package transit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	namespacesPath = "namespaces/"
+
+	// prefix of ciphertexts, signatures, and HMACs, which are formatted as `kstash:v<version>:<base64>`.
+	prefix = "kstash:v"
+
+	hmacKeyLength = 32
+)
+
+var (
+	ErrInvalidKeyName     = fmt.Errorf("invalid transit key name")
+	ErrKeyExists          = fmt.Errorf("transit key already exists")
+	ErrKeyNotFound        = fmt.Errorf("transit key not found")
+	ErrNoNamespace        = fmt.Errorf("no namespace specified")
+	ErrInvalidCiphertext  = fmt.Errorf("invalid ciphertext")
+	ErrInvalidSignature   = fmt.Errorf("invalid signature")
+	ErrUnknownKeyVersion  = fmt.Errorf("unknown transit key version")
+	ErrVerifyInputMissing = fmt.Errorf("exactly one of a signature and an HMAC must be provided")
+)
+
+// Transit object. Transit encrypts, signs, and HMACs data with named keys that never leave the barrier.
+type Transit struct {
+	b  *barrier.Barrier
+	mu sync.Mutex
+}
+
+// NewTransit creates a new Transit object.
+func NewTransit(b *barrier.Barrier) *Transit {
+	return &Transit{b: b}
+}
+
+// CreateKey creates a new named key in a namespace. The key is used with the given cipher type for encryption.
+func (t *Transit) CreateKey(ctx context.Context, namespace, name string, cipherType apiv1.CipherType) error {
+	path, err := getKeyPath(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, err := t.b.Get(ctx, path); err == nil {
+		return fmt.Errorf("%w: %s", ErrKeyExists, name)
+	} else if !storage.IsErrNotFound(err) {
+		return err
+	}
+
+	now := time.Now().Unix()
+	version, err := newKeyVersion(cipherType, 1, now)
+	if err != nil {
+		return err
+	}
+
+	key := &apiv1.TransitKey{
+		Name:      name,
+		Type:      cipherType,
+		CreatedAt: now,
+		Versions:  []*apiv1.TransitKeyVersion{version},
+	}
+
+	return t.putKey(ctx, path, key)
+}
+
+// DeleteKey deletes a named key from a namespace. Data encrypted with the key can no longer be decrypted.
+func (t *Transit) DeleteKey(ctx context.Context, namespace, name string) error {
+	path, err := getKeyPath(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, err := t.getKey(ctx, path, name); err != nil {
+		return err
+	}
+
+	return t.b.Delete(ctx, path)
+}
+
+// GetKey returns information about a named key, including the public keys of all of its versions.
+func (t *Transit) GetKey(ctx context.Context, namespace, name string) (*apiv1.TransitKeyInfo, error) {
+	path, err := getKeyPath(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := t.getKey(ctx, path, name)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &apiv1.TransitKeyInfo{
+		Name:          key.Name,
+		Type:          key.Type,
+		CreatedAt:     key.CreatedAt,
+		LatestVersion: latestVersion(key).Version,
+	}
+	for _, version := range key.Versions {
+		info.PublicKeys = append(info.PublicKeys, &apiv1.TransitPublicKey{
+			Version:   version.Version,
+			PublicKey: ed25519.PrivateKey(version.SigningKey).Public().(ed25519.PublicKey),
+			CreatedAt: version.CreatedAt,
+		})
+	}
+
+	return info, nil
+}
+
+// RotateKey adds a new version to a named key and returns it. New data is encrypted, signed, and HMACed with the new version.
+func (t *Transit) RotateKey(ctx context.Context, namespace, name string) (uint32, error) {
+	path, err := getKeyPath(namespace, name)
+	if err != nil {
+		return 0, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key, err := t.getKey(ctx, path, name)
+	if err != nil {
+		return 0, err
+	}
+
+	version, err := newKeyVersion(key.Type, latestVersion(key).Version+1, time.Now().Unix())
+	if err != nil {
+		return 0, err
+	}
+	key.Versions = append(key.Versions, version)
+
+	if err := t.putKey(ctx, path, key); err != nil {
+		return 0, err
+	}
+
+	return version.Version, nil
+}
+
+// Encrypt encrypts plaintext with the latest version of a named key. The associated data is authenticated but not encrypted.
+func (t *Transit) Encrypt(ctx context.Context, namespace, name string, plaintext, associatedData []byte) (string, uint32, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return encrypt(key, plaintext, associatedData)
+}
+
+// Decrypt decrypts a ciphertext with the version of a named key it was encrypted with.
+func (t *Transit) Decrypt(ctx context.Context, namespace, name, ciphertext string, associatedData []byte) ([]byte, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(key, ciphertext, associatedData)
+}
+
+// Rewrap decrypts a ciphertext and encrypts it again with the latest version of a named key, without revealing the plaintext.
+func (t *Transit) Rewrap(ctx context.Context, namespace, name, ciphertext string, associatedData []byte) (string, uint32, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return "", 0, err
+	}
+
+	plaintext, err := decrypt(key, ciphertext, associatedData)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return encrypt(key, plaintext, associatedData)
+}
+
+// Sign signs the input with the latest version of a named key using ed25519.
+func (t *Transit) Sign(ctx context.Context, namespace, name string, input []byte) (string, uint32, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return "", 0, err
+	}
+
+	version := latestVersion(key)
+	signature := ed25519.Sign(ed25519.PrivateKey(version.SigningKey), input)
+	return format(version.Version, signature), version.Version, nil
+}
+
+// Verify verifies a signature of the input made by Sign with any version of a named key.
+func (t *Transit) Verify(ctx context.Context, namespace, name string, input []byte, signature string) (bool, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return false, err
+	}
+
+	version, sig, err := parse(key, signature)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+
+	return ed25519.Verify(ed25519.PrivateKey(version.SigningKey).Public().(ed25519.PublicKey), input, sig), nil
+}
+
+// HMAC computes an HMAC-SHA256 of the input with the latest version of a named key.
+func (t *Transit) HMAC(ctx context.Context, namespace, name string, input []byte) (string, uint32, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return "", 0, err
+	}
+
+	version := latestVersion(key)
+	return format(version.Version, computeHMAC(version, input)), version.Version, nil
+}
+
+// VerifyHMAC verifies an HMAC of the input computed by HMAC with any version of a named key.
+func (t *Transit) VerifyHMAC(ctx context.Context, namespace, name string, input []byte, mac string) (bool, error) {
+	key, err := t.loadKey(ctx, namespace, name)
+	if err != nil {
+		return false, err
+	}
+
+	version, sum, err := parse(key, mac)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+
+	return hmac.Equal(sum, computeHMAC(version, input)), nil
+}
+
+func (t *Transit) loadKey(ctx context.Context, namespace, name string) (*apiv1.TransitKey, error) {
+	path, err := getKeyPath(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.getKey(ctx, path, name)
+}
+
+func (t *Transit) getKey(ctx context.Context, path, name string) (*apiv1.TransitKey, error) {
+	item, err := t.b.Get(ctx, path)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, name)
+		}
+		return nil, err
+	}
+
+	key := &apiv1.TransitKey{}
+	if err := proto.Unmarshal(item.Raw, key); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal transit key: %s: %w", name, err)
+	}
+	if len(key.Versions) == 0 {
+		return nil, fmt.Errorf("transit key has no versions: %s", name)
+	}
+
+	return key, nil
+}
+
+func (t *Transit) putKey(ctx context.Context, path string, key *apiv1.TransitKey) error {
+	raw, err := proto.Marshal(key)
+	if err != nil {
+		return err
+	}
+
+	return t.b.Put(ctx, &apiv1.Item{Key: path, Raw: raw})
+}
+
+func newKeyVersion(cipherType apiv1.CipherType, version uint32, createdAt int64) (*apiv1.TransitKeyVersion, error) {
+	encryptionKey, err := encryption.GenerateKey(cipherType)
+	if err != nil {
+		return nil, err
+	}
+
+	_, signingKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	hmacKey := make([]byte, hmacKeyLength)
+	if _, err := io.ReadFull(rand.Reader, hmacKey); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.TransitKeyVersion{
+		Version:       version,
+		EncryptionKey: encryptionKey,
+		SigningKey:    signingKey,
+		HmacKey:       hmacKey,
+		CreatedAt:     createdAt,
+	}, nil
+}
+
+func encrypt(key *apiv1.TransitKey, plaintext, associatedData []byte) (string, uint32, error) {
+	version := latestVersion(key)
+	encrypted, err := encryption.EncryptWithAD(key.Type, version.EncryptionKey, plaintext, transitAD(key, version, associatedData))
+	if err != nil {
+		return "", 0, err
+	}
+
+	return format(version.Version, encrypted), version.Version, nil
+}
+
+func decrypt(key *apiv1.TransitKey, ciphertext string, associatedData []byte) ([]byte, error) {
+	version, encrypted, err := parse(key, ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCiphertext, err)
+	}
+
+	plaintext, err := encryption.DecryptWithAD(key.Type, version.EncryptionKey, encrypted, transitAD(key, version, associatedData))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCiphertext, err)
+	}
+
+	return plaintext, nil
+}
+
+// transitAD binds a ciphertext to the name and version of its key, in addition to the associated data of the caller.
+func transitAD(key *apiv1.TransitKey, version *apiv1.TransitKeyVersion, associatedData []byte) []byte {
+	ad := []byte(key.Name)
+	ad = append(ad, 0)
+	ad = strconv.AppendUint(ad, uint64(version.Version), 10)
+	ad = append(ad, 0)
+	return append(ad, associatedData...)
+}
+
+func computeHMAC(version *apiv1.TransitKeyVersion, input []byte) []byte {
+	mac := hmac.New(sha256.New, version.HmacKey)
+	mac.Write(input)
+	return mac.Sum(nil)
+}
+
+func latestVersion(key *apiv1.TransitKey) *apiv1.TransitKeyVersion {
+	return key.Versions[len(key.Versions)-1]
+}
+
+func format(version uint32, data []byte) string {
+	return prefix + strconv.FormatUint(uint64(version), 10) + ":" + base64.StdEncoding.EncodeToString(data)
+}
+
+// parse splits a value formatted by format and returns the key version it references along with its decoded data.
+func parse(key *apiv1.TransitKey, val string) (*apiv1.TransitKeyVersion, []byte, error) {
+	if !strings.HasPrefix(val, prefix) {
+		return nil, nil, fmt.Errorf("missing %q prefix", prefix)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(val, prefix), ":", 2)
+	if len(parts) != 2 {
+		return nil, nil, fmt.Errorf("missing key version")
+	}
+
+	v, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key version: %s", parts[0])
+	}
+
+	data, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, version := range key.Versions {
+		if version.Version == uint32(v) {
+			return version, data, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s version %d", ErrUnknownKeyVersion, key.Name, v)
+}
+
+func getKeyPath(namespace, name string) (string, error) {
+	namespace = strings.Trim(namespace, "/")
+	if len(namespace) == 0 {
+		return "", ErrNoNamespace
+	}
+
+	if len(name) == 0 || strings.Contains(name, "/") || strings.IndexFunc(name, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) > -1 {
+		return "", ErrInvalidKeyName
+	}
+
+	if strings.IndexFunc(namespace, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) > -1 {
+		return "", ErrInvalidKeyName
+	}
+
+	return namespacesPath + namespace + "/transit/" + name, nil
+}
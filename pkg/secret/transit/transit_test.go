@@ -0,0 +1,231 @@
+package transit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestTransit(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "transit")
+}
+
+var _ = Describe("transit", func() {
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	barr, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx := context.Background()
+	err = barr.Initialize(ctx, gatekeeperKey, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = barr.Unseal(ctx, gatekeeperKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	transit := NewTransit(barr)
+
+	var (
+		ciphertext string
+		signature  string
+		mac        string
+	)
+
+	It("requires a namespace and a valid key name", func() {
+		err := transit.CreateKey(ctx, "/", "key1", apiv1.CipherType_AES256_GCM)
+		Expect(err).To(MatchError(ErrNoNamespace))
+
+		err = transit.CreateKey(ctx, "namespace", "", apiv1.CipherType_AES256_GCM)
+		Expect(err).To(MatchError(ErrInvalidKeyName))
+
+		err = transit.CreateKey(ctx, "namespace", "folder/key1", apiv1.CipherType_AES256_GCM)
+		Expect(err).To(MatchError(ErrInvalidKeyName))
+
+		err = transit.CreateKey(ctx, "namespace", "key1\x00", apiv1.CipherType_AES256_GCM)
+		Expect(err).To(MatchError(ErrInvalidKeyName))
+	})
+
+	It("can create a key", func() {
+		err := transit.CreateKey(ctx, "namespace", "key1", apiv1.CipherType_AES256_GCM)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = transit.CreateKey(ctx, "namespace", "key1", apiv1.CipherType_AES256_GCM)
+		Expect(err).To(MatchError(ErrKeyExists))
+	})
+
+	It("stores keys in the barrier", func() {
+		item, err := barr.Get(ctx, "namespaces/namespace/transit/key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).NotTo(BeEmpty())
+
+		bitem, err := back.Get(ctx, "/kstash/secrets/namespaces/namespace/transit/key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bitem.Val)).NotTo(ContainSubstring("key1"))
+	})
+
+	It("returns an error if the key is not found", func() {
+		_, _, err := transit.Encrypt(ctx, "namespace", "not-here", []byte("data"), nil)
+		Expect(err).To(MatchError(ErrKeyNotFound))
+
+		_, _, err = transit.Encrypt(ctx, "something-else", "key1", []byte("data"), nil)
+		Expect(err).To(MatchError(ErrKeyNotFound))
+	})
+
+	It("can encrypt and decrypt data", func() {
+		var version uint32
+		ciphertext, version, err = transit.Encrypt(ctx, "namespace", "key1", []byte("the-data"), []byte("context"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(1)))
+		Expect(ciphertext).To(HavePrefix("kstash:v1:"))
+
+		plaintext, err := transit.Decrypt(ctx, "namespace", "key1", ciphertext, []byte("context"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("the-data")))
+	})
+
+	It("won't decrypt data with different associated data", func() {
+		_, err := transit.Decrypt(ctx, "namespace", "key1", ciphertext, []byte("other-context"))
+		Expect(err).To(MatchError(ErrInvalidCiphertext))
+	})
+
+	It("won't decrypt data with a different key", func() {
+		err := transit.CreateKey(ctx, "namespace", "key2", apiv1.CipherType_XCHACHA20_POLY1305)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = transit.Decrypt(ctx, "namespace", "key2", ciphertext, []byte("context"))
+		Expect(err).To(MatchError(ErrInvalidCiphertext))
+	})
+
+	It("won't decrypt malformed ciphertexts", func() {
+		for _, c := range []string{"", "the-data", "kstash:v1", "kstash:vx:AAAA", "kstash:v1:!!!", "kstash:v9:" + strings.TrimPrefix(ciphertext, "kstash:v1:")} {
+			_, err := transit.Decrypt(ctx, "namespace", "key1", c, []byte("context"))
+			Expect(err).To(MatchError(ErrInvalidCiphertext))
+		}
+	})
+
+	It("can sign and verify data", func() {
+		var version uint32
+		signature, version, err = transit.Sign(ctx, "namespace", "key1", []byte("the-data"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(1)))
+
+		valid, err := transit.Verify(ctx, "namespace", "key1", []byte("the-data"), signature)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeTrue())
+
+		valid, err = transit.Verify(ctx, "namespace", "key1", []byte("other-data"), signature)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeFalse())
+
+		valid, err = transit.Verify(ctx, "namespace", "key2", []byte("the-data"), signature)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeFalse())
+	})
+
+	It("exposes the public keys that verify signatures", func() {
+		info, err := transit.GetKey(ctx, "namespace", "key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Name).To(Equal("key1"))
+		Expect(info.Type).To(Equal(apiv1.CipherType_AES256_GCM))
+		Expect(info.LatestVersion).To(Equal(uint32(1)))
+		Expect(info.PublicKeys).To(HaveLen(1))
+
+		sig, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(signature, "kstash:v1:"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ed25519.Verify(info.PublicKeys[0].PublicKey, []byte("the-data"), sig)).To(BeTrue())
+	})
+
+	It("can compute and verify HMACs", func() {
+		var version uint32
+		mac, version, err = transit.HMAC(ctx, "namespace", "key1", []byte("the-data"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(1)))
+
+		valid, err := transit.VerifyHMAC(ctx, "namespace", "key1", []byte("the-data"), mac)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeTrue())
+
+		valid, err = transit.VerifyHMAC(ctx, "namespace", "key1", []byte("other-data"), mac)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeFalse())
+
+		_, err = transit.VerifyHMAC(ctx, "namespace", "key1", []byte("the-data"), "not-an-hmac")
+		Expect(err).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("can rotate a key", func() {
+		version, err := transit.RotateKey(ctx, "namespace", "key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(2)))
+
+		info, err := transit.GetKey(ctx, "namespace", "key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.LatestVersion).To(Equal(uint32(2)))
+		Expect(info.PublicKeys).To(HaveLen(2))
+	})
+
+	It("uses the latest version after rotating a key", func() {
+		c, version, err := transit.Encrypt(ctx, "namespace", "key1", []byte("the-data"), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(2)))
+		Expect(c).To(HavePrefix("kstash:v2:"))
+
+		s, version, err := transit.Sign(ctx, "namespace", "key1", []byte("the-data"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(2)))
+		Expect(s).NotTo(Equal(signature))
+	})
+
+	It("still decrypts and verifies with older versions", func() {
+		plaintext, err := transit.Decrypt(ctx, "namespace", "key1", ciphertext, []byte("context"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("the-data")))
+
+		valid, err := transit.Verify(ctx, "namespace", "key1", []byte("the-data"), signature)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeTrue())
+
+		valid, err = transit.VerifyHMAC(ctx, "namespace", "key1", []byte("the-data"), mac)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeTrue())
+	})
+
+	It("can rewrap data with the latest version", func() {
+		rewrapped, version, err := transit.Rewrap(ctx, "namespace", "key1", ciphertext, []byte("context"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(uint32(2)))
+		Expect(rewrapped).To(HavePrefix("kstash:v2:"))
+
+		plaintext, err := transit.Decrypt(ctx, "namespace", "key1", rewrapped, []byte("context"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("the-data")))
+	})
+
+	It("can delete a key", func() {
+		err := transit.DeleteKey(ctx, "namespace", "key1")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = transit.Decrypt(ctx, "namespace", "key1", ciphertext, []byte("context"))
+		Expect(err).To(MatchError(ErrKeyNotFound))
+
+		err = transit.DeleteKey(ctx, "namespace", "key1")
+		Expect(err).To(MatchError(ErrKeyNotFound))
+	})
+})
@@ -0,0 +1,332 @@
+// Package snapshot exports and imports every item stored by K-Stash as a portable archive.
+// Items are archived exactly as they are stored, so secrets are never decrypted while taking or restoring a snapshot.
+//
+// An archive starts with a magic string and a big-endian format version, followed by length-prefixed protobuf frames.
+// The first frame is a `SnapshotHeader`, every following frame is a `BackendItem`, and a zero-length frame ends the items.
+// The archive ends with a SHA-256 checksum of everything before it.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+)
+
+const (
+	// Version is the archive format version written by Save.
+	Version = 1
+
+	rootPath = "/kstash/"
+	// haPath holds the leader election records of the deployment the snapshot is taken from, which must not be restored into another deployment.
+	haPath       = rootPath + "ha/"
+	maxFrameSize = 64 << 20
+)
+
+var magic = []byte("KSTASHSS")
+
+var (
+	ErrInvalidSnapshot     = fmt.Errorf("invalid snapshot")
+	ErrChecksumMismatch    = fmt.Errorf("snapshot checksum mismatch")
+	ErrUnsupportedVersion  = fmt.Errorf("unsupported snapshot version")
+	ErrStorageNotEmpty     = fmt.Errorf("storage backend is not empty")
+	ErrMissingBarrierItems = fmt.Errorf("snapshot does not contain the barrier ID and keychain")
+	ErrInconsistent        = fmt.Errorf("items changed while the snapshot was taken")
+)
+
+// Save writes a snapshot of every item in the storage backend to w. Backends with storage.CapabilitySnapshots are read at a single point in time,
+// so the snapshot is consistent even while items are written. Other backends are read item by item, and ErrInconsistent is returned
+// if the chunks of a chunked item changed meanwhile, in which case the snapshot must be taken again.
+// Barrier items are written last, so the archived keychain holds every key used by the other items even if the encryption key is rotated meanwhile.
+func Save(ctx context.Context, store storage.Storage, w io.Writer) (*apiv1.SnapshotHeader, error) {
+	if store.Capabilities().Has(storage.CapabilitySnapshots) {
+		view, err := store.Snapshot(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the storage backend at a single point in time: %w", err)
+		}
+		store = view
+	}
+
+	idItem, err := store.Get(ctx, barrier.IDPath)
+	if err != nil {
+		if storage.IsErrNotFound(err) {
+			return nil, barrier.ErrBarrierNotInitialized
+		}
+		return nil, err
+	}
+
+	header := &apiv1.SnapshotHeader{
+		Version:   Version,
+		BarrierID: idItem.Val,
+		Created:   timestamppb.Now(),
+	}
+
+	h := sha256.New()
+	bw := bufio.NewWriter(io.MultiWriter(w, h))
+
+	if _, err := bw.Write(magic); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(bw, binary.BigEndian, uint32(Version)); err != nil {
+		return nil, err
+	}
+	if err := writeFrame(bw, header); err != nil {
+		return nil, err
+	}
+
+	// chunks sort before the items they belong to, so they are verified once every item has been written
+	written := map[string]struct{}{}
+	var chunked []*apiv1.BackendItem
+
+	writeItem := func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+
+		item, err := store.Get(ctx, key)
+		if err != nil {
+			// the item was deleted after it was listed
+			if storage.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		item.Key = key
+		written[key] = struct{}{}
+		if item.Chunks != nil {
+			chunked = append(chunked, item)
+		}
+		return writeFrame(bw, item)
+	}
+
+	if err := walk(ctx, store, rootPath, func(key string) error {
+		if key == barrier.Path {
+			return storage.ErrSkipPrefix
+		}
+		return writeItem(key)
+	}); err != nil {
+		return nil, err
+	}
+	if err := walk(ctx, store, barrier.Path, writeItem); err != nil {
+		return nil, err
+	}
+
+	for _, item := range chunked {
+		for _, path := range barrier.ChunkPaths(item) {
+			if _, ok := written[path]; !ok {
+				return nil, fmt.Errorf("%w: chunks of %s are missing", ErrInconsistent, item.Key)
+			}
+		}
+	}
+
+	// end of items
+	if err := bw.WriteByte(0); err != nil {
+		return nil, err
+	}
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(h.Sum(nil)); err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// Restore reads a snapshot from r and writes its items to the storage backend, which must be empty.
+// Nothing is written unless the whole snapshot is valid. Returns the snapshot's header and the number of restored items.
+func Restore(ctx context.Context, store storage.Storage, r io.Reader) (*apiv1.SnapshotHeader, uint64, error) {
+	empty := true
+	if err := walk(ctx, store, rootPath, func(key string) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+		empty = false
+		return io.EOF
+	}); err != nil && err != io.EOF {
+		return nil, 0, err
+	}
+	if !empty {
+		return nil, 0, ErrStorageNotEmpty
+	}
+
+	header, items, err := Read(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var restored uint64
+	for _, item := range items {
+		if err := store.Put(ctx, item); err != nil {
+			return header, restored, fmt.Errorf("unable to restore item %s: %w", item.Key, err)
+		}
+		restored++
+	}
+
+	return header, restored, nil
+}
+
+// Read reads and validates a whole snapshot from r without writing it anywhere.
+func Read(r io.Reader) (*apiv1.SnapshotHeader, []*apiv1.BackendItem, error) {
+	hr := &hashingReader{r: bufio.NewReader(r), h: sha256.New()}
+
+	prefix := make([]byte, len(magic))
+	if _, err := io.ReadFull(hr, prefix); err != nil || !bytes.Equal(prefix, magic) {
+		return nil, nil, ErrInvalidSnapshot
+	}
+
+	var version uint32
+	if err := binary.Read(hr, binary.BigEndian, &version); err != nil {
+		return nil, nil, ErrInvalidSnapshot
+	}
+	if version != Version {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+
+	header := &apiv1.SnapshotHeader{}
+	if ok, err := readFrame(hr, header); err != nil || !ok {
+		return nil, nil, ErrInvalidSnapshot
+	}
+
+	items := []*apiv1.BackendItem{}
+	for {
+		item := &apiv1.BackendItem{}
+		ok, err := readFrame(hr, item)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			break
+		}
+
+		if !strings.HasPrefix(item.Key, rootPath) || strings.HasPrefix(item.Key, haPath) {
+			return nil, nil, fmt.Errorf("%w: unexpected key %s", ErrInvalidSnapshot, item.Key)
+		}
+		items = append(items, item)
+	}
+
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(hr.r, sum); err != nil {
+		return nil, nil, ErrInvalidSnapshot
+	}
+	if !bytes.Equal(sum, hr.h.Sum(nil)) {
+		return nil, nil, ErrChecksumMismatch
+	}
+
+	if err := validateBarrierItems(header, items); err != nil {
+		return nil, nil, err
+	}
+
+	return header, items, nil
+}
+
+func validateBarrierItems(header *apiv1.SnapshotHeader, items []*apiv1.BackendItem) error {
+	hasID, hasKeychain := false, false
+	for _, item := range items {
+		switch item.Key {
+		case barrier.IDPath:
+			if !bytes.Equal(item.Val, header.BarrierID) {
+				return fmt.Errorf("%w: barrier ID does not match the header", ErrInvalidSnapshot)
+			}
+			hasID = true
+		case barrier.KeychainPath:
+			hasKeychain = true
+		}
+	}
+
+	if !hasID || !hasKeychain {
+		return ErrMissingBarrierItems
+	}
+
+	return nil
+}
+
+// walk calls fn with every key and prefix under the given prefix, skipping HA records.
+func walk(ctx context.Context, store storage.Storage, prefix string, fn storage.WalkFunc) error {
+	return storage.Walk(ctx, store, prefix, func(key string) error {
+		if key == haPath {
+			return storage.ErrSkipPrefix
+		}
+		return fn(key)
+	})
+}
+
+func writeFrame(w io.Writer, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	// an empty frame marks the end of the items, headers and items always have a version or key set
+	if len(b) == 0 {
+		return fmt.Errorf("cannot write an empty frame")
+	}
+
+	size := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(size, uint64(len(b)))
+	if _, err := w.Write(size[:n]); err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// readFrame reads the next frame into m. Returns false when the end of the items is reached.
+func readFrame(r *hashingReader, m proto.Message) (bool, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return false, ErrInvalidSnapshot
+	}
+	if size == 0 {
+		return false, nil
+	}
+	if size > maxFrameSize {
+		return false, fmt.Errorf("%w: frame of %d bytes is too large", ErrInvalidSnapshot, size)
+	}
+
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return false, ErrInvalidSnapshot
+	}
+
+	if err := proto.Unmarshal(b, m); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+
+	return true, nil
+}
+
+// hashingReader hashes the bytes that are consumed from the underlying reader, but not the bytes it has buffered.
+type hashingReader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func (r *hashingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.h.Write(p[:n])
+	return n, err
+}
+
+func (r *hashingReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.h.Write([]byte{b})
+	}
+	return b, err
+}
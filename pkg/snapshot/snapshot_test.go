@@ -0,0 +1,188 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv1 "github.com/slaskawi/vault-poc/api/v1"
+	"github.com/slaskawi/vault-poc/pkg/barrier"
+	"github.com/slaskawi/vault-poc/pkg/barrier/encryption"
+	"github.com/slaskawi/vault-poc/pkg/storage"
+	"github.com/slaskawi/vault-poc/pkg/storage/memory"
+)
+
+func TestSnapshot(t *testing.T) {
+	defer GinkgoRecover()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "snapshot")
+}
+
+var _ = Describe("snapshot", func() {
+	ctx := context.Background()
+
+	back, err := memory.NewMemoryStorage(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	b, err := barrier.NewBarrier(back)
+	Expect(err).NotTo(HaveOccurred())
+
+	gatekeeperKey, err := encryption.GenerateKey(apiv1.CipherType_AES256_GCM)
+	Expect(err).NotTo(HaveOccurred())
+
+	var archive []byte
+
+	newStore := func() storage.Storage {
+		store, err := memory.NewMemoryStorage(nil)
+		Expect(err).NotTo(HaveOccurred())
+		return store
+	}
+
+	It("fails to snapshot an uninitialized store", func() {
+		_, err := Save(ctx, back, &bytes.Buffer{})
+		Expect(err).To(MatchError(barrier.ErrBarrierNotInitialized))
+	})
+
+	It("can save a snapshot of the encrypted store", func() {
+		err := b.Initialize(ctx, gatekeeperKey, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = b.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer b.Seal()
+
+		err = b.Put(ctx, &apiv1.Item{Key: "/testing/key1", Raw: []byte("value1")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = b.RotateEncryptionKey(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = b.Put(ctx, &apiv1.Item{Key: "/testing/nested/key2", Raw: []byte("value2")})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = back.Put(ctx, &apiv1.BackendItem{Key: haPath + "leader", Val: []byte("node")})
+		Expect(err).NotTo(HaveOccurred())
+
+		var buf bytes.Buffer
+		header, err := Save(ctx, back, &buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header.Version).To(Equal(uint32(Version)))
+		Expect(header.Created).NotTo(BeNil())
+
+		id, err := b.ID(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		headerID := encryption.FromHash(header.BarrierID)
+		Expect(headerID.Hex()).To(Equal(id.Hex()))
+
+		archive = buf.Bytes()
+		Expect(bytes.HasPrefix(archive, magic)).To(BeTrue())
+		Expect(bytes.Contains(archive, []byte("value1"))).To(BeFalse())
+	})
+
+	It("writes the barrier items last and skips HA records", func() {
+		_, items, err := Read(bytes.NewReader(archive))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).NotTo(BeEmpty())
+		Expect(items[len(items)-1].Key).To(HavePrefix(barrier.Path))
+
+		for _, item := range items {
+			Expect(item.Key).NotTo(HavePrefix(haPath))
+		}
+	})
+
+	It("can restore a snapshot into an empty store", func() {
+		store := newStore()
+
+		header, numItems, err := Restore(ctx, store, bytes.NewReader(archive))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header.Version).To(Equal(uint32(Version)))
+		Expect(numItems).To(BeNumerically(">=", 4))
+
+		restored, err := barrier.NewBarrier(store)
+		Expect(err).NotTo(HaveOccurred())
+
+		sealed, err := restored.IsSealed(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sealed).To(BeTrue())
+
+		err = restored.Unseal(ctx, gatekeeperKey)
+		Expect(err).NotTo(HaveOccurred())
+		defer restored.Seal()
+
+		item, err := restored.Get(ctx, "/testing/key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("value1")))
+
+		item, err = restored.Get(ctx, "/testing/nested/key2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Raw).To(Equal([]byte("value2")))
+	})
+
+	It("refuses to restore into a store that is not empty", func() {
+		_, _, err := Restore(ctx, back, bytes.NewReader(archive))
+		Expect(err).To(MatchError(ErrStorageNotEmpty))
+	})
+
+	It("rejects a snapshot that was tampered with", func() {
+		tampered := append([]byte{}, archive...)
+		tampered[len(tampered)/2] ^= 0xff
+
+		store := newStore()
+		_, _, err := Restore(ctx, store, bytes.NewReader(tampered))
+		Expect(err).To(HaveOccurred())
+
+		keys, err := store.List(ctx, rootPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(BeEmpty())
+	})
+
+	It("rejects a snapshot with an invalid checksum", func() {
+		tampered := append([]byte{}, archive...)
+		tampered[len(tampered)-1] ^= 0xff
+
+		_, _, err := Read(bytes.NewReader(tampered))
+		Expect(err).To(MatchError(ErrChecksumMismatch))
+	})
+
+	It("rejects truncated snapshots", func() {
+		_, _, err := Read(bytes.NewReader(archive[:len(archive)-10]))
+		Expect(err).To(MatchError(ErrInvalidSnapshot))
+
+		_, _, err = Read(bytes.NewReader([]byte("not a snapshot")))
+		Expect(err).To(MatchError(ErrInvalidSnapshot))
+	})
+
+	It("rejects unsupported versions", func() {
+		tampered := append([]byte{}, archive...)
+		binary.BigEndian.PutUint32(tampered[len(magic):], Version+1)
+
+		_, _, err := Read(bytes.NewReader(tampered))
+		Expect(err).To(MatchError(ErrUnsupportedVersion))
+	})
+
+	It("refuses to save items whose chunks are missing", func() {
+		store := newStore()
+		_, items, err := Read(bytes.NewReader(archive))
+		Expect(err).NotTo(HaveOccurred())
+		for _, item := range items {
+			Expect(store.Put(ctx, item)).To(Succeed())
+		}
+
+		// a manifest whose chunks were replaced by those of a newer version of the item
+		err = store.Put(ctx, &apiv1.BackendItem{
+			Key:    "/kstash/secrets/testing/large",
+			Chunks: &apiv1.ChunkManifest{Id: "0123456789abcdef", Count: 2},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		err = store.Put(ctx, &apiv1.BackendItem{Key: "/kstash/chunks/0123456789abcdef/0", Val: []byte("chunk")})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = Save(ctx, store, &bytes.Buffer{})
+		Expect(err).To(MatchError(ErrInconsistent))
+	})
+})
@@ -3,7 +3,6 @@ package etcd
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strings"
 
 	etcdclient "go.etcd.io/etcd/client/v3"
@@ -14,6 +13,12 @@ import (
 	"github.com/slaskawi/vault-poc/pkg/storage"
 )
 
+// locksPrefix keeps the keys used by distributed locks apart from the stored items, so they are never listed or exported as items.
+const locksPrefix = "/.locks"
+
+// listBatchSize is the maximum number of keys read from etcd at once when listing.
+const listBatchSize = 1000
+
 // EtcdStorage object.
 type EtcdStorage struct {
 	c *etcdclient.Client
@@ -39,48 +44,72 @@ func NewEtcdStorage(config *etcdclient.Config) (storage.Storage, error) {
 
 // List items with keys that have the given prefix.
 func (s *EtcdStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	names, _, err := s.ListPage(ctx, prefix, storage.ListOptions{})
+	return names, err
+}
+
+// ListPage lists a page of the names of items with keys that have the given prefix. Only keys are read from etcd, in ranges of
+// at most listBatchSize keys. The keys under a nested prefix are skipped by starting the next range after them, unless the listing is recursive.
+func (s *EtcdStorage) ListPage(ctx context.Context, prefix string, opts storage.ListOptions) ([]string, bool, error) {
+	return s.listPage(ctx, prefix, opts)
+}
+
+// listPage lists a page of names like ListPage, passing the given options to every range that is read from etcd.
+func (s *EtcdStorage) listPage(ctx context.Context, prefix string, opts storage.ListOptions, ops ...etcdclient.OpOption) ([]string, bool, error) {
 	if !strings.HasSuffix(prefix, "/") {
 		prefix += "/"
 	}
 
-	resp, err := s.c.Get(ctx, prefix, etcdclient.WithPrefix())
-	if err != nil {
-		return nil, err
-	}
+	start := storage.PageStart(prefix, opts)
+	end := etcdclient.GetPrefixRangeEnd(prefix)
 
-	keys := map[string]struct{}{}
-	for _, kv := range resp.Kvs {
-		key := string(kv.Key)
+	ops = append([]etcdclient.OpOption{etcdclient.WithRange(end), etcdclient.WithKeysOnly(), etcdclient.WithLimit(listBatchSize)}, ops...)
+	names := []string{}
+	for {
+		resp, err := s.c.Get(ctx, start, ops...)
+		if err != nil {
+			return nil, false, err
+		}
 
-		if strings.HasPrefix(key, prefix) {
-			key = strings.TrimPrefix(key, prefix)
-			if len(key) == 0 {
+		next := ""
+		for _, kv := range resp.Kvs {
+			name := strings.TrimPrefix(string(kv.Key), prefix)
+			if len(name) == 0 {
 				continue
 			}
 
-			if i := strings.Index(key, "/"); i == -1 {
-				keys[key] = struct{}{}
-			} else {
-				keys[key[:i+1]] = struct{}{}
+			if i := strings.Index(name, "/"); i > -1 && !opts.Recursive {
+				name = name[:i+1]
+				next = storage.SkipPrefix(prefix + name)
 			}
-		}
-	}
 
-	keysList := make([]string, len(keys))
-	i := 0
-	for key := range keys {
-		keysList[i] = key
-		i++
-	}
+			if opts.Limit > 0 && len(names) == opts.Limit {
+				return names, true, nil
+			}
+			names = append(names, name)
 
-	sort.Strings(keysList)
+			if len(next) > 0 {
+				break
+			}
+		}
 
-	return keysList, nil
+		if len(next) == 0 {
+			if !resp.More {
+				return names, false, nil
+			}
+			next = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+		}
+		start = next
+	}
 }
 
 // Get an item by its key.
 func (s *EtcdStorage) Get(ctx context.Context, key string) (*apiv1.BackendItem, error) {
-	resp, err := s.c.Get(ctx, key)
+	return s.get(ctx, key)
+}
+
+func (s *EtcdStorage) get(ctx context.Context, key string, ops ...etcdclient.OpOption) (*apiv1.BackendItem, error) {
+	resp, err := s.c.Get(ctx, key, ops...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +145,7 @@ func (s *EtcdStorage) Delete(ctx context.Context, key string) error {
 
 // Capabilities determines the capabilities of the backend.
 func (s *EtcdStorage) Capabilities() storage.Capability {
-	return storage.CapabilityDistributedLocking | storage.CapabilityWatching
+	return storage.CapabilityDistributedLocking | storage.CapabilityWatching | storage.CapabilitySnapshots
 }
 
 // LockKey creates a distributed lock for the given key in the backend.
@@ -128,6 +157,90 @@ func (s *EtcdStorage) LockKey(ctx context.Context, key string) (storage.Mutex, e
 	}, nil
 }
 
+// Watch sends the keys that have the given prefix on the returned channel whenever they are changed or deleted.
+func (s *EtcdStorage) Watch(ctx context.Context, prefix string) (<-chan string, error) {
+	ch := make(chan string)
+	wch := s.c.Watch(ctx, prefix, etcdclient.WithPrefix())
+
+	go func() {
+		defer close(ch)
+
+		for resp := range wch {
+			for _, ev := range resp.Events {
+				select {
+				case ch <- string(ev.Kv.Key):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Snapshot returns a read-only view of the backend at its current revision. Reads from the view fail once etcd compacts the revision.
+func (s *EtcdStorage) Snapshot(ctx context.Context) (storage.Storage, error) {
+	resp, err := s.c.Get(ctx, locksPrefix, etcdclient.WithCountOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{s: s, rev: resp.Header.Revision}, nil
+}
+
+// Snapshot object.
+type Snapshot struct {
+	s   *EtcdStorage
+	rev int64
+}
+
+// List items with keys that have the given prefix at the revision of the snapshot.
+func (v *Snapshot) List(ctx context.Context, prefix string) ([]string, error) {
+	names, _, err := v.ListPage(ctx, prefix, storage.ListOptions{})
+	return names, err
+}
+
+// ListPage lists a page of the names of items with keys that have the given prefix at the revision of the snapshot.
+func (v *Snapshot) ListPage(ctx context.Context, prefix string, opts storage.ListOptions) ([]string, bool, error) {
+	return v.s.listPage(ctx, prefix, opts, etcdclient.WithRev(v.rev))
+}
+
+// Get an item by its key at the revision of the snapshot.
+func (v *Snapshot) Get(ctx context.Context, key string) (*apiv1.BackendItem, error) {
+	return v.s.get(ctx, key, etcdclient.WithRev(v.rev))
+}
+
+// Put returns ErrReadOnly.
+func (v *Snapshot) Put(ctx context.Context, item *apiv1.BackendItem) error {
+	return storage.ErrReadOnly
+}
+
+// Delete returns ErrReadOnly.
+func (v *Snapshot) Delete(ctx context.Context, key string) error {
+	return storage.ErrReadOnly
+}
+
+// Capabilities determines the capabilities of the snapshot.
+func (v *Snapshot) Capabilities() storage.Capability {
+	return storage.CapabilitySnapshots
+}
+
+// LockKey returns ErrReadOnly.
+func (v *Snapshot) LockKey(ctx context.Context, key string) (storage.Mutex, error) {
+	return nil, storage.ErrReadOnly
+}
+
+// Watch returns ErrReadOnly, since the snapshot never changes.
+func (v *Snapshot) Watch(ctx context.Context, prefix string) (<-chan string, error) {
+	return nil, storage.ErrReadOnly
+}
+
+// Snapshot returns the snapshot itself.
+func (v *Snapshot) Snapshot(ctx context.Context) (storage.Storage, error) {
+	return v, nil
+}
+
 // Mutex object.
 type Mutex struct {
 	s        *EtcdStorage
@@ -183,6 +296,15 @@ func (m *Mutex) Get() (*apiv1.BackendItem, error) {
 	return m.s.Get(m.ctx, m.key)
 }
 
+// Done returns a channel that is closed when the session holding the lock expires.
+func (m *Mutex) Done() <-chan struct{} {
+	if m.eSession == nil {
+		return nil
+	}
+
+	return m.eSession.Done()
+}
+
 func (m *Mutex) newSession() error {
 	if m.eSession != nil && m.eMutex != nil {
 		return nil
@@ -194,7 +316,7 @@ func (m *Mutex) newSession() error {
 	}
 
 	m.eSession = session
-	m.eMutex = concurrency.NewMutex(session, m.key)
+	m.eMutex = concurrency.NewMutex(session, locksPrefix+m.key)
 
 	return nil
 }
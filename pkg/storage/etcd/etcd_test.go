@@ -71,6 +71,34 @@ var _ = Describe("etcd", func() {
 		Expect(keys[0]).To(Equal("subkey1"))
 	})
 
+	It("can list pages of items", func() {
+		keys, more, err := store.ListPage(ctx, "/test/", storage.ListOptions{Limit: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]string{"key1", "key2"}))
+		Expect(more).To(BeTrue())
+
+		keys, more, err = store.ListPage(ctx, "/test/", storage.ListOptions{After: "key2", Limit: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]string{"key2/"}))
+		Expect(more).To(BeFalse())
+
+		keys, more, err = store.ListPage(ctx, "/test", storage.ListOptions{Recursive: true, Limit: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]string{"key1", "key2"}))
+		Expect(more).To(BeTrue())
+
+		keys, more, err = store.ListPage(ctx, "/test", storage.ListOptions{Recursive: true, After: "key2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]string{"key2/subkey1"}))
+		Expect(more).To(BeFalse())
+
+		// names under a nested prefix are skipped when the page starts after it.
+		keys, more, err = store.ListPage(ctx, "/", storage.ListOptions{After: "test/"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(BeEmpty())
+		Expect(more).To(BeFalse())
+	})
+
 	It("can get an item", func() {
 		item, err := store.Get(ctx, "/test/key1")
 		Expect(err).NotTo(HaveOccurred())
@@ -89,6 +117,31 @@ var _ = Describe("etcd", func() {
 		Expect(item).To(BeNil())
 	})
 
+	It("can read a snapshot that later writes do not change", func() {
+		Expect(store.Capabilities().Has(storage.CapabilitySnapshots)).To(BeTrue())
+
+		view, err := store.Snapshot(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = store.Put(ctx, &apiv1.BackendItem{Key: "/test/key1", Val: []byte("changed")})
+		Expect(err).NotTo(HaveOccurred())
+		err = store.Put(ctx, &apiv1.BackendItem{Key: "/test/snapshot", Val: []byte("new")})
+		Expect(err).NotTo(HaveOccurred())
+
+		item, err := view.Get(ctx, "/test/key1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Val).To(Equal([]byte("key1")))
+
+		names, err := view.List(ctx, "/test/")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).NotTo(ContainElement("snapshot"))
+
+		err = store.Put(ctx, &apiv1.BackendItem{Key: "/test/key1", Val: []byte("key1")})
+		Expect(err).NotTo(HaveOccurred())
+		err = store.Delete(ctx, "/test/snapshot")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
 	It("can aquire a lock to prevent concurrent distributed operations", func() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
@@ -122,6 +175,19 @@ var _ = Describe("etcd", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 
+	It("can watch keys with a prefix", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch, err := store.Watch(ctx, "/watch/")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = store.Put(ctx, &apiv1.BackendItem{Key: "/watch/key1"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(ch, time.Second).Should(Receive(Equal("/watch/key1")))
+	})
+
 	AfterSuite(func() {
 		os.RemoveAll("default.etcd")
 	})
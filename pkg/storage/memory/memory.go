@@ -12,11 +12,19 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const watchBufferSize = 16
+
 // MemoryStorage object.
 type MemoryStorage struct {
-	config *MemoryConfig
-	m      map[string][]byte
-	mu     sync.RWMutex
+	config   *MemoryConfig
+	m        map[string][]byte
+	watchers map[*watcher]struct{}
+	mu       sync.RWMutex
+}
+
+type watcher struct {
+	prefix string
+	ch     chan string
 }
 
 // NewMemoryStorage returns a new MemoryStorage object.
@@ -26,13 +34,20 @@ func NewMemoryStorage(config *MemoryConfig) (storage.Storage, error) {
 	}
 
 	return &MemoryStorage{
-		config: config,
-		m:      map[string][]byte{},
+		config:   config,
+		m:        map[string][]byte{},
+		watchers: map[*watcher]struct{}{},
 	}, nil
 }
 
 // List items with keys that have the given prefix.
 func (s *MemoryStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	names, _, err := s.ListPage(ctx, prefix, storage.ListOptions{})
+	return names, err
+}
+
+// ListPage lists a page of the names of items with keys that have the given prefix.
+func (s *MemoryStorage) ListPage(ctx context.Context, prefix string, opts storage.ListOptions) ([]string, bool, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -40,32 +55,38 @@ func (s *MemoryStorage) List(ctx context.Context, prefix string) ([]string, erro
 		prefix += "/"
 	}
 
-	keys := map[string]struct{}{}
+	start := storage.PageStart(prefix, opts)
+	keys := []string{}
 	for key := range s.m {
-		if strings.HasPrefix(key, prefix) {
-			key = strings.TrimPrefix(key, prefix)
-			if len(key) == 0 {
-				continue
-			}
-
-			if i := strings.Index(key, "/"); i == -1 {
-				keys[key] = struct{}{}
-			} else {
-				keys[key[:i+1]] = struct{}{}
-			}
+		if strings.HasPrefix(key, prefix) && key >= start {
+			keys = append(keys, key)
 		}
 	}
+	sort.Strings(keys)
 
-	keysList := make([]string, len(keys))
-	i := 0
-	for key := range keys {
-		keysList[i] = key
-		i++
-	}
+	names := []string{}
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, prefix)
+		if len(name) == 0 {
+			continue
+		}
+
+		if i := strings.Index(name, "/"); i > -1 && !opts.Recursive {
+			name = name[:i+1]
+		}
+
+		// the keys under a nested prefix are next to each other, so they are listed once.
+		if len(names) > 0 && names[len(names)-1] == name {
+			continue
+		}
 
-	sort.Strings(keysList)
+		if opts.Limit > 0 && len(names) == opts.Limit {
+			return names, true, nil
+		}
+		names = append(names, name)
+	}
 
-	return keysList, nil
+	return names, false, nil
 }
 
 // Get an item by its key.
@@ -94,6 +115,7 @@ func (s *MemoryStorage) Put(ctx context.Context, item *apiv1.BackendItem) error
 	}
 
 	s.m[item.Key] = b
+	s.notify(item.Key)
 	return nil
 }
 
@@ -103,12 +125,13 @@ func (s *MemoryStorage) Delete(ctx context.Context, key string) error {
 	defer s.mu.Unlock()
 
 	delete(s.m, key)
+	s.notify(key)
 	return nil
 }
 
 // Capabilities determines the additional capabilities of the backend.
 func (s *MemoryStorage) Capabilities() storage.Capability {
-	return storage.CapabilityNone
+	return storage.CapabilityWatching | storage.CapabilitySnapshots
 }
 
 // LockKey locks a key.
@@ -121,6 +144,62 @@ func (s *MemoryStorage) LockKey(ctx context.Context, key string) (storage.Mutex,
 	return &Mutex{item: item}, nil
 }
 
+// Watch sends the keys that have the given prefix on the returned channel whenever they are changed or deleted.
+// Changes are dropped if the receiver falls too far behind.
+func (s *MemoryStorage) Watch(ctx context.Context, prefix string) (<-chan string, error) {
+	w := &watcher{
+		prefix: prefix,
+		ch:     make(chan string, watchBufferSize),
+	}
+
+	s.mu.Lock()
+	s.watchers[w] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		delete(s.watchers, w)
+		close(w.ch)
+		s.mu.Unlock()
+	}()
+
+	return w.ch, nil
+}
+
+// Snapshot returns a copy of the backend. Writes to the copy do not change the backend.
+func (s *MemoryStorage) Snapshot(ctx context.Context) (storage.Storage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// stored values are replaced but never modified, so they can be shared with the copy
+	m := make(map[string][]byte, len(s.m))
+	for key, b := range s.m {
+		m[key] = b
+	}
+
+	return &MemoryStorage{
+		config:   s.config,
+		m:        m,
+		watchers: map[*watcher]struct{}{},
+	}, nil
+}
+
+// notify must be called while holding the write lock.
+func (s *MemoryStorage) notify(key string) {
+	for w := range s.watchers {
+		if !strings.HasPrefix(key, w.prefix) {
+			continue
+		}
+
+		select {
+		case w.ch <- key:
+		default:
+		}
+	}
+}
+
 // Mutex object.
 type Mutex struct {
 	item *apiv1.BackendItem
@@ -140,3 +219,8 @@ func (m *Mutex) Unlock() error {
 func (m *Mutex) Get() (*apiv1.BackendItem, error) {
 	return m.item, nil
 }
+
+// Done returns a nil channel since the lock can never be lost.
+func (m *Mutex) Done() <-chan struct{} {
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	apiv1 "github.com/slaskawi/vault-poc/api/v1"
 )
@@ -18,11 +19,16 @@ const (
 	CapabilityNone Capability = 1 << iota
 	CapabilityDistributedLocking
 	CapabilityWatching
+	CapabilitySnapshots
 )
 
 var (
 	ErrNotFound = fmt.Errorf("key not found")
 	ErrLocked   = fmt.Errorf("key is locked")
+	ErrReadOnly = fmt.Errorf("storage is read-only")
+
+	// ErrSkipPrefix can be returned by a WalkFunc to skip the keys under the prefix it was called with.
+	ErrSkipPrefix = fmt.Errorf("skip prefix")
 )
 
 // Storage represents a physical backend storage engine.
@@ -30,6 +36,10 @@ type Storage interface {
 	// List items with keys that have the given prefix.
 	List(ctx context.Context, prefix string) ([]string, error)
 
+	// ListPage lists a page of the names of items with keys that have the given prefix, in lexical order.
+	// more reports whether there are names after the page, which are listed by passing the last name of the page as ListOptions.After.
+	ListPage(ctx context.Context, prefix string, opts ListOptions) (names []string, more bool, err error)
+
 	// Get an item by its key.
 	Get(ctx context.Context, key string) (*apiv1.BackendItem, error)
 
@@ -44,6 +54,26 @@ type Storage interface {
 
 	// LockKey locks a key with a distributed Mutex.
 	LockKey(ctx context.Context, key string) (Mutex, error)
+
+	// Watch sends the keys that have the given prefix on the returned channel whenever they are changed or deleted.
+	// The channel is closed when the context is done.
+	Watch(ctx context.Context, prefix string) (<-chan string, error)
+
+	// Snapshot returns a read-only view of every item in the backend at a single point in time, which later writes do not change.
+	// Backends without CapabilitySnapshots return ErrReadOnly.
+	Snapshot(ctx context.Context) (Storage, error)
+}
+
+// ListOptions control which names are listed by Storage.ListPage.
+type ListOptions struct {
+	// Recursive lists the keys of all items under the prefix relative to it, instead of the names directly under it.
+	Recursive bool
+
+	// After is the name the page starts after, usually the last name of the previous page.
+	After string
+
+	// Limit is the maximum number of names in the page. Zero lists all names.
+	Limit int
 }
 
 // Mutex represents a distributed lock at the physical backend storage engine.
@@ -56,9 +86,66 @@ type Mutex interface {
 
 	// Get value of the key.
 	Get() (*apiv1.BackendItem, error)
+
+	// Done returns a channel that is closed if the lock is lost before it is unlocked, i.e. when the backend session expires.
+	Done() <-chan struct{}
 }
 
 // IsErrNotFound determines if the given error is ErrNotFound.
 func IsErrNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound)
 }
+
+// WalkFunc is called by Walk with every key and prefix. Prefixes end with a slash.
+type WalkFunc func(key string) error
+
+// Walk calls fn for every key under the given prefix in lexical order. Nested prefixes are passed to fn before the keys they contain.
+func Walk(ctx context.Context, s Storage, prefix string, fn WalkFunc) error {
+	names, err := s.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	for _, name := range names {
+		key := prefix + name
+		err := fn(key)
+		if err == ErrSkipPrefix {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if strings.HasSuffix(name, "/") {
+			if err := Walk(ctx, s, key, fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// PageStart returns the first key that can be listed in a page of names under the given prefix, which must end with a slash.
+func PageStart(prefix string, opts ListOptions) string {
+	if len(opts.After) == 0 {
+		return prefix
+	}
+
+	if !opts.Recursive && strings.HasSuffix(opts.After, "/") {
+		return SkipPrefix(prefix + opts.After)
+	}
+
+	// the zero byte makes it the first key after the given one.
+	return prefix + opts.After + "\x00"
+}
+
+// SkipPrefix returns the first key after all keys with the given prefix, which must end with a slash.
+func SkipPrefix(prefix string) string {
+	// `0` is the character after `/`.
+	return prefix[:len(prefix)-1] + "0"
+}